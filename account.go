@@ -1,17 +1,17 @@
 // Package accountapi provides a client for the fake Form3 Finacial Cloud RESTFul API.
-// It implements only create, fetch, list and delete.
+// It implements only create, fetch, list, update and delete.
 package accountapi
 
 import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"io/ioutil"
 	"net/http"
 	"net/url"
 	"os"
 	"path"
 	"strconv"
+	"strings"
 
 	"github.com/google/uuid"
 )
@@ -22,18 +22,24 @@ const envBaseURL = "FORM3_BASE_URL"
 const (
 	CountryUnitedKingdom = "GB"
 	CountryAustralia     = "AU"
+	CountryAustria       = "AT"
 	CountryBelgium       = "BE"
 	CountryCanada        = "CA"
+	CountryDenmark       = "DK"
+	CountryFinland       = "FI"
 	CountryFrance        = "FR"
 	CountryGermany       = "DE"
 	CountryGreece        = "GR"
 	CountryHongKong      = "HK"
+	CountryIreland       = "IE"
 	CountryItaly         = "IT"
 	CountryLuxembourg    = "LU"
 	CountryNetherlands   = "NL"
+	CountryNorway        = "NO"
 	CountryPoland        = "PL"
 	CountryPortugal      = "PT"
 	CountrySpain         = "ES"
+	CountrySweden        = "SE"
 	CountrySwitzerland   = "CH"
 	CountryUnitedStates  = "US"
 )
@@ -42,18 +48,24 @@ const (
 const (
 	CurrencyUnitedKingdom = "GBP"
 	CurrencyAustralia     = "AUD"
+	CurrencyAustria       = "EUR"
 	CurrencyBelgium       = "EUR"
 	CurrencyCanada        = "CAD"
+	CurrencyDenmark       = "DKK"
+	CurrencyFinland       = "EUR"
 	CurrencyFrance        = "EUR"
 	CurrencyGermany       = "EUR"
 	CurrencyGreecee       = "EUR"
 	CurrencyHongKong      = "HKD"
+	CurrencyIreland       = "EUR"
 	CurrencyItaly         = "EUR"
 	CurrencyLuxembourg    = "EUR"
 	CurrencyNetherlands   = "EUR"
+	CurrencyNorway        = "NOK"
 	CurrencyPoland        = "PLN"
 	CurrencyPortugal      = "EUR"
 	CurrencySpain         = "EUR"
+	CurrencySweden        = "SEK"
 	CurrencySwitzerland   = "CHF"
 	CurrencyUnitedStates  = "USD"
 )
@@ -62,18 +74,24 @@ const (
 const (
 	BankIDCodeUnitedKingdom = "GBDSC"
 	BankIDCodeAustralia     = "AUBSB"
+	BankIDCodeAustria       = "ATBLZ"
 	BankIDCodeBelgium       = "BE"
 	BankIDCodeCanada        = "CACPA"
+	BankIDCodeDenmark       = "" // not supported, must be blank
+	BankIDCodeFinland       = "" // not supported, must be blank
 	BankIDCodeFrance        = "FR"
 	BankIDCodeGermany       = "DEBLZ"
 	BankIDCodeGreece        = "GRBIC"
 	BankIDCodeHongKong      = "HKNCC"
+	BankIDCodeIreland       = "GBDSC"
 	BankIDCodeItaly         = "ITNCC"
 	BankIDCodeLuxembourg    = "LULUX"
 	BankIDCodeNetherlands   = "" // not supported, must be blank
+	BankIDCodeNorway        = "" // not supported, must be blank
 	BankIDCodePoland        = "PLKNR"
 	BankIDCodePortugal      = "PTNCC"
 	BankIDCodeSpain         = "ESNCC"
+	BankIDCodeSweden        = "SESBA"
 	BankIDCodeSwitzerland   = "CHBCC"
 	BankIDCodeUnitedStates  = "USABA"
 )
@@ -82,21 +100,27 @@ const (
 const (
 	BankIDLengthUnitedKingdom = 6
 	BankIDLengthAustralia     = 6
+	BankIDLengthAustria       = 5
 	BankIDLengthBelgium       = 3
 	BankIDLengthCanada        = 9
+	BankIDLengthDenmark       = 4
+	BankIDLengthFinland       = 0 // 0 because not supported
 	BankIDLengthFrance        = 10
 	BankIDLengthGermany       = 8
 	BankIDLengthGreece        = 7
 	BankIDLengthHongKong      = 3
+	BankIDLengthIreland       = 6
 	//
 	BankIDLengthItalyAccountNumberPresent    = 11
 	BankIDLengthItalyAccountNumberNotPresent = 10
 	//
 	BankIDLengthLuxembourg   = 3
 	BankIDLengthNetherlands  = 0 // 0 because not supported
+	BankIDLengthNorway       = 0 // 0 because not supported
 	BankIDLengthPoland       = 8
 	BankIDLengthPortugal     = 8
 	BankIDLengthSpain        = 8
+	BankIDLengthSweden       = 4
 	BankIDLengthSwitzerland  = 5
 	BankIDLengthUnitedStates = 9
 )
@@ -106,31 +130,67 @@ const (
 	AccountNumberLengthUnitedKingdom     = 8
 	AccountNumberLengthAustraliaStart    = 6
 	AccountNumberLengthAustraliaStop     = 10
+	AccountNumberLengthAustria           = 11
 	AccountNumberLengthBelgium           = 7
 	AccountNumberLengthCanadaStart       = 7
 	AccountNumberLengthCanadaStop        = 12
+	AccountNumberLengthDenmark           = 10
+	AccountNumberLengthFinland           = 14
 	AccountNumberLengthFrance            = 10
 	AccountNumberLengthGermany           = 7
 	AccountNumberLengthGreece            = 16
 	AccountNumberLengthHongKongStart     = 9
 	AccountNumberLengthHongKongStop      = 12
+	AccountNumberLengthIreland           = 8
 	AccountNumberLengthItaly             = 12
 	AccountNumberLengthLuxembourg        = 13
 	AccountNumberLengthNetherlands       = 10
+	AccountNumberLengthNorway            = 11
 	AccountNumberLengthPoland            = 16
 	AccountNumberLengthPortugal          = 11
 	AccountNumberLengthSpain             = 10
+	AccountNumberLengthSwedenStart       = 7
+	AccountNumberLengthSwedenStop        = 10
 	AccountNumberLengthSwitzerland       = 12
 	AccountNumberLengthUnitedStatesStart = 6
 	AccountNumberLengthUnitedStatesStop  = 17
 )
 
+// Account classifications
+const (
+	AccountClassificationPersonal = "Personal"
+	AccountClassificationBusiness = "Business"
+)
+
+// Account statuses
+const (
+	AccountStatusPending   = "pending"
+	AccountStatusConfirmed = "confirmed"
+	AccountStatusClosed    = "closed"
+)
+
 // Options holds the options meant to be passed as an argument to NewAccount
 type Options struct {
 	Type           string
 	ID             string
 	OrganisationID string
 	Attributes     []Attribute
+
+	// TransliterateNames converts the names of the account holder to the
+	// restricted SWIFT character set, see TransliterateSWIFT. Names with characters
+	// that have no transliteration are rejected with UntransliterableCharacterError.
+	TransliterateNames bool
+
+	// Normalize corrects common input mistakes in the attributes before
+	// they are validated, see Attributes.Normalize.
+	Normalize bool
+
+	// OnNormalize, if set, is called with the changes made by Normalize.
+	OnNormalize func(changes []NormalizationChange)
+
+	// Directory, if set, rejects bank IDs and BICs that don't exist in it.
+	// See Directory.Lookup and Directory.LookupBIC.
+	Directory *Directory
 }
 
 // Attributes holds the account attributes
@@ -152,7 +212,8 @@ type Attributes struct {
 	// for allowed value for each country. Required value depends on country attribute.
 	BankIDCode string `json:"bank_id_code,omitempty"`
 
-	// Account number. A unique account number will automatically be generated if not provided.
+	// Account number. A unique account number will automatically be generated if not provided,
+	// see GenerateAccountNumber to generate one locally.
 	AccountNumber string `json:"account_number,omitempty"`
 
 	// SWIFT BIC in either 8 or 11 character format e.g. 'NWBKGB22'.
@@ -161,9 +222,25 @@ type Attributes struct {
 	// A free-format reference that can be used to link this account to an external system.
 	CustomerID string `json:"customer_id,omitempty"`
 
+	// Title of the account holder, e.g. 'Mr', 'Dr'. Up to 40 characters.
+	Title string `json:"title,omitempty"`
+
 	// First name of the account holder
 	FirstName string `json:"first_name,omitempty"`
 
+	// Name of the account holder, up to four lines possible.
+	// Each line can be up to 140 characters long.
+	Name []string `json:"name,omitempty"`
+
+	// Primary account name, used for Confirmation of Payee matching.
+	// Up to 140 characters.
+	BankAccountName string `json:"bank_account_name,omitempty"`
+
+	// Alternative names of the account holder, up to 3 names.
+	// Each name can be up to 140 characters long.
+	// Supersedes AlternativeBankAccountNames, if both are set they must be equal.
+	AlternativeNames []string `json:"alternative_names,omitempty"`
+
 	// Alternative primary account names, only used for UK Confirmation of Payee
 	// CoP: Up to 3 alternative account names, one in each line of the array.
 	AlternativeBankAccountNames []string `json:"alternative_bank_account_names,omitempty"`
@@ -173,14 +250,63 @@ type Attributes struct {
 	// CoP: Set to true is this is a joint account. Defaults to false.
 	JointAccount bool `json:"joint_account,omitempty"`
 
+	// Classification of the account, either 'Personal' or 'Business'.
+	// Business accounts must not have a first name.
+	AccountClassification string `json:"account_classification,omitempty"`
+
+	// Status of the account, one of 'pending', 'confirmed' or 'closed'.
+	Status string `json:"status,omitempty"`
+
+	// Flag to indicate if the account has been switched using the
+	// Current Account Switch Service (CASS). Only used for 'GB' accounts.
+	Switched bool `json:"switched,omitempty"`
+
+	// Additional information to identify the account, e.g. a building society roll number.
+	// Up to 140 characters.
+	SecondaryIdentification string `json:"secondary_identification,omitempty"`
+
+	// Identification of a private account holder.
+	PrivateIdentification *PrivateIdentification `json:"private_identification,omitempty"`
+
+	// Identification of an organisation account holder.
+	OrganisationIdentification *OrganisationIdentification `json:"organisation_identification,omitempty"`
+
 	// Flag to indicate if the account has opted out of account matching,
 	// only used for Confirmation of Payee.
 	// CoP: Set to true if the account has opted out of account matching. Defaults to false.
 	AccountMatchingOptOut bool `json:"account_matching_opt_out,omitempty"`
+
+	// attributes that are not modelled, kept so they survive a round-trip
+	unknown map[string]json.RawMessage
 }
 
 type Attribute func(*Attributes)
 
+// ListFilter restricts the accounts returned by ListAccounts
+type ListFilter func(params url.Values)
+
+// FullName returns the name of the account holder. It's the Name lines
+// joined by a space, or BankAccountName or FirstName if Name is not set.
+func (a *Attributes) FullName() string {
+	switch {
+	case len(a.Name) != 0:
+		return strings.Join(a.Name, " ")
+	case a.BankAccountName != "":
+		return a.BankAccountName
+	default:
+		return a.FirstName
+	}
+}
+
+// AllAlternativeNames returns AlternativeNames, or AlternativeBankAccountNames
+// if AlternativeNames is not set.
+func (a *Attributes) AllAlternativeNames() []string {
+	if len(a.AlternativeNames) != 0 {
+		return a.AlternativeNames
+	}
+	return a.AlternativeBankAccountNames
+}
+
 type Links struct {
 	First string `json:"first,omitempty"`
 	Last  string `json:"last,omitempty"`
@@ -201,25 +327,67 @@ type Details struct {
 type Data struct {
 	Attributes *Attributes `json:"attributes,omitempty"`
 	Details
+
+	// JSON:API members that are not modelled, e.g. 'relationships'
+	unknown map[string]json.RawMessage
 }
 
 type Account struct {
 	Data         *Data  `json:"data,omitempty"`
 	Links        *Links `json:"links,omitempty"`
 	ErrorMessage string `json:"error_message,omitempty"`
+
+	// JSON:API members that are not modelled, e.g. 'meta' and 'included'
+	unknown map[string]json.RawMessage
 }
 
 type Accounts struct {
 	Data  []Data `json:"data"`
 	Links Links  `json:"links"`
+
+	// JSON:API members that are not modelled, e.g. 'meta'
+	unknown map[string]json.RawMessage
 }
 
 type Client struct {
 	Client  *http.Client
 	BaseURL string
+
+	// SkipValidation disables the validation of the accounts in CreateAccount,
+	// they are then only validated by the server.
+	SkipValidation bool
+
+	// OnResponse, if set, is called with every response of the server,
+	// e.g. to log the request ID or the rate limit headers.
+	OnResponse func(resp *Response)
+
+	// DryRun, if set, records the requests that change accounts instead of sending
+	// them and answers them as the server would if they succeeded. Accounts are always
+	// validated in dry-run mode. Requests that only read accounts are sent.
+	DryRun *DryRun
+
+	// Audit, if set, records every account created, updated or deleted by the client, whether
+	// the call succeeds or not. See WithAuditActor to record who made the calls.
+	Audit *AuditLog
 }
 
+// CreateAccount creates the account, see Client.Audit for the record of the call.
 func (c *Client) CreateAccount(ctx context.Context, account *Account) (Account, error) {
+	created, err := c.createAccount(ctx, account)
+
+	record := AuditRecord{Operation: AuditOperationCreate}
+	if account != nil && account.Data != nil {
+		record.AccountID = account.Data.ID
+		record.OrganisationID = account.Data.OrganisationID
+		record.Version = account.Data.Version
+	}
+	if created.Data != nil {
+		record.Version = created.Data.Version
+	}
+	return created, c.audit(ctx, record, err)
+}
+
+func (c *Client) createAccount(ctx context.Context, account *Account) (Account, error) {
 	if c.Client == nil {
 		c.Client = &http.Client{}
 	}
@@ -228,6 +396,12 @@ func (c *Client) CreateAccount(ctx context.Context, account *Account) (Account,
 		c.BaseURL = DefaultBaseURL()
 	}
 
+	if !c.SkipValidation || c.DryRun != nil {
+		if err := account.Validate(); err != nil {
+			return Account{}, err
+		}
+	}
+
 	baseURL, err := url.Parse(c.BaseURL)
 	if err != nil {
 		return Account{}, err
@@ -246,11 +420,10 @@ func (c *Client) CreateAccount(ctx context.Context, account *Account) (Account,
 	req.Header.Set("Accept", "vnd.api+json")
 	req.Header.Set("Content-Type", "application/vnd.api+json")
 
-	resp, err := c.Client.Do(req.WithContext(ctx))
+	resp, err := c.do(ctx, req)
 	if err != nil {
 		return Account{}, err
 	}
-	defer resp.Body.Close()
 
 	switch resp.StatusCode {
 	case http.StatusNotFound:
@@ -258,14 +431,12 @@ func (c *Client) CreateAccount(ctx context.Context, account *Account) (Account,
 	case http.StatusConflict:
 		return Account{}, &DuplicateAccountError{account.Data.ID}
 	}
-
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
+	if err := responseError(resp); err != nil {
 		return Account{}, err
 	}
 
 	var a Account
-	if err := json.Unmarshal(body, &a); err != nil {
+	if err := json.Unmarshal(resp.body, &a); err != nil {
 		return Account{}, err
 	}
 
@@ -300,30 +471,34 @@ func (c *Client) FetchAccount(ctx context.Context, id string) (Account, error) {
 
 	req.Header.Set("Accept", "vnd.api+json")
 
-	resp, err := c.Client.Do(req.WithContext(ctx))
+	resp, err := c.do(ctx, req)
 	if err != nil {
 		return Account{}, err
 	}
-	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusNotFound {
 		return Account{}, &ResourceNotExistsError{baseURL.String()}
 	}
-
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
+	if err := responseError(resp); err != nil {
 		return Account{}, err
 	}
 
 	var a Account
-	if err := json.Unmarshal(body, &a); err != nil {
+	if err := json.Unmarshal(resp.body, &a); err != nil {
 		return Account{}, err
 	}
 
 	return a, nil
 }
 
-func (c *Client) ListAccounts(ctx context.Context, pageNumber, pageSize int) (Accounts, error) {
+// lastPage returns true if the page of accounts is empty or has no link to a next page.
+// Servers may serve fewer accounts than the page size asked for, so a short page is not
+// the last one.
+func (a *Accounts) lastPage() bool {
+	return len(a.Data) == 0 || a.Links.Next == ""
+}
+
+func (c *Client) ListAccounts(ctx context.Context, pageNumber, pageSize int, filters ...ListFilter) (Accounts, error) {
 	if c.Client == nil {
 		c.Client = &http.Client{}
 	}
@@ -340,6 +515,9 @@ func (c *Client) ListAccounts(ctx context.Context, pageNumber, pageSize int) (Ac
 	params := url.Values{}
 	params.Add("page[number]", strconv.Itoa(pageNumber))
 	params.Add("page[size]", strconv.Itoa(pageSize))
+	for _, filter := range filters {
+		filter(params)
+	}
 	baseURL.RawQuery = params.Encode()
 
 	req, err := http.NewRequest(http.MethodGet, baseURL.String(), nil)
@@ -349,30 +527,40 @@ func (c *Client) ListAccounts(ctx context.Context, pageNumber, pageSize int) (Ac
 
 	req.Header.Set("Accept", "vnd.api+json")
 
-	resp, err := c.Client.Do(req.WithContext(ctx))
+	resp, err := c.do(ctx, req)
 	if err != nil {
 		return Accounts{}, err
 	}
-	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusNotFound {
 		return Accounts{}, &ResourceNotExistsError{baseURL.String()}
 	}
-
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
+	if err := responseError(resp); err != nil {
 		return Accounts{}, err
 	}
 
 	var a Accounts
-	if err := json.Unmarshal(body, &a); err != nil {
+	if err := json.Unmarshal(resp.body, &a); err != nil {
 		return Accounts{}, err
 	}
 
 	return a, nil
 }
 
+// DeleteAccount deletes the version of the account, see Client.Audit for the record of the call.
+// With an audit log, the account is fetched first for its organisation unless it's set with
+// WithAuditOrganisation.
 func (c *Client) DeleteAccount(ctx context.Context, id string, version int) error {
+	record := AuditRecord{Operation: AuditOperationDelete, AccountID: id, Version: version}
+	if c.Audit != nil {
+		record.OrganisationID = c.auditOrganisation(ctx, id)
+	}
+
+	err := c.deleteAccount(ctx, id, version)
+	return c.audit(ctx, record, err)
+}
+
+func (c *Client) deleteAccount(ctx context.Context, id string, version int) error {
 	if c.Client == nil {
 		c.Client = &http.Client{}
 	}
@@ -401,17 +589,101 @@ func (c *Client) DeleteAccount(ctx context.Context, id string, version int) erro
 		return err
 	}
 
-	resp, err := c.Client.Do(req.WithContext(ctx))
+	resp, err := c.do(ctx, req)
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
+	switch resp.StatusCode {
+	case http.StatusNotFound:
 		return &ResourceNotExistsError{baseURL.String()}
+	case http.StatusConflict:
+		return &VersionConflictError{ID: id, Version: version}
 	}
 
-	return nil
+	return responseError(resp)
+}
+
+// UpdateAccount changes the attributes of the account, the version of the account must be
+// its current version. It returns a VersionConflictError if the account changed since.
+// See Client.Audit for the record of the call.
+func (c *Client) UpdateAccount(ctx context.Context, account *Account) (Account, error) {
+	updated, err := c.updateAccount(ctx, account)
+
+	record := AuditRecord{Operation: AuditOperationUpdate}
+	if account != nil && account.Data != nil {
+		record.AccountID = account.Data.ID
+		record.OrganisationID = account.Data.OrganisationID
+		record.Version = account.Data.Version
+	}
+	return updated, c.audit(ctx, record, err)
+}
+
+func (c *Client) updateAccount(ctx context.Context, account *Account) (Account, error) {
+	if c.Client == nil {
+		c.Client = &http.Client{}
+	}
+
+	if c.BaseURL == "" {
+		c.BaseURL = DefaultBaseURL()
+	}
+
+	if account == nil || account.Data == nil || account.Data.Attributes == nil {
+		return Account{}, ErrNoAttributes
+	}
+
+	if !c.SkipValidation || c.DryRun != nil {
+		if err := account.Validate(); err != nil {
+			return Account{}, err
+		}
+	}
+
+	accountID, err := uuid.Parse(account.Data.ID)
+	if err != nil {
+		return Account{}, err
+	}
+
+	baseURL, err := url.Parse(c.BaseURL)
+	if err != nil {
+		return Account{}, err
+	}
+
+	baseURL.Path = path.Join(baseURL.Path, accountID.String())
+
+	data, err := json.Marshal(account)
+	if err != nil {
+		return Account{}, err
+	}
+
+	req, err := http.NewRequest(http.MethodPatch, baseURL.String(), bytes.NewBuffer(data))
+	if err != nil {
+		return Account{}, err
+	}
+
+	req.Header.Set("Accept", "vnd.api+json")
+	req.Header.Set("Content-Type", "application/vnd.api+json")
+
+	resp, err := c.do(ctx, req)
+	if err != nil {
+		return Account{}, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return Account{}, &ResourceNotExistsError{baseURL.String()}
+	case http.StatusConflict:
+		return Account{}, &VersionConflictError{ID: account.Data.ID, Version: account.Data.Version}
+	}
+	if err := responseError(resp); err != nil {
+		return Account{}, err
+	}
+
+	var a Account
+	if err := json.Unmarshal(resp.body, &a); err != nil {
+		return Account{}, err
+	}
+
+	return a, nil
 }
 
 func NewAccount(opt *Options) (*Account, error) {
@@ -425,10 +697,31 @@ func NewAccount(opt *Options) (*Account, error) {
 		attr(attributes)
 	}
 
+	if opt.Normalize {
+		normalized, changes := attributes.Normalize()
+		attributes = &normalized
+		if opt.OnNormalize != nil {
+			opt.OnNormalize(changes)
+		}
+	}
+
+	attributes.normalizeNames()
+	if opt.TransliterateNames {
+		if err := attributes.transliterateNames(); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := attributes.validate(); err != nil {
 		return nil, err
 	}
 
+	if opt.Directory != nil {
+		if err := opt.Directory.validate(attributes); err != nil {
+			return nil, err
+		}
+	}
+
 	account := &Account{
 		Data: &Data{
 			Attributes: attributes,
@@ -509,6 +802,30 @@ func WithAttrFirstName(name string) Attribute {
 	}
 }
 
+func WithAttrTitle(title string) Attribute {
+	return func(a *Attributes) {
+		a.Title = title
+	}
+}
+
+func WithAttrName(lines ...string) Attribute {
+	return func(a *Attributes) {
+		a.Name = lines
+	}
+}
+
+func WithAttrBankAccountName(name string) Attribute {
+	return func(a *Attributes) {
+		a.BankAccountName = name
+	}
+}
+
+func WithAttrAlternativeNames(names ...string) Attribute {
+	return func(a *Attributes) {
+		a.AlternativeNames = names
+	}
+}
+
 func WithAttrAlternativeBankAccountNames(names ...string) Attribute {
 	return func(a *Attributes) {
 		a.AlternativeBankAccountNames = names
@@ -521,12 +838,72 @@ func WithAttrAccountMatchingOptOut(optOut bool) Attribute {
 	}
 }
 
+func WithAttrAccountClassification(classification string) Attribute {
+	return func(a *Attributes) {
+		a.AccountClassification = classification
+	}
+}
+
+func WithAttrStatus(status string) Attribute {
+	return func(a *Attributes) {
+		a.Status = status
+	}
+}
+
+func WithAttrSwitched(switched bool) Attribute {
+	return func(a *Attributes) {
+		a.Switched = switched
+	}
+}
+
+func WithAttrSecondaryIdentification(id string) Attribute {
+	return func(a *Attributes) {
+		a.SecondaryIdentification = id
+	}
+}
+
 func WithAttrCustomerID(id string) Attribute {
 	return func(a *Attributes) {
 		a.CustomerID = id
 	}
 }
 
+func WithFilterCountry(country string) ListFilter {
+	return func(params url.Values) {
+		params.Add("filter[country]", country)
+	}
+}
+
+func WithFilterBankID(id string) ListFilter {
+	return func(params url.Values) {
+		params.Add("filter[bank_id]", id)
+	}
+}
+
+func WithFilterBankIDCode(code string) ListFilter {
+	return func(params url.Values) {
+		params.Add("filter[bank_id_code]", code)
+	}
+}
+
+func WithFilterAccountNumber(number string) ListFilter {
+	return func(params url.Values) {
+		params.Add("filter[account_number]", number)
+	}
+}
+
+func WithFilterCustomerID(id string) ListFilter {
+	return func(params url.Values) {
+		params.Add("filter[customer_id]", id)
+	}
+}
+
+func WithFilterOrganisationID(id string) ListFilter {
+	return func(params url.Values) {
+		params.Add("filter[organisation_id]", id)
+	}
+}
+
 func DefaultBaseURL() string {
 	u := os.Getenv(envBaseURL)
 	if u == "" {
@@ -1,6 +1,14 @@
 package accountapi
 
-import "fmt"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrNoAttributes is returned if an account has no data or no attributes.
+var ErrNoAttributes = errors.New("account has no attributes")
 
 // ResourceNotExistsError is returned if URL is invalid and the resource it points to does not exist.
 type ResourceNotExistsError struct {
@@ -19,3 +27,62 @@ type DuplicateAccountError struct {
 func (e *DuplicateAccountError) Error() string {
 	return fmt.Sprintf("duplicate account '%s'", e.ID)
 }
+
+// VersionConflictError is returned if an account is updated or deleted with a version
+// that is not its current version.
+type VersionConflictError struct {
+	ID      string
+	Version int
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("version %d of account '%s' is not the current version", e.Version, e.ID)
+}
+
+// APIError is returned if the server answers with an error status that has no more
+// specific error, e.g. 400 if it rejects an account.
+type APIError struct {
+	StatusCode int
+
+	// error_message of the response, blank if it has none.
+	Message string
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("server answered %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	return fmt.Sprintf("server answered %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+}
+
+// responseError returns an APIError if the response has an error status
+func responseError(resp *Response) error {
+	if resp.StatusCode < http.StatusBadRequest {
+		return nil
+	}
+
+	var body struct {
+		ErrorMessage string `json:"error_message"`
+	}
+	_ = json.Unmarshal(resp.body, &body)
+	return &APIError{StatusCode: resp.StatusCode, Message: body.ErrorMessage}
+}
+
+// ErrAccountNumbersExhausted is returned if no unique account number could be generated.
+var ErrAccountNumbersExhausted = errors.New("no unique account number could be generated")
+
+// ErrIncompleteKeyPair is returned if only one of the client certificate and key files is set.
+var ErrIncompleteKeyPair = errors.New("both the certificate and the key file must be set")
+
+// ErrNoCertificates is returned if a CA bundle holds no PEM certificates.
+var ErrNoCertificates = errors.New("no certificates found")
+
+// ErrExportNotResumable is returned by Client.Export if it resumes from a checkpoint
+// but the output can't be truncated to the end of the checkpoint.
+var ErrExportNotResumable = errors.New("export output can't be truncated to resume it")
+
+// ErrInvalidSnapshot is returned by Restore if the archive is not a snapshot written by Snapshot.
+var ErrInvalidSnapshot = errors.New("invalid account snapshot")
+
+// ErrSnapshotTruncated is returned by Restore if the snapshot ends before its last account.
+var ErrSnapshotTruncated = errors.New("account snapshot is truncated")
@@ -0,0 +1,160 @@
+package accountapi
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+// number of attempts to generate a valid, unique account number before giving up
+const accountNumberGenerateAttempts = 1000
+
+// accountNumberCheckDigits completes the generated digits of an account number with
+// the national check digits of a country. It reports false if no check digits exist
+// for the digits, e.g. for a remainder of 10.
+type accountNumberCheckDigits struct {
+	length int
+	digits func(bankID, digits string) (string, bool)
+}
+
+// countries whose account numbers end with national check digits, the countries with
+// accountNumberCheckDigits in countries. Dutch account numbers have none: not all of
+// them pass the eleven test (elfproef), e.g. the former Postbank numbers.
+var accountNumberCheckDigitsByCountry = map[string]accountNumberCheckDigits{
+	CountryFinland: {length: 1, digits: luhnCheckDigit},
+	CountryNorway:  {length: 1, digits: modulus11CheckDigit},
+}
+
+// AccountNumberGenerator generates account numbers in the format of each country,
+// the way the server does when an account is created without an account number.
+type AccountNumberGenerator struct {
+	// Rand is the source of randomness. If nil, the top-level functions of math/rand are used.
+	// A *rand.Rand is not safe for concurrent use, so neither is the generator when it is set.
+	Rand *rand.Rand
+
+	// Existing, if not nil, holds the account numbers already in use. Generated account numbers
+	// are never in Existing and are added to it, so they are unique across calls too.
+	Existing map[string]bool
+}
+
+// NewAccountNumberGenerator returns an AccountNumberGenerator that uses src as the source
+// of randomness, e.g. rand.NewSource(1) for account numbers that are the same on every run.
+func NewAccountNumberGenerator(src rand.Source) *AccountNumberGenerator {
+	return &AccountNumberGenerator{Rand: rand.New(src)}
+}
+
+// GenerateAccountNumber generates an account number for the country and bank ID.
+func GenerateAccountNumber(country, bankID string) (string, error) {
+	return (&AccountNumberGenerator{}).Generate(country, bankID)
+}
+
+// Generate generates an account number for the country and bank ID. The account number
+// has the length required by the country, a random length for countries that allow a range,
+// and ends with the national check digits for countries that have them. The bank ID is not
+// validated, it is only used by the check digits of the countries that include it.
+func (g *AccountNumberGenerator) Generate(country, bankID string) (string, error) {
+	rules, ok := countries[country]
+	if !ok {
+		return "", checkCountry(country)
+	}
+
+	for i := 0; i < accountNumberGenerateAttempts; i++ {
+		number, ok := g.generate(rules, accountNumberCheckDigitsByCountry[country], bankID)
+		if !ok || g.Existing[number] {
+			continue
+		}
+
+		if g.Existing != nil {
+			g.Existing[number] = true
+		}
+		return number, nil
+	}
+
+	return "", ErrAccountNumbersExhausted
+}
+
+func (g *AccountNumberGenerator) generate(rules countryRules,
+	check accountNumberCheckDigits, bankID string) (string, bool) {
+	from, to := rules.accountNumberLengthFrom, rules.accountNumberLengthTo
+	length := from + g.intn(to-from+1)
+
+	var b strings.Builder
+	for i := 0; i < length-check.length; i++ {
+		digit := g.intn(10)
+		if i == 0 && rules.accountNumberFirstCharNonZero {
+			digit = 1 + g.intn(9)
+		}
+		b.WriteString(strconv.Itoa(digit))
+	}
+
+	if check.digits == nil {
+		return b.String(), true
+	}
+
+	digits, ok := check.digits(bankID, b.String())
+	return b.String() + digits, ok
+}
+
+func (g *AccountNumberGenerator) intn(n int) int {
+	if g.Rand == nil {
+		return rand.Intn(n)
+	}
+	return g.Rand.Intn(n)
+}
+
+// modulus11CheckDigit returns the last digit of a Norwegian account number, so the sum
+// of the digits weighted 5, 4, 3, 2, 7, 6, 5, 4, 3, 2 and 1 is divisible by 11
+func modulus11CheckDigit(_, digits string) (string, bool) {
+	weights := []int{5, 4, 3, 2, 7, 6, 5, 4, 3, 2}
+	if len(digits) != len(weights) {
+		return "", false
+	}
+
+	sum := 0
+	for i := range digits {
+		sum += int(digits[i]-'0') * weights[i]
+	}
+
+	check := (11 - sum%11) % 11
+	if check == 10 {
+		return "", false
+	}
+
+	return strconv.Itoa(check), true
+}
+
+// luhnCheckDigit returns the last digit of a Finnish account number, computed with the Luhn algorithm
+func luhnCheckDigit(_, digits string) (string, bool) {
+	sum := 0
+	double := true
+	for i := len(digits) - 1; i >= 0; i-- {
+		digit := int(digits[i] - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+
+	return strconv.Itoa((10 - sum%10) % 10), true
+}
+
+// validAccountNumberCheckDigits reports whether the account number ends with the national
+// check digits of the country. Account numbers of countries without check digits are valid.
+func validAccountNumberCheckDigits(country, bankID, number string) bool {
+	check, ok := accountNumberCheckDigitsByCountry[country]
+	if !ok {
+		return true
+	}
+
+	if len(number) <= check.length || !isDigits(number) {
+		return false
+	}
+
+	split := len(number) - check.length
+	digits, ok := check.digits(bankID, number[:split])
+	return ok && digits == number[split:]
+}
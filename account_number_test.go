@@ -0,0 +1,103 @@
+package accountapi_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	. "github.com/alexdreptu/form3-accountapi-client"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// zeroSource is a rand.Source that always returns 0
+type zeroSource struct{}
+
+func (zeroSource) Int63() int64 { return 0 }
+func (zeroSource) Seed(int64)   {}
+
+func TestGenerateAccountNumber(t *testing.T) {
+	testCases := []struct {
+		country    string
+		bankID     string
+		bankIDCode string
+	}{
+		{CountryUnitedKingdom, randomBankIDUnitedKingdom(), BankIDCodeUnitedKingdom},
+		{CountryAustralia, randomBankIDAustralia(), BankIDCodeAustralia},
+		{CountryAustria, randomBankIDAustria(), BankIDCodeAustria},
+		{CountryBelgium, randomBankIDBelgium(), BankIDCodeBelgium},
+		{CountryCanada, randomBankIDCanada(), BankIDCodeCanada},
+		{CountryDenmark, randomBankIDDenmark(), BankIDCodeDenmark},
+		{CountryFinland, randomBankIDFinland(), BankIDCodeFinland},
+		{CountryFrance, randomBankIDFrance(), BankIDCodeFrance},
+		{CountryGermany, randomBankIDGermany(), BankIDCodeGermany},
+		{CountryGreece, randomBankIDGreece(), BankIDCodeGreece},
+		{CountryHongKong, randomBankIDHongKong(), BankIDCodeHongKong},
+		{CountryIreland, randomBankIDIreland(), BankIDCodeIreland},
+		{CountryItaly, randomBankIDItaly(true), BankIDCodeItaly},
+		{CountryLuxembourg, randomBankIDLuxembourg(), BankIDCodeLuxembourg},
+		{CountryNetherlands, randomBankIDNetherlands(), BankIDCodeNetherlands},
+		{CountryNorway, randomBankIDNorway(), BankIDCodeNorway},
+		{CountryPoland, randomBankIDPoland(), BankIDCodePoland},
+		{CountryPortugal, randomBankIDPortugal(), BankIDCodePortugal},
+		{CountrySpain, randomBankIDSpain(), BankIDCodeSpain},
+		{CountrySweden, randomBankIDSweden(), BankIDCodeSweden},
+		{CountrySwitzerland, randomBankIDSwitzerland(), BankIDCodeSwitzerland},
+		{CountryUnitedStates, randomBankIDUnitedStates(), BankIDCodeUnitedStates},
+	}
+
+	generator := NewAccountNumberGenerator(rand.NewSource(1))
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.country, func(t *testing.T) {
+			for i := 0; i < 50; i++ {
+				number, err := generator.Generate(tc.country, tc.bankID)
+				require.NoError(t, err)
+
+				_, err = NewAccount(&Options{
+					Type:           accountType,
+					ID:             uuid.New().String(),
+					OrganisationID: uuid.New().String(),
+					Attributes: []Attribute{
+						WithAttrCountry(tc.country),
+						WithAttrBIC(randomBIC()),
+						WithAttrBankID(tc.bankID),
+						WithAttrBankIDCode(tc.bankIDCode),
+						WithAttrAccountNumber(number),
+					},
+				})
+				require.NoError(t, err, number)
+			}
+		})
+	}
+}
+
+func TestGenerateAccountNumber_Deterministic(t *testing.T) {
+	x, err := NewAccountNumberGenerator(rand.NewSource(42)).Generate(CountryUnitedKingdom, "400300")
+	require.NoError(t, err)
+	y, err := NewAccountNumberGenerator(rand.NewSource(42)).Generate(CountryUnitedKingdom, "400300")
+	require.NoError(t, err)
+	assert.Equal(t, x, y)
+}
+
+func TestGenerateAccountNumber_Existing(t *testing.T) {
+	generator := &AccountNumberGenerator{Rand: rand.New(zeroSource{}), Existing: map[string]bool{}}
+
+	number, err := generator.Generate(CountryUnitedKingdom, "400300")
+	require.NoError(t, err)
+	assert.Equal(t, "00000000", number)
+	assert.True(t, generator.Existing[number])
+
+	_, err = generator.Generate(CountryUnitedKingdom, "400300")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrAccountNumbersExhausted))
+}
+
+func TestGenerateAccountNumber_InvalidCountry(t *testing.T) {
+	_, err := GenerateAccountNumber("XX", "400300")
+	require.Error(t, err)
+	var e *InvalidCountryError
+	assert.True(t, errors.As(err, &e))
+}
@@ -4,11 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 	"time"
 
 	. "github.com/alexdreptu/form3-accountapi-client"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -26,6 +31,115 @@ func TestNewClient(t *testing.T) {
 	assert.Equal(t, DefaultBaseURL(), client.BaseURL)
 }
 
+func newValidateTestAccount(t *testing.T) *Account {
+	account, err := NewAccount(&Options{
+		Type:           accountType,
+		ID:             uuid.New().String(),
+		OrganisationID: uuid.New().String(),
+		Attributes: []Attribute{
+			WithAttrCountry(CountryUnitedKingdom),
+			WithAttrBIC(randomBIC()),
+			WithAttrBankID(randomBankIDUnitedKingdom()),
+			WithAttrBankIDCode(BankIDCodeUnitedKingdom),
+		},
+	})
+	require.NoError(t, err)
+	return account
+}
+
+func TestAccount_Validate(t *testing.T) {
+	account := newValidateTestAccount(t)
+	require.NoError(t, account.Validate())
+
+	// decomposed names are checked in Normalization Form C and left unchanged
+	account.Data.Attributes.FirstName = "Jose\u0301"
+	require.NoError(t, account.Validate())
+	assert.Equal(t, "Jose\u0301", account.Data.Attributes.FirstName)
+
+	account.Data.Attributes.BankID = "1234"
+	err := account.Validate()
+	require.Error(t, err)
+	var errs validation.Errors
+	require.True(t, errors.As(err, &errs))
+	var e *InvalidBankIDLengthError
+	assert.True(t, errors.As(errs["bank_id"], &e))
+
+	account = newValidateTestAccount(t)
+	account.Data.ID = "invalid"
+	assert.Error(t, account.Validate())
+
+	account = newValidateTestAccount(t)
+	account.Data.Type = "payments"
+	err = account.Validate()
+	require.Error(t, err)
+	require.True(t, errors.As(err, &errs))
+	var typeErr *InvalidAccountTypeError
+	assert.True(t, errors.As(errs["type"], &typeErr))
+
+	assert.True(t, errors.Is((&Account{}).Validate(), ErrNoAttributes))
+	assert.True(t, errors.Is((&Account{Data: &Data{}}).Validate(), ErrNoAttributes))
+	var nilAccount *Account
+	assert.True(t, errors.Is(nilAccount.Validate(), ErrNoAttributes))
+}
+
+func TestAccount_Validate_CharacterLengths(t *testing.T) {
+	// limits are in characters, an accented character is two bytes in UTF-8
+	account := newValidateTestAccount(t)
+	account.Data.Attributes.Title = strings.Repeat("é", 40)
+	account.Data.Attributes.SecondaryIdentification = strings.Repeat("é", 140)
+	account.Data.Attributes.CustomerID = strings.Repeat("é", 15)
+	require.NoError(t, account.Validate())
+
+	account.Data.Attributes.Title = strings.Repeat("é", 41)
+	account.Data.Attributes.SecondaryIdentification = strings.Repeat("é", 141)
+	err := account.Validate()
+	var errs validation.Errors
+	require.True(t, errors.As(err, &errs))
+	var titleErr *InvalidTitleLengthError
+	require.True(t, errors.As(errs["title"], &titleErr))
+	assert.Equal(t, 41, titleErr.Length)
+	var idErr *InvalidSecondaryIdentificationLengthError
+	require.True(t, errors.As(errs["secondary_identification"], &idErr))
+	assert.Equal(t, 141, idErr.Length)
+}
+
+func TestCreateAccount_Validate(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			body, _ := ioutil.ReadAll(r.Body)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write(body)
+		},
+	))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	account := newValidateTestAccount(t)
+	account.Data.Attributes.BankIDCode = "GBSC"
+
+	client := NewClient(&http.Client{}, server.URL)
+	_, err := client.CreateAccount(ctx, account)
+	require.Error(t, err)
+	var errs validation.Errors
+	require.True(t, errors.As(err, &errs))
+	var e *InvalidBankIDCodeError
+	assert.True(t, errors.As(errs["bank_id_code"], &e))
+	assert.Zero(t, requests)
+
+	_, err = client.CreateAccount(ctx, &Account{})
+	assert.True(t, errors.Is(err, ErrNoAttributes))
+	assert.Zero(t, requests)
+
+	client.SkipValidation = true
+	_, err = client.CreateAccount(ctx, account)
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+}
+
 type CreateAccountSuite struct {
 	suite.Suite
 	testAccount testOptions
@@ -399,6 +513,40 @@ func TestListAccountsSuite(t *testing.T) {
 	suite.Run(t, &ListAccountsSuite{})
 }
 
+func TestListAccounts_Filters(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			query = r.URL.Query()
+			_, _ = w.Write([]byte(`{"data": [], "links": {}}`))
+		},
+	))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	client := NewClient(&http.Client{}, server.URL)
+	_, err := client.ListAccounts(ctx, 2, 10,
+		WithFilterCountry(CountryUnitedKingdom),
+		WithFilterBankID("400300"),
+		WithFilterBankIDCode(BankIDCodeUnitedKingdom),
+		WithFilterAccountNumber("41426815"),
+		WithFilterCustomerID("5019343427"),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, url.Values{
+		"page[number]":           {"2"},
+		"page[size]":             {"10"},
+		"filter[country]":        {"GB"},
+		"filter[bank_id]":        {"400300"},
+		"filter[bank_id_code]":   {"GBDSC"},
+		"filter[account_number]": {"41426815"},
+		"filter[customer_id]":    {"5019343427"},
+	}, query)
+}
+
 type DeleteAccountSuite struct {
 	suite.Suite
 	testAccount testOptions
@@ -511,6 +659,14 @@ func TestWithAttrFunctions(t *testing.T) {
 	alternativeBankAccountNames := randomAlternativeBankAccountNames()
 	accountMatchingOptOut := randomBool()
 	customerID := randomCustomerID()
+	title := randomTitle()
+	name := randomName()
+	bankAccountName := randomFullName()
+	alternativeNames := randomAlternativeBankAccountNames()
+	accountClassification := AccountClassificationPersonal
+	status := AccountStatusConfirmed
+	switched := randomBool()
+	secondaryIdentification := randomAlphanumeric(10, alphanumericStyleNormal)
 
 	attrs := []Attribute{
 		WithAttrCountry(country),
@@ -524,6 +680,14 @@ func TestWithAttrFunctions(t *testing.T) {
 		WithAttrAlternativeBankAccountNames(alternativeBankAccountNames...),
 		WithAttrAccountMatchingOptOut(accountMatchingOptOut),
 		WithAttrCustomerID(customerID),
+		WithAttrTitle(title),
+		WithAttrName(name...),
+		WithAttrBankAccountName(bankAccountName),
+		WithAttrAlternativeNames(alternativeNames...),
+		WithAttrAccountClassification(accountClassification),
+		WithAttrStatus(status),
+		WithAttrSwitched(switched),
+		WithAttrSecondaryIdentification(secondaryIdentification),
 	}
 
 	for _, attr := range attrs {
@@ -540,4 +704,29 @@ func TestWithAttrFunctions(t *testing.T) {
 	assert.Equal(t, firstName, attributes.FirstName)
 	assert.Equal(t, alternativeBankAccountNames, attributes.AlternativeBankAccountNames)
 	assert.Equal(t, customerID, attributes.CustomerID)
+	assert.Equal(t, title, attributes.Title)
+	assert.Equal(t, name, attributes.Name)
+	assert.Equal(t, bankAccountName, attributes.BankAccountName)
+	assert.Equal(t, alternativeNames, attributes.AlternativeNames)
+	assert.Equal(t, accountClassification, attributes.AccountClassification)
+	assert.Equal(t, status, attributes.Status)
+	assert.Equal(t, switched, attributes.Switched)
+	assert.Equal(t, secondaryIdentification, attributes.SecondaryIdentification)
+}
+
+func TestAttributesFullName(t *testing.T) {
+	attributes := &Attributes{FirstName: "Samantha"}
+	assert.Equal(t, "Samantha", attributes.FullName())
+
+	attributes.BankAccountName = "Samantha Holder"
+	assert.Equal(t, "Samantha Holder", attributes.FullName())
+
+	attributes.Name = []string{"Samantha", "Jane Holder"}
+	assert.Equal(t, "Samantha Jane Holder", attributes.FullName())
+
+	attributes.AlternativeBankAccountNames = []string{"Sam Holder"}
+	assert.Equal(t, []string{"Sam Holder"}, attributes.AllAlternativeNames())
+
+	attributes.AlternativeNames = []string{"Sammy Holder"}
+	assert.Equal(t, []string{"Sammy Holder"}, attributes.AllAlternativeNames())
 }
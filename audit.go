@@ -0,0 +1,351 @@
+package accountapi
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// Audited operations
+const (
+	AuditOperationCreate = "create"
+	AuditOperationUpdate = "update"
+	AuditOperationDelete = "delete"
+)
+
+// Outcomes of the audited operations
+const (
+	AuditOutcomeSuccess = "success"
+	AuditOutcomeFailure = "failure"
+	AuditOutcomeDryRun  = "dry_run"
+)
+
+// AuditRecord is a line of an AuditLog.
+type AuditRecord struct {
+	// Position of the record in the log, starting at 1.
+	Seq int64 `json:"seq"`
+
+	Time      time.Time `json:"time"`
+	Operation string    `json:"operation"`
+	AccountID string    `json:"account_id"`
+
+	// Organisation of the account. Deletes are made by account ID, their organisation is
+	// set with WithAuditOrganisation or fetched, it's blank if the account can't be fetched.
+	OrganisationID string `json:"organisation_id,omitempty"`
+
+	Version int    `json:"version"`
+	Outcome string `json:"outcome"`
+
+	// Error of a failed operation.
+	Error string `json:"error,omitempty"`
+
+	// Who made the call, see WithAuditActor.
+	Actor string `json:"actor,omitempty"`
+
+	// Hash of the previous record, blank for the first record.
+	PrevHash string `json:"prev_hash"`
+
+	// SHA-256 of the record, encoded as JSON without the hash.
+	Hash string `json:"hash,omitempty"`
+}
+
+// AuditHead identifies the last record of an AuditLog. Keeping it outside the log,
+// e.g. in a database, allows VerifyAuditLogHead to detect a truncated log.
+type AuditHead struct {
+	Seq  int64  `json:"seq"`
+	Hash string `json:"hash"`
+}
+
+// AuditLog writes a hash-chained JSON Lines record of every account created, updated or
+// deleted by a Client, see Client.Audit. Each record holds the hash of the previous one, so edits,
+// removed records and truncation are detected by VerifyAuditLog. It's safe for concurrent use.
+type AuditLog struct {
+	mu   sync.Mutex
+	w    io.Writer
+	file *os.File
+	head AuditHead
+}
+
+// NewAuditLog returns an AuditLog that writes a new chain of records to w.
+func NewAuditLog(w io.Writer) *AuditLog {
+	return &AuditLog{w: w}
+}
+
+// OpenAuditLog opens the audit log file, creating it if needed, and appends to its chain.
+// The existing records are verified first, it returns an AuditLogError if they are invalid.
+// A last record that was cut, e.g. by a crash while it was written, is an AuditLogError
+// wrapping ErrAuditTruncated; RepairAuditLog removes it so the log can be opened again.
+func OpenAuditLog(path string) (*AuditLog, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := VerifyAuditLog(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return &AuditLog{w: file, file: file, head: head}, nil
+}
+
+// RepairAuditLog removes the last line of the audit log file if it was cut, e.g. by a crash
+// while it was written. The complete records are verified first, the file is left unchanged
+// if they are invalid. It returns the last record of the repaired log.
+func RepairAuditLog(path string) (AuditHead, error) {
+	file, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return AuditHead{}, err
+	}
+	defer file.Close()
+
+	// size of the complete lines
+	size := int64(0)
+	reader := bufio.NewReader(file)
+	for {
+		data, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return AuditHead{}, err
+		}
+		size += int64(len(data))
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return AuditHead{}, err
+	}
+	head, err := VerifyAuditLog(io.LimitReader(file, size))
+	if err != nil {
+		return head, err
+	}
+
+	if err := file.Truncate(size); err != nil {
+		return head, err
+	}
+	return head, file.Sync()
+}
+
+// Close closes the file of a log opened with OpenAuditLog.
+func (l *AuditLog) Close() error {
+	if l.file == nil {
+		return nil
+	}
+	return l.file.Close()
+}
+
+// Head returns the last record written to the log.
+func (l *AuditLog) Head() AuditHead {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.head
+}
+
+// Record appends the record to the log, it sets its position in the chain and its hash,
+// and the time if it's not set. Files are synced after every record.
+func (l *AuditLog) Record(record AuditRecord) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if record.Time.IsZero() {
+		record.Time = time.Now()
+	}
+	record.Time = record.Time.UTC()
+	record.Seq = l.head.Seq + 1
+	record.PrevHash = l.head.Hash
+
+	hash, err := auditHash(record)
+	if err != nil {
+		return err
+	}
+	record.Hash = hash
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if _, err := l.w.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	if l.file != nil {
+		if err := l.file.Sync(); err != nil {
+			return err
+		}
+	}
+
+	l.head = AuditHead{Seq: record.Seq, Hash: record.Hash}
+	return nil
+}
+
+// AuditLogError is returned by VerifyAuditLog if a record is invalid.
+type AuditLogError struct {
+	// Line of the record, starting at 1.
+	Line int
+	Err  error
+}
+
+func (e *AuditLogError) Error() string {
+	return fmt.Sprintf("audit log line %d: %v", e.Line, e.Err)
+}
+
+func (e *AuditLogError) Unwrap() error {
+	return e.Err
+}
+
+// errors wrapped by AuditLogError
+var (
+	ErrAuditHashMismatch = errors.New("hash does not match the record")
+	ErrAuditChainBroken  = errors.New("record does not follow the previous record")
+	ErrAuditTruncated    = errors.New("audit log is truncated")
+)
+
+// VerifyAuditLog reads an audit log and checks every record: its hash, its position and
+// that it's chained to the previous record. It returns the last record of the log, or an
+// AuditLogError for the first invalid record. Removing records from the end of a log can
+// only be detected with a head kept elsewhere, see VerifyAuditLogHead.
+func VerifyAuditLog(r io.Reader) (AuditHead, error) {
+	return verifyAuditLog(r, nil)
+}
+
+// VerifyAuditLogHead is VerifyAuditLog for a log whose record at the position of the head
+// is known, the log may have more records after it. It returns ErrAuditTruncated if records
+// were removed from the end of the log, and ErrAuditChainBroken if the record at the position
+// of the head is not the head, e.g. because the log was rewritten.
+func VerifyAuditLogHead(r io.Reader, expected AuditHead) error {
+	head, err := verifyAuditLog(r, func(head AuditHead) error {
+		if head.Seq == expected.Seq && head.Hash != expected.Hash {
+			return ErrAuditChainBroken
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if head.Seq < expected.Seq {
+		return ErrAuditTruncated
+	}
+	return nil
+}
+
+// verifyAuditLog is VerifyAuditLog, it calls check, if set, with every valid record
+// and stops at the first error it returns
+func verifyAuditLog(r io.Reader, check func(head AuditHead) error) (AuditHead, error) {
+	head := AuditHead{}
+	reader := bufio.NewReader(r)
+
+	for line := 1; ; line++ {
+		data, err := reader.ReadBytes('\n')
+		if err == io.EOF && len(data) == 0 {
+			return head, nil
+		}
+		if err != nil && err != io.EOF {
+			return head, err
+		}
+		if err == io.EOF {
+			// every record ends with a new line, the last one was cut
+			return head, &AuditLogError{Line: line, Err: ErrAuditTruncated}
+		}
+
+		var record AuditRecord
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&record); err != nil {
+			return head, &AuditLogError{Line: line, Err: err}
+		}
+
+		hash, err := auditHash(record)
+		if err != nil {
+			return head, &AuditLogError{Line: line, Err: err}
+		}
+		if hash != record.Hash {
+			return head, &AuditLogError{Line: line, Err: ErrAuditHashMismatch}
+		}
+		if record.Seq != head.Seq+1 || record.PrevHash != head.Hash {
+			return head, &AuditLogError{Line: line, Err: ErrAuditChainBroken}
+		}
+
+		head = AuditHead{Seq: record.Seq, Hash: record.Hash}
+		if check != nil {
+			if err := check(head); err != nil {
+				return head, &AuditLogError{Line: line, Err: err}
+			}
+		}
+	}
+}
+
+// auditHash returns the SHA-256 of the record encoded as JSON without its hash
+func auditHash(record AuditRecord) (string, error) {
+	record.Hash = ""
+	data, err := json.Marshal(record)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+type auditActorKey struct{}
+
+// WithAuditActor returns a context that makes the calls of a Client record the actor
+// in its audit log.
+func WithAuditActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, auditActorKey{}, actor)
+}
+
+type auditOrganisationKey struct{}
+
+// WithAuditOrganisation returns a context that makes DeleteAccount record the organisation
+// of the account in the audit log, instead of fetching the account for it.
+func WithAuditOrganisation(ctx context.Context, organisationID string) context.Context {
+	return context.WithValue(ctx, auditOrganisationKey{}, organisationID)
+}
+
+// auditOrganisation returns the organisation of the account set with WithAuditOrganisation,
+// or the organisation of the fetched account, blank if it can't be fetched
+func (c *Client) auditOrganisation(ctx context.Context, id string) string {
+	if organisationID, _ := ctx.Value(auditOrganisationKey{}).(string); organisationID != "" {
+		return organisationID
+	}
+
+	account, err := c.FetchAccount(ctx, id)
+	if err != nil || account.Data == nil {
+		return ""
+	}
+	return account.Data.OrganisationID
+}
+
+// audit records an operation of the client, if it has an audit log. The error
+// of the audit log is returned if the operation itself didn't fail.
+func (c *Client) audit(ctx context.Context, record AuditRecord, err error) error {
+	if c.Audit == nil {
+		return err
+	}
+
+	record.Actor, _ = ctx.Value(auditActorKey{}).(string)
+	switch {
+	case err != nil:
+		record.Outcome = AuditOutcomeFailure
+		record.Error = err.Error()
+	case c.DryRun != nil:
+		record.Outcome = AuditOutcomeDryRun
+	default:
+		record.Outcome = AuditOutcomeSuccess
+	}
+
+	if auditErr := c.Audit.Record(record); auditErr != nil && err == nil {
+		return fmt.Errorf("writing audit record: %w", auditErr)
+	}
+	return err
+}
@@ -0,0 +1,293 @@
+package accountapi_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/alexdreptu/form3-accountapi-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readAuditRecords(t *testing.T, data []byte) []AuditRecord {
+	records := []AuditRecord{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		var record AuditRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+	return records
+}
+
+func TestClient_Audit(t *testing.T) {
+	// the last account created, fetched for the organisation of deletes
+	var created []byte
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodPost:
+				body, _ := ioutil.ReadAll(r.Body)
+				var account Account
+				if err := json.Unmarshal(body, &account); err != nil || account.Validate() != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					_, _ = w.Write([]byte(`{"error_message": "validation failure list"}`))
+					return
+				}
+				created = body
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write(body)
+			case http.MethodGet:
+				_, _ = w.Write(created)
+			case http.MethodDelete:
+				if r.URL.Query().Get("version") != "0" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}
+		},
+	))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	ctx = WithAuditActor(ctx, "alice@example.com")
+
+	var log bytes.Buffer
+	client := NewClient(&http.Client{}, server.URL)
+	client.Audit = NewAuditLog(&log)
+
+	account := newValidateTestAccount(t)
+	_, err := client.CreateAccount(ctx, account)
+	require.NoError(t, err)
+	require.NoError(t, client.DeleteAccount(ctx, account.Data.ID, 0))
+	assert.Error(t, client.DeleteAccount(ctx, account.Data.ID, 1))
+
+	// invalid accounts are recorded too
+	invalid := newValidateTestAccount(t)
+	invalid.Data.Attributes.BankID = "invalid"
+	_, err = client.CreateAccount(context.Background(), invalid)
+	assert.Error(t, err)
+
+	// so are the accounts the server rejects
+	client.SkipValidation = true
+	_, err = client.CreateAccount(ctx, invalid)
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr), err)
+	assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+	assert.Equal(t, "validation failure list", apiErr.Message)
+
+	// the organisation of a delete can be set instead of fetched
+	client.DryRun = NewDryRun()
+	require.NoError(t, client.DeleteAccount(WithAuditOrganisation(ctx, "other"), account.Data.ID, 0))
+
+	records := readAuditRecords(t, log.Bytes())
+	require.Len(t, records, 6)
+
+	create := records[0]
+	assert.Equal(t, int64(1), create.Seq)
+	assert.Equal(t, AuditOperationCreate, create.Operation)
+	assert.Equal(t, account.Data.ID, create.AccountID)
+	assert.Equal(t, account.Data.OrganisationID, create.OrganisationID)
+	assert.Equal(t, AuditOutcomeSuccess, create.Outcome)
+	assert.Equal(t, "alice@example.com", create.Actor)
+	assert.Empty(t, create.PrevHash)
+	assert.WithinDuration(t, time.Now(), create.Time, time.Minute)
+
+	assert.Equal(t, AuditOperationDelete, records[1].Operation)
+	assert.Equal(t, AuditOutcomeSuccess, records[1].Outcome)
+	assert.Equal(t, account.Data.OrganisationID, records[1].OrganisationID)
+	assert.Equal(t, create.Hash, records[1].PrevHash)
+
+	assert.Equal(t, AuditOutcomeFailure, records[2].Outcome)
+	assert.Equal(t, 1, records[2].Version)
+	assert.Equal(t, account.Data.OrganisationID, records[2].OrganisationID)
+	assert.NotEmpty(t, records[2].Error)
+
+	assert.Equal(t, AuditOperationCreate, records[3].Operation)
+	assert.Equal(t, AuditOutcomeFailure, records[3].Outcome)
+	assert.Empty(t, records[3].Actor)
+
+	assert.Equal(t, AuditOutcomeFailure, records[4].Outcome)
+	assert.Equal(t, apiErr.Error(), records[4].Error)
+
+	assert.Equal(t, AuditOutcomeDryRun, records[5].Outcome)
+	assert.Equal(t, "other", records[5].OrganisationID)
+
+	head, err := VerifyAuditLog(bytes.NewReader(log.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, client.Audit.Head(), head)
+	assert.Equal(t, AuditHead{Seq: 6, Hash: records[5].Hash}, head)
+}
+
+// writeTestAuditLog writes n records and returns the log and its head
+func writeTestAuditLog(t *testing.T, n int) ([]byte, AuditHead) {
+	var log bytes.Buffer
+	audit := NewAuditLog(&log)
+	for i := 0; i < n; i++ {
+		require.NoError(t, audit.Record(AuditRecord{
+			Operation: AuditOperationCreate,
+			AccountID: "ad27e265-9605-4b4b-a0e5-3003ea9cc4dc",
+			Version:   i,
+			Outcome:   AuditOutcomeSuccess,
+		}))
+	}
+	return log.Bytes(), audit.Head()
+}
+
+func TestVerifyAuditLog(t *testing.T) {
+	log, head := writeTestAuditLog(t, 4)
+	lines := strings.SplitAfter(string(log), "\n")[:4]
+
+	verify := func(lines ...string) error {
+		_, err := VerifyAuditLog(strings.NewReader(strings.Join(lines, "")))
+		return err
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		got, err := VerifyAuditLog(bytes.NewReader(log))
+		require.NoError(t, err)
+		assert.Equal(t, head, got)
+		assert.NoError(t, VerifyAuditLogHead(bytes.NewReader(log), head))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		got, err := VerifyAuditLog(strings.NewReader(""))
+		require.NoError(t, err)
+		assert.Equal(t, AuditHead{}, got)
+	})
+
+	t.Run("edited record", func(t *testing.T) {
+		edited := strings.Replace(lines[1], `"version":1`, `"version":7`, 1)
+		require.NotEqual(t, lines[1], edited)
+
+		err := verify(lines[0], edited, lines[2], lines[3])
+		var logErr *AuditLogError
+		require.True(t, errors.As(err, &logErr), err)
+		assert.Equal(t, 2, logErr.Line)
+		assert.True(t, errors.Is(err, ErrAuditHashMismatch))
+	})
+
+	t.Run("removed record", func(t *testing.T) {
+		err := verify(lines[0], lines[2], lines[3])
+		var logErr *AuditLogError
+		require.True(t, errors.As(err, &logErr), err)
+		assert.Equal(t, 2, logErr.Line)
+		assert.True(t, errors.Is(err, ErrAuditChainBroken))
+	})
+
+	t.Run("removed first record", func(t *testing.T) {
+		assert.True(t, errors.Is(verify(lines[1:]...), ErrAuditChainBroken))
+	})
+
+	t.Run("reordered records", func(t *testing.T) {
+		assert.True(t, errors.Is(verify(lines[0], lines[2], lines[1], lines[3]), ErrAuditChainBroken))
+	})
+
+	t.Run("cut record", func(t *testing.T) {
+		err := verify(lines[0], lines[1], lines[2][:len(lines[2])/2])
+		var logErr *AuditLogError
+		require.True(t, errors.As(err, &logErr), err)
+		assert.Equal(t, 3, logErr.Line)
+		assert.True(t, errors.Is(err, ErrAuditTruncated))
+	})
+
+	t.Run("removed last records", func(t *testing.T) {
+		// a shorter log is still a valid chain, only the head tells it's truncated
+		assert.NoError(t, verify(lines[:2]...))
+		err := VerifyAuditLogHead(strings.NewReader(strings.Join(lines[:2], "")), head)
+		assert.True(t, errors.Is(err, ErrAuditTruncated))
+	})
+
+	t.Run("replaced log", func(t *testing.T) {
+		other, _ := writeTestAuditLog(t, 4)
+		err := VerifyAuditLogHead(bytes.NewReader(other), head)
+		assert.True(t, errors.Is(err, ErrAuditChainBroken))
+	})
+
+	t.Run("rewritten and extended log", func(t *testing.T) {
+		// a log rewritten with valid hashes has other records at the position of the head
+		other, _ := writeTestAuditLog(t, 5)
+		err := VerifyAuditLogHead(bytes.NewReader(other), head)
+		var logErr *AuditLogError
+		require.True(t, errors.As(err, &logErr), err)
+		assert.Equal(t, 4, logErr.Line)
+		assert.True(t, errors.Is(err, ErrAuditChainBroken))
+
+		// a log extended after the head is valid
+		assert.NoError(t, VerifyAuditLogHead(bytes.NewReader(log), AuditHead{Seq: 2, Hash: readAuditRecords(t, log)[1].Hash}))
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		edited := strings.Replace(lines[1], `{`, `{"note":"x",`, 1)
+		var logErr *AuditLogError
+		assert.True(t, errors.As(verify(lines[0], edited), &logErr))
+	})
+}
+
+func TestOpenAuditLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "audit.jsonl")
+
+	record := AuditRecord{
+		Operation: AuditOperationDelete,
+		AccountID: "ad27e265-9605-4b4b-a0e5-3003ea9cc4dc",
+		Outcome:   AuditOutcomeSuccess,
+	}
+
+	audit, err := OpenAuditLog(file)
+	require.NoError(t, err)
+	require.NoError(t, audit.Record(record))
+	require.NoError(t, audit.Close())
+
+	// the chain goes on after the records of the file
+	audit, err = OpenAuditLog(file)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), audit.Head().Seq)
+	require.NoError(t, audit.Record(record))
+	head := audit.Head()
+	require.NoError(t, audit.Close())
+
+	data, err := ioutil.ReadFile(file)
+	require.NoError(t, err)
+	require.NoError(t, VerifyAuditLogHead(bytes.NewReader(data), head))
+	assert.Equal(t, int64(2), head.Seq)
+
+	// a cut last record is removed by RepairAuditLog
+	require.NoError(t, ioutil.WriteFile(file, append(data, `{"seq":3,"ti`...), 0600))
+	_, err = OpenAuditLog(file)
+	assert.True(t, errors.Is(err, ErrAuditTruncated))
+	repaired, err := RepairAuditLog(file)
+	require.NoError(t, err)
+	assert.Equal(t, head, repaired)
+	audit, err = OpenAuditLog(file)
+	require.NoError(t, err)
+	assert.Equal(t, head, audit.Head())
+	require.NoError(t, audit.Close())
+
+	// a tampered log is not extended, nor repaired
+	tampered := bytes.Replace(data, []byte("delete"), []byte("create"), 1)
+	require.NoError(t, ioutil.WriteFile(file, tampered, 0600))
+	_, err = OpenAuditLog(file)
+	assert.True(t, errors.Is(err, ErrAuditHashMismatch))
+	_, err = RepairAuditLog(file)
+	assert.True(t, errors.Is(err, ErrAuditHashMismatch))
+	unchanged, err := ioutil.ReadFile(file)
+	require.NoError(t, err)
+	assert.Equal(t, tampered, unchanged)
+}
@@ -0,0 +1,117 @@
+// Command accountapi-emulator serves /v1/organisation/accounts like the fake Form3
+// account API, so the client can be run and tested without the API image, its
+// database and vault.
+//
+//	accountapi-emulator -addr :8080 -data accounts.json -seed seed.json
+//
+// Accounts are validated with Account.Validate, invalid payloads are answered with
+// 400 and an error_message. Accounts have versions: updates of other versions and
+// duplicate IDs are answered with 409, deletes of other versions with 404 like the
+// account API. Lists are paginated with
+// page[number] and page[size] and have links to the first, last, previous and next
+// pages. The accounts are written to the -data file after every change and read
+// from it at startup; the accounts of the -seed file that don't exist yet are then
+// created. Both files are accounts documents, like the list response.
+package main
+
+import (
+	"context"
+	"flag"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stderr); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run starts the emulator and serves until it's interrupted
+func run(args []string, stderr io.Writer) error {
+	fs := flag.NewFlagSet("accountapi-emulator", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	addr := fs.String("addr", ":8080", "address to listen on")
+	dataFile := fs.String("data", "accounts.json", "file the accounts are kept in, empty to keep them in memory")
+	seedFile := fs.String("seed", "", "file with accounts to create at startup if they don't exist")
+	quiet := fs.Bool("quiet", false, "don't log the requests")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	logger := log.New(stderr, "", log.LstdFlags)
+	handler, err := newHandler(*dataFile, *seedFile, logger)
+	if err != nil {
+		return err
+	}
+	if !*quiet {
+		handler = logRequests(handler, logger)
+	}
+
+	srv := &http.Server{Addr: *addr, Handler: handler}
+	errs := make(chan error, 1)
+	go func() {
+		logger.Printf("listening on %s", *addr)
+		errs <- srv.ListenAndServe()
+	}()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	select {
+	case err := <-errs:
+		return err
+	case <-signals:
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return srv.Shutdown(ctx)
+}
+
+// newHandler opens the store of the data file and seeds it
+func newHandler(dataFile, seedFile string, logger *log.Logger) (http.Handler, error) {
+	s, err := openStore(dataFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if seedFile != "" {
+		accounts, err := readAccounts(seedFile)
+		if err != nil {
+			return nil, err
+		}
+		n, err := s.seed(accounts)
+		if err != nil {
+			return nil, err
+		}
+		logger.Printf("seeded %d of %d account(s) from %s", n, len(accounts), seedFile)
+	}
+
+	return &server{store: s}, nil
+}
+
+// statusRecorder keeps the status code of the response
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// logRequests logs the method, URL, status and duration of the requests
+func logRequests(next http.Handler, logger *log.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		logger.Printf("%s %s %d %s", r.Method, r.URL.RequestURI(), rec.status, time.Since(start))
+	})
+}
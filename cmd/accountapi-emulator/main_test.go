@@ -0,0 +1,232 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	accountapi "github.com/alexdreptu/form3-accountapi-client"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testOrganisationID = "eb0bd6f5-c3f5-44b2-b677-acd23cdde73c"
+
+func newTestAccount(t *testing.T, bankID string) *accountapi.Account {
+	account, err := accountapi.NewAccount(&accountapi.Options{
+		Type:           "accounts",
+		ID:             uuid.New().String(),
+		OrganisationID: testOrganisationID,
+		Attributes: []accountapi.Attribute{
+			accountapi.WithAttrCountry(accountapi.CountryUnitedKingdom),
+			accountapi.WithAttrBankID(bankID),
+			accountapi.WithAttrBankIDCode(accountapi.BankIDCodeUnitedKingdom),
+			accountapi.WithAttrBIC("NWBKGB22"),
+			accountapi.WithAttrName("Samantha Holder"),
+		},
+	})
+	require.NoError(t, err)
+	return account
+}
+
+// startEmulator serves the emulator with the data and seed files and returns
+// a client of it
+func startEmulator(t *testing.T, dataFile, seedFile string) (*httptest.Server, *accountapi.Client) {
+	handler, err := newHandler(dataFile, seedFile, log.New(ioutil.Discard, "", 0))
+	require.NoError(t, err)
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server, accountapi.NewClient(&http.Client{}, server.URL+accountsPath)
+}
+
+func testContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func TestEmulator(t *testing.T) {
+	_, client := startEmulator(t, "", "")
+	ctx := testContext(t)
+
+	account := newTestAccount(t, "400300")
+	created, err := client.CreateAccount(ctx, account)
+	require.NoError(t, err)
+	assert.Equal(t, 0, created.Data.Version)
+	assert.NotEmpty(t, created.Data.CreatedOn)
+	assert.Equal(t, accountsPath+"/"+account.Data.ID, created.Links.Self)
+
+	_, err = client.CreateAccount(ctx, account)
+	var duplicate *accountapi.DuplicateAccountError
+	assert.True(t, errors.As(err, &duplicate))
+
+	fetched, err := client.FetchAccount(ctx, account.Data.ID)
+	require.NoError(t, err)
+	assert.Equal(t, created.Data.Attributes.BankID, fetched.Data.Attributes.BankID)
+
+	_, err = client.FetchAccount(ctx, uuid.New().String())
+	var notExists *accountapi.ResourceNotExistsError
+	assert.True(t, errors.As(err, &notExists))
+
+	// the attributes of an update are merged and the version is incremented
+	client.SkipValidation = true
+	update := &accountapi.Account{Data: &accountapi.Data{
+		Details:    fetched.Data.Details,
+		Attributes: &accountapi.Attributes{Name: []string{"Sam Holder"}},
+	}}
+	updated, err := client.UpdateAccount(ctx, update)
+	require.NoError(t, err)
+	assert.Equal(t, 1, updated.Data.Version)
+	assert.Equal(t, []string{"Sam Holder"}, updated.Data.Attributes.Name)
+	assert.Equal(t, "400300", updated.Data.Attributes.BankID)
+	assert.Equal(t, created.Data.CreatedOn, updated.Data.CreatedOn)
+
+	_, err = client.UpdateAccount(ctx, update)
+	var conflict *accountapi.VersionConflictError
+	assert.True(t, errors.As(err, &conflict))
+
+	// like the account API, deletes of other versions are answered with 404
+	err = client.DeleteAccount(ctx, account.Data.ID, 0)
+	assert.True(t, errors.As(err, &notExists))
+	require.NoError(t, client.DeleteAccount(ctx, account.Data.ID, 1))
+	err = client.DeleteAccount(ctx, account.Data.ID, 1)
+	assert.True(t, errors.As(err, &notExists))
+}
+
+func TestEmulator_List(t *testing.T) {
+	_, client := startEmulator(t, "", "")
+	ctx := testContext(t)
+
+	ids := []string{}
+	for i := 0; i < 7; i++ {
+		account := newTestAccount(t, fmt.Sprintf("40030%d", i%2))
+		_, err := client.CreateAccount(ctx, account)
+		require.NoError(t, err)
+		ids = append(ids, account.Data.ID)
+	}
+
+	// accounts are listed in the order they were created
+	accounts, err := client.ListAccounts(ctx, 1, 3)
+	require.NoError(t, err)
+	require.Len(t, accounts.Data, 3)
+	assert.Equal(t, ids[3], accounts.Data[0].ID)
+	assert.Equal(t, accountapi.Links{
+		Self:  accountsPath + "?page%5Bnumber%5D=1&page%5Bsize%5D=3",
+		First: accountsPath + "?page%5Bnumber%5D=0&page%5Bsize%5D=3",
+		Last:  accountsPath + "?page%5Bnumber%5D=2&page%5Bsize%5D=3",
+		Next:  accountsPath + "?page%5Bnumber%5D=2&page%5Bsize%5D=3",
+		Prev:  accountsPath + "?page%5Bnumber%5D=0&page%5Bsize%5D=3",
+	}, accounts.Links)
+
+	accounts, err = client.ListAccounts(ctx, 2, 3)
+	require.NoError(t, err)
+	assert.Len(t, accounts.Data, 1)
+	assert.Empty(t, accounts.Links.Next)
+
+	accounts, err = client.ListAccounts(ctx, 0, 100, accountapi.WithFilterBankID("400301"))
+	require.NoError(t, err)
+	assert.Len(t, accounts.Data, 3)
+	assert.Equal(t, ids[1], accounts.Data[0].ID)
+	assert.Contains(t, accounts.Links.Self, "filter%5Bbank_id%5D=400301")
+
+	accounts, err = client.ListAccounts(ctx, 0, 100, accountapi.WithFilterOrganisationID(testOrganisationID))
+	require.NoError(t, err)
+	assert.Len(t, accounts.Data, 7)
+	accounts, err = client.ListAccounts(ctx, 0, 100, accountapi.WithFilterOrganisationID(uuid.New().String()))
+	require.NoError(t, err)
+	assert.Empty(t, accounts.Data)
+}
+
+func TestEmulator_BadRequests(t *testing.T) {
+	server, _ := startEmulator(t, "", "")
+
+	do := func(method, path, body string) (int, string) {
+		req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+		require.NoError(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		var result struct {
+			ErrorMessage string `json:"error_message"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&result)
+		return resp.StatusCode, result.ErrorMessage
+	}
+
+	status, message := do(http.MethodPost, accountsPath, `{"data": {"type": "accounts", "id": "`+uuid.New().String()+
+		`", "organisation_id": "`+testOrganisationID+`", "attributes": {"country": "GB", "bank_id": "1", "bic": "NWBKGB22"}}}`)
+	assert.Equal(t, http.StatusBadRequest, status)
+	assert.True(t, strings.HasPrefix(message, "validation failure list:\n"), message)
+	assert.Contains(t, message, "bank_id in body")
+	assert.Contains(t, message, "bank_id_code in body")
+
+	status, _ = do(http.MethodPost, accountsPath, `{"data": `)
+	assert.Equal(t, http.StatusBadRequest, status)
+	status, message = do(http.MethodGet, accountsPath+"/1234", "")
+	assert.Equal(t, http.StatusBadRequest, status)
+	assert.Equal(t, "id is not a valid uuid", message)
+	status, _ = do(http.MethodDelete, accountsPath+"/"+uuid.New().String(), "")
+	assert.Equal(t, http.StatusBadRequest, status)
+	status, _ = do(http.MethodGet, accountsPath+"?page[size]=0", "")
+	assert.Equal(t, http.StatusBadRequest, status)
+	status, _ = do(http.MethodPut, accountsPath, "")
+	assert.Equal(t, http.StatusMethodNotAllowed, status)
+	status, _ = do(http.MethodGet, "/v1/health", "")
+	assert.Equal(t, http.StatusOK, status)
+}
+
+func TestEmulator_Persistence(t *testing.T) {
+	dir, err := ioutil.TempDir("", "emulator")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	dataFile := filepath.Join(dir, "accounts.json")
+	seedFile := filepath.Join(dir, "seed.json")
+
+	seeded := newTestAccount(t, "400300")
+	seed, err := json.Marshal(accountapi.Accounts{Data: []accountapi.Data{*seeded.Data}})
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(seedFile, seed, 0600))
+
+	server, client := startEmulator(t, dataFile, seedFile)
+	created := newTestAccount(t, "400301")
+	_, err = client.CreateAccount(testContext(t), created)
+	require.NoError(t, err)
+	require.NoError(t, client.DeleteAccount(testContext(t), seeded.Data.ID, 0))
+	server.Close()
+
+	// the accounts are read back from the data file
+	_, client = startEmulator(t, dataFile, "")
+	accounts, err := client.ListAccounts(testContext(t), 0, 100)
+	require.NoError(t, err)
+	require.Len(t, accounts.Data, 1)
+	assert.Equal(t, created.Data.ID, accounts.Data[0].ID)
+
+	// seed accounts that don't exist are created again
+	_, client = startEmulator(t, dataFile, seedFile)
+	accounts, err = client.ListAccounts(testContext(t), 0, 100)
+	require.NoError(t, err)
+	assert.Len(t, accounts.Data, 2)
+
+	// invalid seed accounts stop the startup
+	seeded.Data.Attributes.BankID = "1"
+	seed, err = json.Marshal(accountapi.Accounts{Data: []accountapi.Data{*seeded.Data}})
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(seedFile, seed, 0600))
+	_, err = newHandler(filepath.Join(dir, "other.json"), seedFile, log.New(ioutil.Discard, "", 0))
+	assert.Error(t, err)
+
+	var logs bytes.Buffer
+	assert.Error(t, run([]string{"-unknown"}, &logs))
+}
@@ -0,0 +1,338 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	accountapi "github.com/alexdreptu/form3-accountapi-client"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+const (
+	accountsPath = "/v1/organisation/accounts"
+	healthPath   = "/v1/health"
+
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// listFilters returns the attribute each filter of list matches, by query parameter
+var listFilters = map[string]func(*accountapi.Attributes) string{
+	"filter[country]":        func(a *accountapi.Attributes) string { return a.Country },
+	"filter[bank_id]":        func(a *accountapi.Attributes) string { return a.BankID },
+	"filter[bank_id_code]":   func(a *accountapi.Attributes) string { return a.BankIDCode },
+	"filter[account_number]": func(a *accountapi.Attributes) string { return a.AccountNumber },
+	"filter[customer_id]":    func(a *accountapi.Attributes) string { return a.CustomerID },
+}
+
+// server answers the account API requests from the store
+type server struct {
+	store *store
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == healthPath && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]string{"status": "up"})
+
+	case r.URL.Path == accountsPath || r.URL.Path == accountsPath+"/":
+		switch r.Method {
+		case http.MethodPost:
+			s.create(w, r)
+		case http.MethodGet:
+			s.list(w, r)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+
+	case strings.HasPrefix(r.URL.Path, accountsPath+"/"):
+		id := strings.TrimPrefix(r.URL.Path, accountsPath+"/")
+		if _, err := uuid.Parse(id); err != nil || strings.Contains(id, "/") {
+			writeError(w, http.StatusBadRequest, "id is not a valid uuid")
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			s.fetch(w, id)
+		case http.MethodPatch:
+			s.update(w, r, id)
+		case http.MethodDelete:
+			s.delete(w, r, id)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (s *server) create(w http.ResponseWriter, r *http.Request) {
+	account, ok := readAccount(w, r)
+	if !ok {
+		return
+	}
+	if err := account.Validate(); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	d, err := s.store.create(*account.Data)
+	if err != nil {
+		writeStoreError(w, account.Data.ID, err)
+		return
+	}
+	writeAccount(w, http.StatusCreated, d)
+}
+
+func (s *server) fetch(w http.ResponseWriter, id string) {
+	d, err := s.store.get(id)
+	if err != nil {
+		writeStoreError(w, id, err)
+		return
+	}
+	writeAccount(w, http.StatusOK, d)
+}
+
+// list answers a page of the accounts, page[number] is a number, 'first' or 'last'
+func (s *server) list(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	size := defaultPageSize
+	if value := query.Get("page[size]"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 || n > maxPageSize {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("page[size] must be between 1 and %d", maxPageSize))
+			return
+		}
+		size = n
+	}
+
+	match := func(d *accountapi.Data) bool {
+		if value := query.Get("filter[organisation_id]"); value != "" && !contains(strings.Split(value, ","), d.OrganisationID) {
+			return false
+		}
+		for param, attribute := range listFilters {
+			value := query.Get(param)
+			if value == "" {
+				continue
+			}
+			if d.Attributes == nil || !contains(strings.Split(value, ","), attribute(d.Attributes)) {
+				return false
+			}
+		}
+		return true
+	}
+
+	// the number of accounts is needed for the last page
+	_, total := s.store.list(0, size, match)
+	last := 0
+	if total > 0 {
+		last = (total - 1) / size
+	}
+
+	page := 0
+	switch value := query.Get("page[number]"); value {
+	case "", "first":
+	case "last":
+		page = last
+	default:
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			writeError(w, http.StatusBadRequest, "page[number] must be a number, 'first' or 'last'")
+			return
+		}
+		page = n
+	}
+
+	accounts, _ := s.store.list(page, size, match)
+	links := accountapi.Links{
+		Self:  pageLink(query, page, size),
+		First: pageLink(query, 0, size),
+		Last:  pageLink(query, last, size),
+	}
+	if page < last {
+		links.Next = pageLink(query, page+1, size)
+	}
+	if page > 0 {
+		links.Prev = pageLink(query, page-1, size)
+	}
+	writeJSON(w, http.StatusOK, accountapi.Accounts{Data: accounts, Links: links})
+}
+
+// update merges the attributes of the request into the account with the version of the request
+func (s *server) update(w http.ResponseWriter, r *http.Request, id string) {
+	account, ok := readAccount(w, r)
+	if !ok {
+		return
+	}
+	if account.Data.ID != "" && account.Data.ID != id {
+		writeError(w, http.StatusBadRequest, "id in body does not match the id in the path")
+		return
+	}
+
+	var invalid error
+	d, err := s.store.update(id, account.Data.Version, func(current accountapi.Data) (accountapi.Data, error) {
+		updated, err := mergeAttributes(current.Attributes, account.Data.Attributes)
+		if err != nil {
+			return accountapi.Data{}, err
+		}
+		current.Attributes = updated
+		if err := (&accountapi.Account{Data: &current}).Validate(); err != nil {
+			invalid = err
+			return accountapi.Data{}, err
+		}
+		return current, nil
+	})
+	if invalid != nil {
+		writeValidationError(w, invalid)
+		return
+	}
+	if err != nil {
+		writeStoreError(w, id, err)
+		return
+	}
+	writeAccount(w, http.StatusOK, d)
+}
+
+func (s *server) delete(w http.ResponseWriter, r *http.Request, id string) {
+	version, err := strconv.Atoi(r.URL.Query().Get("version"))
+	if err != nil || version < 0 {
+		writeError(w, http.StatusBadRequest, "invalid version number")
+		return
+	}
+
+	// the account API answers deletes of other versions with 404, not 409
+	err = s.store.delete(id, version)
+	if errors.Is(err, errVersion) {
+		writeError(w, http.StatusNotFound, "specified version incorrect")
+		return
+	}
+	if err != nil {
+		writeStoreError(w, id, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// readAccount decodes the account of the request, it answers 400 if it can't
+func readAccount(w http.ResponseWriter, r *http.Request) (*accountapi.Account, bool) {
+	account := &accountapi.Account{}
+	if err := json.NewDecoder(r.Body).Decode(account); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return nil, false
+	}
+	if account.Data == nil || account.Data.Attributes == nil {
+		writeError(w, http.StatusBadRequest, "validation failure list:\ndata.attributes in body is required")
+		return nil, false
+	}
+	return account, true
+}
+
+// mergeAttributes returns the current attributes with the members set in the patch replaced
+func mergeAttributes(current, patch *accountapi.Attributes) (*accountapi.Attributes, error) {
+	members := map[string]json.RawMessage{}
+	for _, a := range []*accountapi.Attributes{current, patch} {
+		if a == nil {
+			continue
+		}
+		data, err := json.Marshal(a)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &members); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := json.Marshal(members)
+	if err != nil {
+		return nil, err
+	}
+	merged := &accountapi.Attributes{}
+	if err := json.Unmarshal(data, merged); err != nil {
+		return nil, err
+	}
+	return merged, nil
+}
+
+// pageLink returns the link to a page, with the filters of the query
+func pageLink(query url.Values, page, size int) string {
+	params := url.Values{}
+	for param, values := range query {
+		if strings.HasPrefix(param, "filter[") {
+			params[param] = values
+		}
+	}
+	params.Set("page[number]", strconv.Itoa(page))
+	params.Set("page[size]", strconv.Itoa(size))
+	return accountsPath + "?" + params.Encode()
+}
+
+func writeAccount(w http.ResponseWriter, status int, d accountapi.Data) {
+	writeJSON(w, status, accountapi.Account{
+		Data:  &d,
+		Links: &accountapi.Links{Self: accountsPath + "/" + d.ID},
+	})
+}
+
+// writeStoreError answers the errors of the store like the account API
+func writeStoreError(w http.ResponseWriter, id string, err error) {
+	switch {
+	case errors.Is(err, errNotFound):
+		writeError(w, http.StatusNotFound, fmt.Sprintf("record %s does not exist", id))
+	case errors.Is(err, errDuplicate):
+		writeError(w, http.StatusConflict, "Account cannot be created as it violates a duplicate constraint")
+	case errors.Is(err, errVersion):
+		writeError(w, http.StatusConflict, "invalid version")
+	default:
+		writeError(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+// writeValidationError answers 400 with the errors of Account.Validate, a line per field
+func writeValidationError(w http.ResponseWriter, err error) {
+	var errs validation.Errors
+	if !errors.As(err, &errs) {
+		writeError(w, http.StatusBadRequest, "validation failure list:\n"+err.Error())
+		return
+	}
+
+	fields := make([]string, 0, len(errs))
+	for field := range errs {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	lines := []string{"validation failure list:"}
+	for _, field := range fields {
+		lines = append(lines, fmt.Sprintf("%s in body: %v", field, errs[field]))
+	}
+	writeError(w, http.StatusBadRequest, strings.Join(lines, "\n"))
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error_message": message})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/vnd.api+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,254 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	accountapi "github.com/alexdreptu/form3-accountapi-client"
+)
+
+// errors of the store, answered with 404 and 409
+var (
+	errNotFound  = errors.New("account not found")
+	errDuplicate = errors.New("duplicate account")
+	errVersion   = errors.New("invalid version")
+)
+
+// store keeps the accounts in the order they were created. If it has a file, the
+// accounts are written to it, as an accounts document, after every change.
+type store struct {
+	mu       sync.Mutex
+	file     string
+	ids      []string
+	accounts map[string]accountapi.Data
+
+	// now returns the time of the changes
+	now func() time.Time
+}
+
+// openStore returns a store with the accounts of the file, if it exists.
+// With no file the accounts are only kept in memory.
+func openStore(file string) (*store, error) {
+	s := &store{file: file, accounts: map[string]accountapi.Data{}, now: time.Now}
+	if file == "" {
+		return s, nil
+	}
+
+	accounts, err := readAccounts(file)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range accounts {
+		s.ids = append(s.ids, d.ID)
+		s.accounts[d.ID] = d
+	}
+	return s, nil
+}
+
+// readAccounts reads an accounts document, the format of the data and seed files
+func readAccounts(file string) ([]accountapi.Data, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var accounts accountapi.Accounts
+	if err := json.Unmarshal(data, &accounts); err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	return accounts.Data, nil
+}
+
+// seed creates the accounts that don't exist yet and returns how many were created.
+// The accounts are validated, the first invalid one stops the seeding.
+func (s *store) seed(accounts []accountapi.Data) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n := 0
+	for i := range accounts {
+		d := accounts[i]
+		if err := (&accountapi.Account{Data: &d}).Validate(); err != nil {
+			return n, fmt.Errorf("seed account '%s': %w", d.ID, err)
+		}
+		if _, ok := s.accounts[d.ID]; ok {
+			continue
+		}
+		s.add(d)
+		n++
+	}
+	if n == 0 {
+		return 0, nil
+	}
+	return n, s.save()
+}
+
+// create adds the account with version 0, the account must be valid
+func (s *store) create(d accountapi.Data) (accountapi.Data, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.accounts[d.ID]; ok {
+		return accountapi.Data{}, errDuplicate
+	}
+
+	now := s.timestamp()
+	d.Version = 0
+	d.CreatedOn = now
+	d.ModifiedOn = now
+	s.add(d)
+
+	if err := s.save(); err != nil {
+		s.remove(d.ID)
+		return accountapi.Data{}, err
+	}
+	return d, nil
+}
+
+func (s *store) get(id string) (accountapi.Data, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, ok := s.accounts[id]
+	if !ok {
+		return accountapi.Data{}, errNotFound
+	}
+	return d, nil
+}
+
+// list returns the page of the accounts that match, and the number of accounts that match
+func (s *store) list(page, size int, match func(*accountapi.Data) bool) ([]accountapi.Data, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	accounts := []accountapi.Data{}
+	total := 0
+	for _, id := range s.ids {
+		d := s.accounts[id]
+		if !match(&d) {
+			continue
+		}
+		if total >= page*size && total < (page+1)*size {
+			accounts = append(accounts, d)
+		}
+		total++
+	}
+	return accounts, total
+}
+
+// update replaces the account with the version by the result of change,
+// which may return an error to leave the account unchanged
+func (s *store) update(id string, version int, change func(accountapi.Data) (accountapi.Data, error)) (accountapi.Data, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, ok := s.accounts[id]
+	if !ok {
+		return accountapi.Data{}, errNotFound
+	}
+	if current.Version != version {
+		return accountapi.Data{}, errVersion
+	}
+
+	d, err := change(current)
+	if err != nil {
+		return accountapi.Data{}, err
+	}
+	d.Details = current.Details
+	d.Version = current.Version + 1
+	d.ModifiedOn = s.timestamp()
+	s.accounts[id] = d
+
+	if err := s.save(); err != nil {
+		s.accounts[id] = current
+		return accountapi.Data{}, err
+	}
+	return d, nil
+}
+
+// delete removes the account with the version
+func (s *store) delete(id string, version int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, ok := s.accounts[id]
+	if !ok {
+		return errNotFound
+	}
+	if current.Version != version {
+		return errVersion
+	}
+
+	ids := s.ids
+	s.remove(id)
+	if err := s.save(); err != nil {
+		s.ids = ids
+		s.accounts[id] = current
+		return err
+	}
+	return nil
+}
+
+func (s *store) add(d accountapi.Data) {
+	s.ids = append(s.ids, d.ID)
+	s.accounts[d.ID] = d
+}
+
+func (s *store) remove(id string) {
+	ids := make([]string, 0, len(s.ids))
+	for _, other := range s.ids {
+		if other != id {
+			ids = append(ids, other)
+		}
+	}
+	s.ids = ids
+	delete(s.accounts, id)
+}
+
+func (s *store) timestamp() string {
+	return s.now().UTC().Format(time.RFC3339Nano)
+}
+
+// save writes the accounts to the file, through a temporary file so
+// the file is never left half written
+func (s *store) save() error {
+	if s.file == "" {
+		return nil
+	}
+
+	accounts := accountapi.Accounts{Data: make([]accountapi.Data, 0, len(s.ids))}
+	for _, id := range s.ids {
+		accounts.Data = append(accounts.Data, s.accounts[id])
+	}
+	data, err := json.MarshalIndent(accounts, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(s.file), filepath.Base(s.file)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.file)
+}
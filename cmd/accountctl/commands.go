@@ -0,0 +1,173 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	accountapi "github.com/alexdreptu/form3-accountapi-client"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func runCreate(e *env, args []string) error {
+	fs := e.newFlagSet("create", "")
+	var flags accountFlags
+	flags.register(fs)
+	if _, err := e.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	account, err := flags.account(e.stdin)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := e.callContext()
+	defer cancel()
+
+	created, err := e.client().CreateAccount(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	return e.printAccount(created)
+}
+
+func runGet(e *env, args []string) error {
+	fs := e.newFlagSet("get", "<account id>")
+	arguments, err := e.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := e.callContext()
+	defer cancel()
+
+	account, err := e.client().FetchAccount(ctx, arguments[0])
+	if err != nil {
+		return err
+	}
+
+	return e.printAccount(account)
+}
+
+func runList(e *env, args []string) error {
+	fs := e.newFlagSet("list", "")
+	page := fs.Int("page", 0, "page number, starting at 0")
+	size := fs.Int("size", 100, "page size")
+	country := fs.String("country", "", "only accounts of the country")
+	bankID := fs.String("bank-id", "", "only accounts with the bank ID")
+	bankIDCode := fs.String("bank-id-code", "", "only accounts with the bank ID code")
+	accountNumber := fs.String("account-number", "", "only accounts with the account number")
+	customerID := fs.String("customer-id", "", "only accounts with the customer ID")
+	if _, err := e.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	filters := []accountapi.ListFilter{}
+	if *country != "" {
+		filters = append(filters, accountapi.WithFilterCountry(*country))
+	}
+	if *bankID != "" {
+		filters = append(filters, accountapi.WithFilterBankID(*bankID))
+	}
+	if *bankIDCode != "" {
+		filters = append(filters, accountapi.WithFilterBankIDCode(*bankIDCode))
+	}
+	if *accountNumber != "" {
+		filters = append(filters, accountapi.WithFilterAccountNumber(*accountNumber))
+	}
+	if *customerID != "" {
+		filters = append(filters, accountapi.WithFilterCustomerID(*customerID))
+	}
+
+	ctx, cancel := e.callContext()
+	defer cancel()
+
+	accounts, err := e.client().ListAccounts(ctx, *page, *size, filters...)
+	if err != nil {
+		return err
+	}
+
+	return e.printAccounts(accounts)
+}
+
+func runDelete(e *env, args []string) error {
+	fs := e.newFlagSet("delete", "<account id>")
+	version := fs.Int("version", -1, "version of the account, the current version is fetched if it's not set")
+	arguments, err := e.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	id := arguments[0]
+
+	ctx, cancel := e.callContext()
+	defer cancel()
+
+	client := e.client()
+
+	if *version < 0 {
+		account, err := client.FetchAccount(ctx, id)
+		if err != nil {
+			return err
+		}
+		if account.Data == nil {
+			return fmt.Errorf("account '%s' has no data", id)
+		}
+		*version = account.Data.Version
+	}
+
+	if err := client.DeleteAccount(ctx, id, *version); err != nil {
+		return err
+	}
+
+	if e.output == "json" {
+		return e.printJSON(map[string]interface{}{"id": id, "version": *version, "deleted": true})
+	}
+	_, err = fmt.Fprintf(e.stdout, "deleted account %s version %d\n", id, *version)
+	return err
+}
+
+// validationResult is the JSON output of validate
+type validationResult struct {
+	Valid  bool              `json:"valid"`
+	Errors map[string]string `json:"errors,omitempty"`
+}
+
+func runValidate(e *env, args []string) error {
+	fs := e.newFlagSet("validate", "")
+	var flags accountFlags
+	flags.register(fs)
+	if _, err := e.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	account, err := flags.account(e.stdin)
+	if err == nil {
+		err = account.Validate()
+	}
+
+	var errs validation.Errors
+	switch {
+	case err == nil:
+		if e.output == "json" {
+			return e.printJSON(validationResult{Valid: true})
+		}
+		_, err := fmt.Fprintln(e.stdout, "valid")
+		return err
+	case errors.As(err, &errs):
+		result := validationResult{Errors: map[string]string{}}
+		for field, fieldErr := range errs {
+			result.Errors[field] = fieldErr.Error()
+		}
+		if e.output == "json" {
+			if err := e.printJSON(result); err != nil {
+				return err
+			}
+		} else if err := e.printValidationErrors(result.Errors); err != nil {
+			return err
+		}
+		return fmt.Errorf("invalid account: %d error(s)", len(errs))
+	default:
+		return err
+	}
+}
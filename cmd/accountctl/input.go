@@ -0,0 +1,258 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"strings"
+
+	accountapi "github.com/alexdreptu/form3-accountapi-client"
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v2"
+)
+
+// accountFlags are the flags create and validate build the account from
+type accountFlags struct {
+	file string
+
+	accType        string
+	id             string
+	organisationID string
+
+	country                 string
+	bankID                  string
+	bankIDCode              string
+	bic                     string
+	accountNumber           string
+	baseCurrency            string
+	customerID              string
+	title                   string
+	firstName               string
+	name                    stringsFlag
+	bankAccountName         string
+	alternativeNames        stringsFlag
+	classification          string
+	status                  string
+	secondaryIdentification string
+	jointAccount            bool
+	switched                bool
+	matchingOptOut          bool
+
+	normalize     bool
+	transliterate bool
+}
+
+func (f *accountFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.file, "f", "", "JSON or YAML account document, '-' for stdin; the account flags are ignored")
+
+	fs.StringVar(&f.accType, "type", "accounts", "resource type")
+	fs.StringVar(&f.id, "id", "", "account ID, a new one is generated if it's not set")
+	fs.StringVar(&f.organisationID, "organisation-id", "", "organisation ID")
+
+	fs.StringVar(&f.country, "country", "", "ISO 3166-1 country code")
+	fs.StringVar(&f.bankID, "bank-id", "", "bank ID")
+	fs.StringVar(&f.bankIDCode, "bank-id-code", "", "bank ID code, e.g. GBDSC")
+	fs.StringVar(&f.bic, "bic", "", "SWIFT BIC")
+	fs.StringVar(&f.accountNumber, "account-number", "", "account number")
+	fs.StringVar(&f.baseCurrency, "base-currency", "", "ISO 4217 currency code")
+	fs.StringVar(&f.customerID, "customer-id", "", "customer ID")
+	fs.StringVar(&f.title, "title", "", "title of the account holder")
+	fs.StringVar(&f.firstName, "first-name", "", "first name of the account holder")
+	fs.Var(&f.name, "name", "name line of the account holder, can be repeated")
+	fs.StringVar(&f.bankAccountName, "bank-account-name", "", "primary account name")
+	fs.Var(&f.alternativeNames, "alternative-name", "alternative name, can be repeated")
+	fs.StringVar(&f.classification, "classification", "", "account classification, 'Personal' or 'Business'")
+	fs.StringVar(&f.status, "status", "", "account status")
+	fs.StringVar(&f.secondaryIdentification, "secondary-identification", "", "secondary identification")
+	fs.BoolVar(&f.jointAccount, "joint-account", false, "joint account")
+	fs.BoolVar(&f.switched, "switched", false, "account switched with CASS")
+	fs.BoolVar(&f.matchingOptOut, "account-matching-opt-out", false, "opted out of account matching")
+
+	fs.BoolVar(&f.normalize, "normalize", false, "correct common input mistakes, see Attributes.Normalize")
+	fs.BoolVar(&f.transliterate, "transliterate", false, "transliterate the names to the SWIFT character set")
+}
+
+// account returns the account read from the file, or built with NewAccount from the
+// flags. Accounts read from files are not validated.
+func (f *accountFlags) account(stdin io.Reader) (*accountapi.Account, error) {
+	if f.file != "" {
+		return readAccount(f.file, stdin)
+	}
+
+	id := f.id
+	if id == "" {
+		id = uuid.New().String()
+	}
+
+	return accountapi.NewAccount(&accountapi.Options{
+		Type:               f.accType,
+		ID:                 id,
+		OrganisationID:     f.organisationID,
+		Normalize:          f.normalize,
+		TransliterateNames: f.transliterate,
+		Attributes: []accountapi.Attribute{
+			accountapi.WithAttrCountry(f.country),
+			accountapi.WithAttrBankID(f.bankID),
+			accountapi.WithAttrBankIDCode(f.bankIDCode),
+			accountapi.WithAttrBIC(f.bic),
+			accountapi.WithAttrAccountNumber(f.accountNumber),
+			accountapi.WithAttrBaseCurrency(f.baseCurrency),
+			accountapi.WithAttrCustomerID(f.customerID),
+			accountapi.WithAttrTitle(f.title),
+			accountapi.WithAttrFirstName(f.firstName),
+			accountapi.WithAttrName(f.name...),
+			accountapi.WithAttrBankAccountName(f.bankAccountName),
+			accountapi.WithAttrAlternativeNames(f.alternativeNames...),
+			accountapi.WithAttrAccountClassification(f.classification),
+			accountapi.WithAttrStatus(f.status),
+			accountapi.WithAttrSecondaryIdentification(f.secondaryIdentification),
+			accountapi.WithAttrJointAccount(f.jointAccount),
+			accountapi.WithAttrSwitched(f.switched),
+			accountapi.WithAttrAccountMatchingOptOut(f.matchingOptOut),
+		},
+	})
+}
+
+// readAccount reads an account document, in JSON or YAML, from the file or from stdin
+func readAccount(file string, stdin io.Reader) (*accountapi.Account, error) {
+	account := &accountapi.Account{}
+	if err := readDocument(file, stdin, account); err != nil {
+		return nil, err
+	}
+	if account.Data != nil && account.Data.ID == "" {
+		account.Data.ID = uuid.New().String()
+	}
+	return account, nil
+}
+
+// readDocument decodes a JSON or YAML document, from the file or from stdin, into v
+func readDocument(file string, stdin io.Reader, v interface{}) error {
+	var data []byte
+	var err error
+	if file == "-" {
+		data, err = ioutil.ReadAll(stdin)
+	} else {
+		data, err = ioutil.ReadFile(file)
+	}
+	if err != nil {
+		return err
+	}
+
+	if trimmed := bytes.TrimSpace(data); len(trimmed) == 0 || trimmed[0] != '{' {
+		if data, err = yamlToJSON(data, reflect.TypeOf(v)); err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("%s: %w", file, err)
+	}
+	return nil
+}
+
+// yamlToJSON converts a YAML document to JSON, so it's decoded into a value of type t with
+// the JSON names of its fields. Scalars decoded into string fields keep the text they were
+// written with, so unquoted values like 'bank_id: 400300' or 'account_number: 0123' are
+// strings, not numbers.
+func yamlToJSON(data []byte, t reflect.Type) ([]byte, error) {
+	var document yamlNode
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	return json.Marshal(document.value(t))
+}
+
+// yamlNode is a node of a YAML document, as it was written
+type yamlNode struct {
+	mapping  map[string]*yamlNode
+	sequence []*yamlNode
+
+	// text and value yaml.v2 resolved a scalar to
+	text   string
+	scalar interface{}
+}
+
+func (n *yamlNode) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if err := unmarshal(&n.mapping); err == nil {
+		return nil
+	}
+	if err := unmarshal(&n.sequence); err == nil {
+		return nil
+	}
+	if err := unmarshal(&n.text); err != nil {
+		return err
+	}
+	return unmarshal(&n.scalar)
+}
+
+// value returns the value of the node to encode to JSON for a value of type t,
+// t is nil if the node isn't decoded into a known type, e.g. for unknown fields
+func (n *yamlNode) value(t reflect.Type) interface{} {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch {
+	case n == nil:
+		return nil
+
+	case n.mapping != nil:
+		m := make(map[string]interface{}, len(n.mapping))
+		for key, value := range n.mapping {
+			m[key] = value.value(memberType(t, key))
+		}
+		return m
+
+	case n.sequence != nil:
+		var elem reflect.Type
+		if t != nil && (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) {
+			elem = t.Elem()
+		}
+		values := make([]interface{}, len(n.sequence))
+		for i, value := range n.sequence {
+			values[i] = value.value(elem)
+		}
+		return values
+
+	case n.scalar != nil && t != nil && t.Kind() == reflect.String:
+		return n.text
+
+	default:
+		return n.scalar
+	}
+}
+
+// memberType returns the type of the member of a struct or map type with the JSON name,
+// nil if it has none
+func memberType(t reflect.Type, name string) reflect.Type {
+	if t == nil {
+		return nil
+	}
+	if t.Kind() == reflect.Map {
+		return t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := strings.Split(field.Tag.Get("json"), ",")[0]
+		switch {
+		case tag == "-" || field.PkgPath != "" && !field.Anonymous:
+			continue
+		case field.Anonymous && tag == "":
+			// the fields of embedded structs are members of the struct
+			if member := memberType(field.Type, name); member != nil {
+				return member
+			}
+		case tag == name || tag == "" && field.Name == name:
+			return field.Type
+		}
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+// Command accountctl creates, fetches, lists, deletes and validates accounts
+// with the account API client, and reconciles them with a desired state.
+//
+//	accountctl create -organisation-id ID -country GB -bank-id 400300 -bank-id-code GBDSC -bic NWBKGB22
+//	accountctl create -f account.yaml
+//	accountctl get ad27e265-9605-4b4b-a0e5-3003ea9cc4dc -o json
+//	accountctl list -page 0 -size 20 -country GB
+//	accountctl delete ad27e265-9605-4b4b-a0e5-3003ea9cc4dc
+//	accountctl validate -f account.json
+//	accountctl plan -f accounts.yaml -prune
+//	accountctl apply -f accounts.yaml -prune
+//
+// The API is reached at -base-url, which defaults to FORM3_BASE_URL like
+// accountapi.DefaultBaseURL. It exits with 1 if a command fails and with 2
+// if it's used wrongly.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	accountapi "github.com/alexdreptu/form3-accountapi-client"
+)
+
+// exit codes
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+const usage = `Usage: accountctl <command> [flags] [arguments]
+
+Commands:
+  create    create an account from flags or from a JSON or YAML file
+  get       fetch an account by ID
+  list      list accounts, a page at a time
+  delete    delete an account, by default its current version
+  validate  validate an account from flags or from a JSON or YAML file
+  plan      show the changes that make the accounts match a desired state file
+  apply     make the changes that make the accounts match a desired state file
+
+Run 'accountctl <command> -h' for the flags of a command.
+`
+
+// errUsage is returned by the commands if they are used wrongly,
+// the details have been printed already
+var errUsage = errors.New("usage")
+
+// command runs a subcommand with the flags and arguments that follow its name
+type command func(env *env, args []string) error
+
+var commands = map[string]command{
+	"create":   runCreate,
+	"get":      runGet,
+	"list":     runList,
+	"delete":   runDelete,
+	"validate": runValidate,
+	"plan":     runPlan,
+	"apply":    runApply,
+}
+
+// env holds what the commands read and write
+type env struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+
+	// set by the common flags
+	baseURL string
+	output  string
+	timeout time.Duration
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run runs the command line and returns the exit code
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return exitUsage
+	}
+
+	name := args[0]
+	if name == "help" || name == "-h" || name == "-help" || name == "--help" {
+		fmt.Fprint(stdout, usage)
+		return exitOK
+	}
+
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(stderr, "accountctl: unknown command '%s'\n\n%s", name, usage)
+		return exitUsage
+	}
+
+	err := cmd(&env{stdin: stdin, stdout: stdout, stderr: stderr}, args[1:])
+	switch {
+	case err == nil:
+		return exitOK
+	case errors.Is(err, flag.ErrHelp):
+		return exitOK
+	case errors.Is(err, errUsage):
+		return exitUsage
+	default:
+		fmt.Fprintf(stderr, "accountctl: %v\n", err)
+		return exitError
+	}
+}
+
+// newFlagSet returns the flag set of a command with the common flags
+func (e *env) newFlagSet(name, arguments string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(e.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(e.stderr, "Usage: accountctl %s [flags] %s\n\nFlags:\n", name, arguments)
+		fs.PrintDefaults()
+	}
+
+	fs.StringVar(&e.baseURL, "base-url", accountapi.DefaultBaseURL(), "account API URL, defaults to FORM3_BASE_URL")
+	fs.StringVar(&e.output, "o", "table", "output format, 'table' or 'json'")
+	fs.DurationVar(&e.timeout, "timeout", 10*time.Second, "timeout of the API calls")
+	return fs
+}
+
+// parse parses the flags, which may come before or after the arguments,
+// and checks the number of arguments
+func (e *env) parse(fs *flag.FlagSet, args []string, nargs int) ([]string, error) {
+	arguments := []string{}
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, errUsage
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			break
+		}
+		arguments = append(arguments, args[0])
+		args = args[1:]
+	}
+
+	if len(arguments) != nargs {
+		fmt.Fprintf(e.stderr, "accountctl %s: expected %d argument(s), got %d\n", fs.Name(), nargs, len(arguments))
+		fs.Usage()
+		return nil, errUsage
+	}
+	if e.output != "table" && e.output != "json" {
+		fmt.Fprintf(e.stderr, "accountctl %s: unknown output format '%s'\n", fs.Name(), e.output)
+		return nil, errUsage
+	}
+	return arguments, nil
+}
+
+// client returns a client for the base URL
+func (e *env) client() *accountapi.Client {
+	return accountapi.NewClient(&http.Client{}, e.baseURL)
+}
+
+// callContext returns a context with the timeout of the calls
+func (e *env) callContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), e.timeout)
+}
+
+// stringsFlag is a flag that can be repeated
+type stringsFlag []string
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ", ")
+}
+
+func (f *stringsFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
@@ -0,0 +1,332 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	accountapi "github.com/alexdreptu/form3-accountapi-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAccountID      = "ad27e265-9605-4b4b-a0e5-3003ea9cc4dc"
+	testOrganisationID = "eb0bd6f5-c3f5-44b2-b677-acd23cdde73c"
+)
+
+// testServer keeps the accounts it's sent in memory
+type testServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	accounts map[string]accountapi.Data
+	queries  []string
+}
+
+func newTestServer(t *testing.T) *testServer {
+	s := &testServer{accounts: map[string]accountapi.Data{}}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *testServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := strings.TrimPrefix(r.URL.Path, "/")
+	switch {
+	case r.Method == http.MethodPost:
+		var account accountapi.Account
+		if err := json.NewDecoder(r.Body).Decode(&account); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if _, ok := s.accounts[account.Data.ID]; ok {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		s.accounts[account.Data.ID] = *account.Data
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(account)
+	case r.Method == http.MethodGet && id == "":
+		s.queries = append(s.queries, r.URL.RawQuery)
+		accounts := accountapi.Accounts{Data: []accountapi.Data{}}
+		for _, data := range s.accounts {
+			accounts.Data = append(accounts.Data, data)
+		}
+		_ = json.NewEncoder(w).Encode(accounts)
+	case r.Method == http.MethodGet:
+		data, ok := s.accounts[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(accountapi.Account{Data: &data})
+	case r.Method == http.MethodPatch:
+		var account accountapi.Account
+		if err := json.NewDecoder(r.Body).Decode(&account); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		data, ok := s.accounts[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		data.Attributes = account.Data.Attributes
+		data.Version++
+		s.accounts[id] = data
+		_ = json.NewEncoder(w).Encode(accountapi.Account{Data: &data})
+	case r.Method == http.MethodDelete:
+		data, ok := s.accounts[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Query().Get("version") != strconv.Itoa(data.Version) {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error_message": "invalid version"}`))
+			return
+		}
+		delete(s.accounts, id)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// runTest runs the command line against the server and returns the exit code and the output
+func runTest(server *testServer, stdin string, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	args = append(args, "-base-url", server.URL)
+	code := run(args, strings.NewReader(stdin), &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+var createFlags = []string{
+	"-id", testAccountID,
+	"-organisation-id", testOrganisationID,
+	"-country", "GB",
+	"-bank-id", "400300",
+	"-bank-id-code", "GBDSC",
+	"-bic", "NWBKGB22",
+	"-account-number", "41426815",
+	"-name", "Samantha", "-name", "Holder",
+}
+
+func TestCreate(t *testing.T) {
+	server := newTestServer(t)
+
+	code, stdout, stderr := runTest(server, "", append([]string{"create"}, createFlags...)...)
+	require.Equal(t, exitOK, code, stderr)
+	assert.Contains(t, stdout, "ACCOUNT NUMBER")
+	assert.Contains(t, stdout, testAccountID)
+	assert.Contains(t, stdout, "Samantha Holder")
+	assert.Equal(t, []string{"Samantha", "Holder"}, server.accounts[testAccountID].Attributes.Name)
+
+	// the server rejects the duplicate
+	code, _, stderr = runTest(server, "", append([]string{"create"}, createFlags...)...)
+	assert.Equal(t, exitError, code)
+	assert.Contains(t, stderr, "duplicate account")
+
+	// invalid accounts are not sent
+	code, _, stderr = runTest(server, "", "create", "-organisation-id", testOrganisationID, "-country", "GB", "-bank-id", "1")
+	assert.Equal(t, exitError, code)
+	assert.Contains(t, stderr, "bank_id")
+	assert.Len(t, server.accounts, 1)
+}
+
+func TestCreate_File(t *testing.T) {
+	dir, err := ioutil.TempDir("", "accountctl")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	yamlFile := filepath.Join(dir, "account.yaml")
+	require.NoError(t, ioutil.WriteFile(yamlFile, []byte(`
+data:
+  type: accounts
+  id: `+testAccountID+`
+  organisation_id: `+testOrganisationID+`
+  attributes:
+    country: GB
+    bank_id: 400300
+    bank_id_code: GBDSC
+    bic: NWBKGB22
+    account_number: 01426819
+    name: [Samantha Holder]
+    iban: GB11NWBK40030041426819
+`), 0600))
+
+	server := newTestServer(t)
+	code, stdout, stderr := runTest(server, "", "create", "-f", yamlFile, "-o", "json")
+	require.Equal(t, exitOK, code, stderr)
+
+	var created accountapi.Account
+	require.NoError(t, json.Unmarshal([]byte(stdout), &created))
+	assert.Equal(t, testAccountID, created.Data.ID)
+	// unquoted numbers are read as they were written
+	assert.Equal(t, "400300", created.Data.Attributes.BankID)
+	assert.Equal(t, "01426819", created.Data.Attributes.AccountNumber)
+	assert.JSONEq(t, `"GB11NWBK40030041426819"`, string(created.Data.Attributes.UnknownFields()["iban"]))
+
+	// JSON from stdin, with a generated ID
+	code, stdout, stderr = runTest(server, `{"data": {"type": "accounts", "organisation_id": "`+testOrganisationID+`",
+		"attributes": {"country": "GB", "bank_id": "400301", "bank_id_code": "GBDSC", "bic": "NWBKGB22"}}}`,
+		"create", "-f", "-", "-o", "json")
+	require.Equal(t, exitOK, code, stderr)
+	require.NoError(t, json.Unmarshal([]byte(stdout), &created))
+	assert.NotEmpty(t, created.Data.ID)
+	assert.Len(t, server.accounts, 2)
+
+	code, _, _ = runTest(server, "", "create", "-f", filepath.Join(dir, "missing.yaml"))
+	assert.Equal(t, exitError, code)
+}
+
+func TestGetListDelete(t *testing.T) {
+	server := newTestServer(t)
+	code, _, stderr := runTest(server, "", append([]string{"create"}, createFlags...)...)
+	require.Equal(t, exitOK, code, stderr)
+
+	code, stdout, stderr := runTest(server, "", "get", testAccountID, "-o", "json")
+	require.Equal(t, exitOK, code, stderr)
+	var fetched accountapi.Account
+	require.NoError(t, json.Unmarshal([]byte(stdout), &fetched))
+	assert.Equal(t, "41426815", fetched.Data.Attributes.AccountNumber)
+
+	code, stdout, stderr = runTest(server, "", "list", "-page", "1", "-size", "5", "-country", "GB", "-customer-id", "42")
+	require.Equal(t, exitOK, code, stderr)
+	assert.Contains(t, stdout, testAccountID)
+	assert.Equal(t, []string{"filter%5Bcountry%5D=GB&filter%5Bcustomer_id%5D=42&page%5Bnumber%5D=1&page%5Bsize%5D=5"},
+		server.queries)
+
+	// a wrong version is rejected by the server
+	code, _, stderr = runTest(server, "", "delete", testAccountID, "-version", "3")
+	assert.Equal(t, exitError, code)
+	assert.Contains(t, stderr, "version 3 of account '"+testAccountID+"' is not the current version")
+
+	// the current version is fetched
+	server.accounts[testAccountID] = func(d accountapi.Data) accountapi.Data {
+		d.Version = 2
+		return d
+	}(server.accounts[testAccountID])
+	code, stdout, stderr = runTest(server, "", "delete", testAccountID)
+	require.Equal(t, exitOK, code, stderr)
+	assert.Equal(t, "deleted account "+testAccountID+" version 2\n", stdout)
+	assert.Empty(t, server.accounts)
+
+	code, _, stderr = runTest(server, "", "get", testAccountID)
+	assert.Equal(t, exitError, code)
+	assert.Contains(t, stderr, "does not exist")
+
+	code, _, _ = runTest(server, "", "delete", testAccountID)
+	assert.Equal(t, exitError, code)
+}
+
+func TestPlanApply(t *testing.T) {
+	dir, err := ioutil.TempDir("", "accountctl")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	server := newTestServer(t)
+	code, _, stderr := runTest(server, "", append([]string{"create"}, createFlags...)...)
+	require.Equal(t, exitOK, code, stderr)
+
+	desiredFile := filepath.Join(dir, "accounts.yaml")
+	require.NoError(t, ioutil.WriteFile(desiredFile, []byte(`
+data:
+  - id: `+testAccountID+`
+    organisation_id: `+testOrganisationID+`
+    attributes:
+      country: GB
+      bank_id: 400300
+      bank_id_code: GBDSC
+      bic: NWBKGB22
+      account_number: 41426815
+      name: [Sam Holder]
+`), 0600))
+
+	code, stdout, stderr := runTest(server, "", "plan", "-f", desiredFile)
+	require.Equal(t, exitOK, code, stderr)
+	assert.Equal(t, "~ "+testAccountID+" will be updated in place\n"+
+		`    ~ name: ["Samantha","Holder"] => ["Sam Holder"]`+"\n\n"+
+		"Plan: 0 to create, 1 to update, 0 to recreate, 0 to delete.\n", stdout)
+	assert.Equal(t, 0, server.accounts[testAccountID].Version)
+
+	code, stdout, stderr = runTest(server, "", "apply", "-f", desiredFile)
+	require.Equal(t, exitOK, code, stderr)
+	assert.Contains(t, stdout, "Applied 1 of 1 change(s).")
+	assert.Equal(t, []string{"Sam Holder"}, server.accounts[testAccountID].Attributes.Name)
+	assert.Equal(t, 1, server.accounts[testAccountID].Version)
+
+	code, stdout, stderr = runTest(server, "", "plan", "-f", desiredFile, "-o", "json")
+	require.Equal(t, exitOK, code, stderr)
+	assert.JSONEq(t, `{"changes": []}`, stdout)
+
+	code, _, _ = runTest(server, "", "plan")
+	assert.Equal(t, exitError, code)
+}
+
+func TestValidate(t *testing.T) {
+	server := newTestServer(t)
+
+	code, stdout, stderr := runTest(server, "", append([]string{"validate"}, createFlags...)...)
+	require.Equal(t, exitOK, code, stderr)
+	assert.Equal(t, "valid\n", stdout)
+
+	code, stdout, _ = runTest(server, `{"data": {"type": "accounts", "id": "`+testAccountID+`",
+		"organisation_id": "`+testOrganisationID+`", "attributes": {"country": "GB", "bank_id": "1", "bic": "NWBKGB22"}}}`,
+		"validate", "-f", "-", "-o", "json")
+	assert.Equal(t, exitError, code)
+	var result validationResult
+	require.NoError(t, json.Unmarshal([]byte(stdout), &result))
+	assert.False(t, result.Valid)
+	assert.Contains(t, result.Errors, "bank_id")
+	assert.Contains(t, result.Errors, "bank_id_code")
+
+	assert.Empty(t, server.accounts)
+}
+
+func TestUsage(t *testing.T) {
+	server := newTestServer(t)
+
+	var stdout, stderr bytes.Buffer
+	assert.Equal(t, exitUsage, run(nil, nil, &stdout, &stderr))
+	assert.Equal(t, exitUsage, run([]string{"undelete"}, nil, &stdout, &stderr))
+	assert.Equal(t, exitOK, run([]string{"help"}, nil, &stdout, &stderr))
+
+	code, _, _ := runTest(server, "", "get")
+	assert.Equal(t, exitUsage, code)
+	code, _, _ = runTest(server, "", "get", testAccountID, "-o", "yaml")
+	assert.Equal(t, exitUsage, code)
+	code, _, _ = runTest(server, "", "list", "-unknown")
+	assert.Equal(t, exitUsage, code)
+	code, _, _ = runTest(server, "", "delete", "-h")
+	assert.Equal(t, exitOK, code)
+}
+
+func TestBaseURLFromEnvironment(t *testing.T) {
+	server := newTestServer(t)
+
+	old, set := os.LookupEnv("FORM3_BASE_URL")
+	require.NoError(t, os.Setenv("FORM3_BASE_URL", server.URL))
+	defer func() {
+		if set {
+			os.Setenv("FORM3_BASE_URL", old)
+		} else {
+			os.Unsetenv("FORM3_BASE_URL")
+		}
+	}()
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"list", "-o", "json"}, nil, &stdout, &stderr)
+	require.Equal(t, exitOK, code, stderr.String())
+	assert.Len(t, server.queries, 1)
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+
+	accountapi "github.com/alexdreptu/form3-accountapi-client"
+)
+
+var tableHeader = []string{
+	"ID", "ORGANISATION ID", "COUNTRY", "BANK ID", "BIC", "ACCOUNT NUMBER", "CURRENCY", "NAME", "VERSION",
+}
+
+func (e *env) printJSON(v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(e.stdout, "%s\n", data)
+	return err
+}
+
+func (e *env) printAccount(account accountapi.Account) error {
+	if e.output == "json" {
+		return e.printJSON(account)
+	}
+
+	data := []accountapi.Data{}
+	if account.Data != nil {
+		data = append(data, *account.Data)
+	}
+	return e.printTable(data)
+}
+
+func (e *env) printAccounts(accounts accountapi.Accounts) error {
+	if e.output == "json" {
+		return e.printJSON(accounts)
+	}
+	return e.printTable(accounts.Data)
+}
+
+// printTable prints a row per account
+func (e *env) printTable(data []accountapi.Data) error {
+	w := tabwriter.NewWriter(e.stdout, 0, 8, 2, ' ', 0)
+	printRow(w, tableHeader)
+	for _, d := range data {
+		attributes := d.Attributes
+		if attributes == nil {
+			attributes = &accountapi.Attributes{}
+		}
+		printRow(w, []string{
+			d.ID,
+			d.OrganisationID,
+			attributes.Country,
+			attributes.BankID,
+			attributes.BIC,
+			attributes.AccountNumber,
+			attributes.BaseCurrency,
+			attributes.FullName(),
+			strconv.Itoa(d.Version),
+		})
+	}
+	return w.Flush()
+}
+
+// printValidationErrors prints a row per invalid field, sorted by field
+func (e *env) printValidationErrors(errs map[string]string) error {
+	fields := make([]string, 0, len(errs))
+	for field := range errs {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	w := tabwriter.NewWriter(e.stdout, 0, 8, 2, ' ', 0)
+	printRow(w, []string{"FIELD", "ERROR"})
+	for _, field := range fields {
+		printRow(w, []string{field, errs[field]})
+	}
+	return w.Flush()
+}
+
+// printPlan prints the plan as a diff, or as JSON
+func (e *env) printPlan(plan *accountapi.Plan) error {
+	if e.output == "json" {
+		return e.printJSON(plan)
+	}
+	_, err := fmt.Fprint(e.stdout, plan)
+	return err
+}
+
+func printRow(w *tabwriter.Writer, columns []string) {
+	for i, column := range columns {
+		if i != 0 {
+			fmt.Fprint(w, "\t")
+		}
+		if column == "" {
+			column = "-"
+		}
+		fmt.Fprint(w, column)
+	}
+	fmt.Fprintln(w)
+}
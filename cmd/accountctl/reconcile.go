@@ -0,0 +1,90 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	accountapi "github.com/alexdreptu/form3-accountapi-client"
+)
+
+// reconcileFlags are the flags plan and apply compute the plan with
+type reconcileFlags struct {
+	file          string
+	prune         bool
+	organisations stringsFlag
+}
+
+func (f *reconcileFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.file, "f", "", "JSON or YAML document with the desired accounts under 'data', '-' for stdin")
+	fs.BoolVar(&f.prune, "prune", false, "delete the accounts of the managed organisations that are not desired")
+	fs.Var(&f.organisations, "organisation-id", "managed organisation, can be repeated; defaults to the organisations of the desired accounts")
+}
+
+// plan reads the desired accounts and compares them with the accounts of the API
+func (f *reconcileFlags) plan(e *env, client *accountapi.Client) (*accountapi.Plan, error) {
+	if f.file == "" {
+		return nil, errors.New("the desired accounts must be set with -f")
+	}
+	accounts := &accountapi.Accounts{}
+	if err := readDocument(f.file, e.stdin, accounts); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := e.callContext()
+	defer cancel()
+
+	plan, err := client.Plan(ctx, accounts.Data, accountapi.ReconcileOptions{
+		Prune:           f.prune,
+		OrganisationIDs: f.organisations,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+func runPlan(e *env, args []string) error {
+	fs := e.newFlagSet("plan", "")
+	var flags reconcileFlags
+	flags.register(fs)
+	if _, err := e.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	plan, err := flags.plan(e, e.client())
+	if err != nil {
+		return err
+	}
+	return e.printPlan(plan)
+}
+
+func runApply(e *env, args []string) error {
+	fs := e.newFlagSet("apply", "")
+	var flags reconcileFlags
+	flags.register(fs)
+	if _, err := e.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	client := e.client()
+	plan, err := flags.plan(e, client)
+	if err != nil {
+		return err
+	}
+	if err := e.printPlan(plan); err != nil {
+		return err
+	}
+	if plan.Empty() {
+		return nil
+	}
+
+	ctx, cancel := e.callContext()
+	defer cancel()
+
+	n, err := client.Apply(ctx, plan)
+	if e.output != "json" {
+		fmt.Fprintf(e.stdout, "Applied %d of %d change(s).\n", n, len(plan.Changes))
+	}
+	return err
+}
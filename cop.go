@@ -0,0 +1,342 @@
+package accountapi
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// CoP match results
+const (
+	CoPMatch      = "Match"
+	CoPCloseMatch = "CloseMatch"
+	CoPNoMatch    = "NoMatch"
+	CoPOptedOut   = "OptedOut"
+)
+
+// CoP reason codes, as returned by the UK Confirmation of Payee service
+const (
+	CoPReasonNoMatch                 = "ANNM" // account name does not match
+	CoPReasonCloseMatch              = "MBAM" // may be a match
+	CoPReasonBusinessNameMatch       = "BANM" // business account, name matches
+	CoPReasonPersonalNameMatch       = "PANM" // personal account, name matches
+	CoPReasonBusinessNameCloseMatch  = "BAMM" // business account, name is a close match
+	CoPReasonPersonalNameCloseMatch  = "PAMM" // personal account, name is a close match
+	CoPReasonAccountMatchingOptedOut = "OPTO" // account has opted out of matching
+)
+
+const (
+	defaultCoPCloseMatchThreshold     = 0.8
+	coPInitialLength                  = 1
+	coPJointAccountSeparatorsReplacer = "|"
+)
+
+// words dropped before matching
+var coPIgnoredWords = map[string]bool{
+	"mr": true, "mrs": true, "ms": true, "miss": true, "mx": true,
+	"dr": true, "prof": true, "sir": true, "dame": true,
+	"and": true,
+}
+
+// words replaced before matching, so common abbreviations match their long form
+var coPSynonyms = map[string]string{
+	"ltd":  "limited",
+	"co":   "company",
+	"corp": "corporation",
+	"inc":  "incorporated",
+	"intl": "international",
+	"bros": "brothers",
+	"st":   "saint",
+}
+
+// separators between the names of joint account holders
+var coPJointAccountSeparators = strings.NewReplacer(
+	" and ", coPJointAccountSeparatorsReplacer,
+	" & ", coPJointAccountSeparatorsReplacer,
+	"&", coPJointAccountSeparatorsReplacer,
+	"/", coPJointAccountSeparatorsReplacer,
+	";", coPJointAccountSeparatorsReplacer,
+)
+
+// CoPResult holds the outcome of a Confirmation of Payee check.
+type CoPResult struct {
+	// One of CoPMatch, CoPCloseMatch, CoPNoMatch or CoPOptedOut.
+	Result string
+
+	// Reason code, as the UK CoP service would return it. Blank on a match.
+	ReasonCode string
+
+	// Name on the account that should be suggested to the payer.
+	// Only set on a close match.
+	SuggestedName string
+}
+
+// CoPMatcher compares a payer supplied name against the names held on an account,
+// as a local pre-check before calling the Confirmation of Payee service.
+type CoPMatcher struct {
+	// CloseMatchThreshold is the minimum similarity, between 0 and 1,
+	// for two names to be considered a close match. If it's 0 or less,
+	// the default of NewCoPMatcher is used.
+	CloseMatchThreshold float64
+}
+
+// NewCoPMatcher returns a CoPMatcher with the default close match threshold.
+func NewCoPMatcher() *CoPMatcher {
+	return &CoPMatcher{CloseMatchThreshold: defaultCoPCloseMatchThreshold}
+}
+
+// MatchPayee checks name against the account using a CoPMatcher with the default settings.
+func MatchPayee(account *Account, name, accountType string) (CoPResult, error) {
+	return NewCoPMatcher().Match(account, name, accountType)
+}
+
+// Match checks a payer supplied name and account type, either AccountClassificationPersonal
+// or AccountClassificationBusiness, against FirstName, Name, BankAccountName and
+// all the alternative names of the account. For joint accounts, the name of any of
+// the account holders is a match too.
+func (m *CoPMatcher) Match(account *Account, name, accountType string) (CoPResult, error) {
+	if account == nil || account.Data == nil || account.Data.Attributes == nil {
+		return CoPResult{}, ErrNoAttributes
+	}
+
+	if accountType != AccountClassificationPersonal &&
+		accountType != AccountClassificationBusiness {
+		return CoPResult{}, &InvalidAccountClassificationError{accountType}
+	}
+
+	attributes := account.Data.Attributes
+	if attributes.AccountMatchingOptOut {
+		return CoPResult{
+			Result:     CoPOptedOut,
+			ReasonCode: CoPReasonAccountMatchingOptedOut,
+		}, nil
+	}
+
+	payer := coPTokens(name)
+	if len(payer) == 0 {
+		return CoPResult{Result: CoPNoMatch, ReasonCode: CoPReasonNoMatch}, nil
+	}
+
+	threshold := m.CloseMatchThreshold
+	if threshold <= 0 {
+		threshold = defaultCoPCloseMatchThreshold
+	}
+
+	var (
+		bestScore float64
+		bestName  string
+		exact     bool
+	)
+
+	for _, candidate := range coPCandidates(attributes) {
+		tokens := coPTokens(candidate)
+		if len(tokens) == 0 {
+			continue
+		}
+
+		if coPExactMatch(payer, tokens) {
+			exact = true
+			bestName = candidate
+			break
+		}
+
+		score := coPSimilarity(payer, tokens)
+		if coPInitialsMatch(payer, tokens) && score < threshold {
+			score = threshold
+		}
+		if score > bestScore {
+			bestScore = score
+			bestName = candidate
+		}
+	}
+
+	// the type of the account is only known if it has a classification
+	typeMismatch := attributes.AccountClassification != "" &&
+		attributes.AccountClassification != accountType
+	business := attributes.AccountClassification == AccountClassificationBusiness
+
+	switch {
+	case exact && !typeMismatch:
+		return CoPResult{Result: CoPMatch}, nil
+
+	case exact:
+		reason := CoPReasonPersonalNameMatch
+		if business {
+			reason = CoPReasonBusinessNameMatch
+		}
+		return CoPResult{Result: CoPCloseMatch, ReasonCode: reason, SuggestedName: bestName}, nil
+
+	case bestScore >= threshold && !typeMismatch:
+		return CoPResult{Result: CoPCloseMatch, ReasonCode: CoPReasonCloseMatch, SuggestedName: bestName}, nil
+
+	case bestScore >= threshold:
+		reason := CoPReasonPersonalNameCloseMatch
+		if business {
+			reason = CoPReasonBusinessNameCloseMatch
+		}
+		return CoPResult{Result: CoPCloseMatch, ReasonCode: reason, SuggestedName: bestName}, nil
+
+	default:
+		return CoPResult{Result: CoPNoMatch, ReasonCode: CoPReasonNoMatch}, nil
+	}
+}
+
+// coPCandidates returns the names of the account that a payer name is matched against.
+// The holders of a joint account are matched one by one, whether they are on their own
+// name lines or alternative names, or joined by a separator.
+func coPCandidates(a *Attributes) []string {
+	names := []string{a.FullName(), a.BankAccountName, a.FirstName}
+	if a.JointAccount {
+		names = append(names, a.Name...)
+	}
+	names = append(names, a.AlternativeNames...)
+	names = append(names, a.AlternativeBankAccountNames...)
+
+	seen := map[string]bool{}
+	candidates := []string{}
+	add := func(name string) {
+		name = strings.TrimSpace(name)
+		if name != "" && !seen[name] {
+			seen[name] = true
+			candidates = append(candidates, name)
+		}
+	}
+
+	for _, name := range names {
+		add(name)
+		if a.JointAccount {
+			holders := coPJointAccountSeparators.Replace(name)
+			for _, holder := range strings.Split(holders, coPJointAccountSeparatorsReplacer) {
+				add(holder)
+			}
+		}
+	}
+
+	return candidates
+}
+
+// coPNormalize removes accents, punctuation and case from a name
+func coPNormalize(name string) string {
+	t := transform.Chain(norm.NFKD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	stripped, _, err := transform.String(t, name)
+	if err != nil {
+		stripped = name
+	}
+
+	return strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			return unicode.ToLower(r)
+		case r == '\'' || r == '’':
+			// O'Brien is the same as OBrien
+			return -1
+		default:
+			return ' '
+		}
+	}, stripped)
+}
+
+// coPTokens returns the normalized words of a name, without titles and conjunctions
+func coPTokens(name string) []string {
+	tokens := []string{}
+	for _, word := range strings.Fields(coPNormalize(name)) {
+		if coPIgnoredWords[word] {
+			continue
+		}
+		if synonym, ok := coPSynonyms[word]; ok {
+			word = synonym
+		}
+		tokens = append(tokens, word)
+	}
+	return tokens
+}
+
+// coPExactMatch reports whether both names have the same words, in any order
+func coPExactMatch(x, y []string) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	return coPSortedJoin(x) == coPSortedJoin(y)
+}
+
+// coPInitialsMatch reports whether the names have the same words, in the same order,
+// where one or more of them are replaced by their initial in one of the names.
+func coPInitialsMatch(x, y []string) bool {
+	if len(x) != len(y) {
+		return false
+	}
+
+	initials := false
+	for i := range x {
+		switch {
+		case x[i] == y[i]:
+		case len([]rune(x[i])) == coPInitialLength && strings.HasPrefix(y[i], x[i]),
+			len([]rune(y[i])) == coPInitialLength && strings.HasPrefix(x[i], y[i]):
+			initials = true
+		default:
+			return false
+		}
+	}
+
+	return initials
+}
+
+// coPSimilarity returns a similarity between 0 and 1 based on the edit distance
+func coPSimilarity(x, y []string) float64 {
+	a := []rune(coPSortedJoin(x))
+	b := []rune(coPSortedJoin(y))
+
+	longest := len(a)
+	if len(b) > longest {
+		longest = len(b)
+	}
+	if longest == 0 {
+		return 0
+	}
+
+	return 1 - float64(levenshtein(a, b))/float64(longest)
+}
+
+func coPSortedJoin(tokens []string) string {
+	sorted := make([]string, len(tokens))
+	copy(sorted, tokens)
+	sort.Strings(sorted)
+	return strings.Join(sorted, " ")
+}
+
+func levenshtein(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min3(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
+
+func min3(x, y, z int) int {
+	if y < x {
+		x = y
+	}
+	if z < x {
+		x = z
+	}
+	return x
+}
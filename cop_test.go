@@ -0,0 +1,215 @@
+package accountapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/alexdreptu/form3-accountapi-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchPayee(t *testing.T) {
+	testCases := []struct {
+		name          string
+		attributes    Attributes
+		payerName     string
+		accountType   string
+		result        string
+		reasonCode    string
+		suggestedName string
+	}{
+		{
+			name:        "match",
+			attributes:  Attributes{Name: []string{"Samantha Holder"}},
+			payerName:   "Samantha Holder",
+			accountType: AccountClassificationPersonal,
+			result:      CoPMatch,
+		},
+		{
+			name:        "match normalised",
+			attributes:  Attributes{Name: []string{"Zoë O'Brien-Smith"}},
+			payerName:   "  zoe  obrien smith ",
+			accountType: AccountClassificationPersonal,
+			result:      CoPMatch,
+		},
+		{
+			name:        "match reordered with title",
+			attributes:  Attributes{BankAccountName: "Samantha Holder"},
+			payerName:   "Mrs Holder Samantha",
+			accountType: AccountClassificationPersonal,
+			result:      CoPMatch,
+		},
+		{
+			name:        "match alternative name",
+			attributes:  Attributes{FirstName: "Samantha", AlternativeBankAccountNames: []string{"Sam Holder"}},
+			payerName:   "Sam Holder",
+			accountType: AccountClassificationPersonal,
+			result:      CoPMatch,
+		},
+		{
+			name: "match business abbreviation",
+			attributes: Attributes{
+				AccountClassification: AccountClassificationBusiness,
+				Name:                  []string{"Acme Holdings Limited"},
+			},
+			payerName:   "ACME Holdings Ltd.",
+			accountType: AccountClassificationBusiness,
+			result:      CoPMatch,
+		},
+		{
+			name:          "close match initials",
+			attributes:    Attributes{Name: []string{"Samantha Holder"}},
+			payerName:     "S Holder",
+			accountType:   AccountClassificationPersonal,
+			result:        CoPCloseMatch,
+			reasonCode:    CoPReasonCloseMatch,
+			suggestedName: "Samantha Holder",
+		},
+		{
+			name:          "close match edit distance",
+			attributes:    Attributes{Name: []string{"Samantha Holder"}, AlternativeNames: []string{"Sam Holder"}},
+			payerName:     "Samanta Holder",
+			accountType:   AccountClassificationPersonal,
+			result:        CoPCloseMatch,
+			reasonCode:    CoPReasonCloseMatch,
+			suggestedName: "Samantha Holder",
+		},
+		{
+			name: "close match business account name match",
+			attributes: Attributes{
+				AccountClassification: AccountClassificationBusiness,
+				Name:                  []string{"Acme Holdings Limited"},
+			},
+			payerName:     "Acme Holdings Limited",
+			accountType:   AccountClassificationPersonal,
+			result:        CoPCloseMatch,
+			reasonCode:    CoPReasonBusinessNameMatch,
+			suggestedName: "Acme Holdings Limited",
+		},
+		{
+			name: "close match personal account close name",
+			attributes: Attributes{
+				AccountClassification: AccountClassificationPersonal,
+				Name:                  []string{"Samantha Holder"},
+			},
+			payerName:     "Samanta Holder",
+			accountType:   AccountClassificationBusiness,
+			result:        CoPCloseMatch,
+			reasonCode:    CoPReasonPersonalNameCloseMatch,
+			suggestedName: "Samantha Holder",
+		},
+		{
+			name:        "no match",
+			attributes:  Attributes{Name: []string{"Samantha Holder"}},
+			payerName:   "Jane Smith",
+			accountType: AccountClassificationPersonal,
+			result:      CoPNoMatch,
+			reasonCode:  CoPReasonNoMatch,
+		},
+		{
+			name:        "no match blank name",
+			attributes:  Attributes{Name: []string{"Samantha Holder"}},
+			payerName:   " ",
+			accountType: AccountClassificationPersonal,
+			result:      CoPNoMatch,
+			reasonCode:  CoPReasonNoMatch,
+		},
+		{
+			name:        "joint account one holder",
+			attributes:  Attributes{JointAccount: true, Name: []string{"John Smith & Jane Doe"}},
+			payerName:   "Jane Doe",
+			accountType: AccountClassificationPersonal,
+			result:      CoPMatch,
+		},
+		{
+			name:        "joint account holders reordered",
+			attributes:  Attributes{JointAccount: true, Name: []string{"John Smith & Jane Doe"}},
+			payerName:   "Jane Doe and John Smith",
+			accountType: AccountClassificationPersonal,
+			result:      CoPMatch,
+		},
+		{
+			name:        "joint account holder on own name line",
+			attributes:  Attributes{JointAccount: true, Name: []string{"John Smith", "Jane Doe"}},
+			payerName:   "Jane Doe",
+			accountType: AccountClassificationPersonal,
+			result:      CoPMatch,
+		},
+		{
+			name:        "joint account holder in alternative names",
+			attributes:  Attributes{JointAccount: true, Name: []string{"John Smith"}, AlternativeNames: []string{"Jane Doe"}},
+			payerName:   "Jane Doe",
+			accountType: AccountClassificationPersonal,
+			result:      CoPMatch,
+		},
+		{
+			name:        "not joint account name line",
+			attributes:  Attributes{Name: []string{"John Smith", "Jane Doe"}},
+			payerName:   "Jane Doe",
+			accountType: AccountClassificationPersonal,
+			result:      CoPNoMatch,
+			reasonCode:  CoPReasonNoMatch,
+		},
+		{
+			name:        "not joint account one holder",
+			attributes:  Attributes{Name: []string{"John Smith & Jane Doe"}},
+			payerName:   "Jane Doe",
+			accountType: AccountClassificationPersonal,
+			result:      CoPNoMatch,
+			reasonCode:  CoPReasonNoMatch,
+		},
+		{
+			name:        "opted out",
+			attributes:  Attributes{AccountMatchingOptOut: true, Name: []string{"Samantha Holder"}},
+			payerName:   "Samantha Holder",
+			accountType: AccountClassificationPersonal,
+			result:      CoPOptedOut,
+			reasonCode:  CoPReasonAccountMatchingOptedOut,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			attributes := tc.attributes
+			account := &Account{Data: &Data{Attributes: &attributes}}
+			result, err := MatchPayee(account, tc.payerName, tc.accountType)
+			require.NoError(t, err)
+			assert.Equal(t, tc.result, result.Result)
+			assert.Equal(t, tc.reasonCode, result.ReasonCode)
+			assert.Equal(t, tc.suggestedName, result.SuggestedName)
+		})
+	}
+}
+
+func TestMatchPayee_Errors(t *testing.T) {
+	_, err := MatchPayee(&Account{}, "Samantha Holder", AccountClassificationPersonal)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrNoAttributes))
+
+	account := &Account{Data: &Data{Attributes: &Attributes{FirstName: "Samantha"}}}
+	_, err = MatchPayee(account, "Samantha", "Private")
+	require.Error(t, err)
+	var e *InvalidAccountClassificationError
+	assert.True(t, errors.As(err, &e))
+}
+
+func TestCoPMatcher_CloseMatchThreshold(t *testing.T) {
+	account := &Account{Data: &Data{Attributes: &Attributes{Name: []string{"Samantha Holder"}}}}
+
+	matcher := NewCoPMatcher()
+	matcher.CloseMatchThreshold = 0.99
+	result, err := matcher.Match(account, "Samanta Holder", AccountClassificationPersonal)
+	require.NoError(t, err)
+	assert.Equal(t, CoPNoMatch, result.Result)
+
+	// the zero value uses the default threshold
+	result, err = (&CoPMatcher{}).Match(account, "John Smith", AccountClassificationPersonal)
+	require.NoError(t, err)
+	assert.Equal(t, CoPResult{Result: CoPNoMatch, ReasonCode: CoPReasonNoMatch}, result)
+	result, err = (&CoPMatcher{}).Match(account, "Samanta Holder", AccountClassificationPersonal)
+	require.NoError(t, err)
+	assert.Equal(t, CoPCloseMatch, result.Result)
+	assert.Equal(t, "Samantha Holder", result.SuggestedName)
+}
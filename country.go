@@ -0,0 +1,382 @@
+package accountapi
+
+import "sort"
+
+// countryRules holds the rules of the country specific attributes. They are checked by
+// Attributes.validate and described by CountryInfo and AccountJSONSchema.
+type countryRules struct {
+	name                          string
+	currency                      string
+	bankIDLength                  int // 0 if the country doesn't support bank IDs
+	bankIDLengthWithAccountNumber int // only set if it differs from bankIDLength
+	bankIDRequired                bool
+	bankIDFirstCharZero           bool
+	bankIDCode                    string // blank if the country doesn't support bank ID codes
+	bankIDCodeRequired            bool
+	bicRequired                   bool
+	accountNumberLengthFrom       int
+	accountNumberLengthTo         int
+	accountNumberFirstCharNonZero bool
+	accountNumberCheckDigits      bool // the account number must end with its national check digits
+	ibanSupported                 bool
+}
+
+var countries = map[string]countryRules{
+	CountryUnitedKingdom: {
+		name:                    "United Kingdom",
+		currency:                CurrencyUnitedKingdom,
+		bankIDLength:            BankIDLengthUnitedKingdom,
+		bankIDRequired:          true,
+		bankIDCode:              BankIDCodeUnitedKingdom,
+		bankIDCodeRequired:      true,
+		bicRequired:             true,
+		accountNumberLengthFrom: AccountNumberLengthUnitedKingdom,
+		accountNumberLengthTo:   AccountNumberLengthUnitedKingdom,
+		ibanSupported:           true,
+	},
+	CountryAustralia: {
+		name:                          "Australia",
+		currency:                      CurrencyAustralia,
+		bankIDLength:                  BankIDLengthAustralia,
+		bankIDCode:                    BankIDCodeAustralia,
+		bankIDCodeRequired:            true,
+		bicRequired:                   true,
+		accountNumberLengthFrom:       AccountNumberLengthAustraliaStart,
+		accountNumberLengthTo:         AccountNumberLengthAustraliaStop,
+		accountNumberFirstCharNonZero: true,
+	},
+	CountryAustria: {
+		name:                    "Austria",
+		currency:                CurrencyAustria,
+		bankIDLength:            BankIDLengthAustria,
+		bankIDRequired:          true,
+		bankIDCode:              BankIDCodeAustria,
+		bankIDCodeRequired:      true,
+		accountNumberLengthFrom: AccountNumberLengthAustria,
+		accountNumberLengthTo:   AccountNumberLengthAustria,
+		ibanSupported:           true,
+	},
+	CountryBelgium: {
+		name:                    "Belgium",
+		currency:                CurrencyBelgium,
+		bankIDLength:            BankIDLengthBelgium,
+		bankIDRequired:          true,
+		bankIDCode:              BankIDCodeBelgium,
+		bankIDCodeRequired:      true,
+		accountNumberLengthFrom: AccountNumberLengthBelgium,
+		accountNumberLengthTo:   AccountNumberLengthBelgium,
+		ibanSupported:           true,
+	},
+	CountryCanada: {
+		name:                    "Canada",
+		currency:                CurrencyCanada,
+		bankIDLength:            BankIDLengthCanada,
+		bankIDFirstCharZero:     true,
+		bankIDCode:              BankIDCodeCanada,
+		bicRequired:             true,
+		accountNumberLengthFrom: AccountNumberLengthCanadaStart,
+		accountNumberLengthTo:   AccountNumberLengthCanadaStop,
+	},
+	CountryDenmark: {
+		name:                    "Denmark",
+		currency:                CurrencyDenmark,
+		bankIDLength:            BankIDLengthDenmark,
+		bankIDRequired:          true,
+		bankIDCode:              BankIDCodeDenmark,
+		accountNumberLengthFrom: AccountNumberLengthDenmark,
+		accountNumberLengthTo:   AccountNumberLengthDenmark,
+		ibanSupported:           true,
+	},
+	CountryFinland: {
+		name:                     "Finland",
+		currency:                 CurrencyFinland,
+		bankIDLength:             BankIDLengthFinland,
+		bankIDCode:               BankIDCodeFinland,
+		bicRequired:              true,
+		accountNumberLengthFrom:  AccountNumberLengthFinland,
+		accountNumberLengthTo:    AccountNumberLengthFinland,
+		accountNumberCheckDigits: true,
+		ibanSupported:            true,
+	},
+	CountryFrance: {
+		name:                    "France",
+		currency:                CurrencyFrance,
+		bankIDLength:            BankIDLengthFrance,
+		bankIDRequired:          true,
+		bankIDCode:              BankIDCodeFrance,
+		bankIDCodeRequired:      true,
+		accountNumberLengthFrom: AccountNumberLengthFrance,
+		accountNumberLengthTo:   AccountNumberLengthFrance,
+		ibanSupported:           true,
+	},
+	CountryGermany: {
+		name:                    "Germany",
+		currency:                CurrencyGermany,
+		bankIDLength:            BankIDLengthGermany,
+		bankIDRequired:          true,
+		bankIDCode:              BankIDCodeGermany,
+		bankIDCodeRequired:      true,
+		accountNumberLengthFrom: AccountNumberLengthGermany,
+		accountNumberLengthTo:   AccountNumberLengthGermany,
+		ibanSupported:           true,
+	},
+	CountryGreece: {
+		name:                    "Greece",
+		currency:                CurrencyGreecee,
+		bankIDLength:            BankIDLengthGreece,
+		bankIDRequired:          true,
+		bankIDCode:              BankIDCodeGreece,
+		bankIDCodeRequired:      true,
+		accountNumberLengthFrom: AccountNumberLengthGreece,
+		accountNumberLengthTo:   AccountNumberLengthGreece,
+		ibanSupported:           true,
+	},
+	CountryHongKong: {
+		name:                    "Hong Kong",
+		currency:                CurrencyHongKong,
+		bankIDLength:            BankIDLengthHongKong,
+		bankIDCode:              BankIDCodeHongKong,
+		bicRequired:             true,
+		accountNumberLengthFrom: AccountNumberLengthHongKongStart,
+		accountNumberLengthTo:   AccountNumberLengthHongKongStop,
+	},
+	CountryIreland: {
+		name:                    "Ireland",
+		currency:                CurrencyIreland,
+		bankIDLength:            BankIDLengthIreland,
+		bankIDRequired:          true,
+		bankIDCode:              BankIDCodeIreland,
+		bankIDCodeRequired:      true,
+		bicRequired:             true,
+		accountNumberLengthFrom: AccountNumberLengthIreland,
+		accountNumberLengthTo:   AccountNumberLengthIreland,
+		ibanSupported:           true,
+	},
+	CountryItaly: {
+		name:                          "Italy",
+		currency:                      CurrencyItaly,
+		bankIDLength:                  BankIDLengthItalyAccountNumberNotPresent,
+		bankIDLengthWithAccountNumber: BankIDLengthItalyAccountNumberPresent,
+		bankIDRequired:                true,
+		bankIDCode:                    BankIDCodeItaly,
+		bankIDCodeRequired:            true,
+		accountNumberLengthFrom:       AccountNumberLengthItaly,
+		accountNumberLengthTo:         AccountNumberLengthItaly,
+		ibanSupported:                 true,
+	},
+	CountryLuxembourg: {
+		name:                    "Luxembourg",
+		currency:                CurrencyLuxembourg,
+		bankIDLength:            BankIDLengthLuxembourg,
+		bankIDRequired:          true,
+		bankIDCode:              BankIDCodeLuxembourg,
+		bankIDCodeRequired:      true,
+		accountNumberLengthFrom: AccountNumberLengthLuxembourg,
+		accountNumberLengthTo:   AccountNumberLengthLuxembourg,
+		ibanSupported:           true,
+	},
+	CountryNetherlands: {
+		name:                    "Netherlands",
+		currency:                CurrencyNetherlands,
+		bankIDLength:            BankIDLengthNetherlands,
+		bankIDCode:              BankIDCodeNetherlands,
+		bicRequired:             true,
+		accountNumberLengthFrom: AccountNumberLengthNetherlands,
+		accountNumberLengthTo:   AccountNumberLengthNetherlands,
+		ibanSupported:           true,
+	},
+	CountryNorway: {
+		name:                     "Norway",
+		currency:                 CurrencyNorway,
+		bankIDLength:             BankIDLengthNorway,
+		bankIDCode:               BankIDCodeNorway,
+		bicRequired:              true,
+		accountNumberLengthFrom:  AccountNumberLengthNorway,
+		accountNumberLengthTo:    AccountNumberLengthNorway,
+		accountNumberCheckDigits: true,
+		ibanSupported:            true,
+	},
+	CountryPoland: {
+		name:                    "Poland",
+		currency:                CurrencyPoland,
+		bankIDLength:            BankIDLengthPoland,
+		bankIDRequired:          true,
+		bankIDCode:              BankIDCodePoland,
+		bankIDCodeRequired:      true,
+		accountNumberLengthFrom: AccountNumberLengthPoland,
+		accountNumberLengthTo:   AccountNumberLengthPoland,
+		ibanSupported:           true,
+	},
+	CountryPortugal: {
+		name:                    "Portugal",
+		currency:                CurrencyPortugal,
+		bankIDLength:            BankIDLengthPortugal,
+		bankIDRequired:          true,
+		bankIDCode:              BankIDCodePortugal,
+		bankIDCodeRequired:      true,
+		accountNumberLengthFrom: AccountNumberLengthPortugal,
+		accountNumberLengthTo:   AccountNumberLengthPortugal,
+		ibanSupported:           true,
+	},
+	CountrySpain: {
+		name:                    "Spain",
+		currency:                CurrencySpain,
+		bankIDLength:            BankIDLengthSpain,
+		bankIDRequired:          true,
+		bankIDCode:              BankIDCodeSpain,
+		bankIDCodeRequired:      true,
+		accountNumberLengthFrom: AccountNumberLengthSpain,
+		accountNumberLengthTo:   AccountNumberLengthSpain,
+		ibanSupported:           true,
+	},
+	CountrySweden: {
+		name:                    "Sweden",
+		currency:                CurrencySweden,
+		bankIDLength:            BankIDLengthSweden,
+		bankIDRequired:          true,
+		bankIDCode:              BankIDCodeSweden,
+		bankIDCodeRequired:      true,
+		accountNumberLengthFrom: AccountNumberLengthSwedenStart,
+		accountNumberLengthTo:   AccountNumberLengthSwedenStop,
+		ibanSupported:           true,
+	},
+	CountrySwitzerland: {
+		name:                    "Switzerland",
+		currency:                CurrencySwitzerland,
+		bankIDLength:            BankIDLengthSwitzerland,
+		bankIDRequired:          true,
+		bankIDCode:              BankIDCodeSwitzerland,
+		bankIDCodeRequired:      true,
+		accountNumberLengthFrom: AccountNumberLengthSwitzerland,
+		accountNumberLengthTo:   AccountNumberLengthSwitzerland,
+		ibanSupported:           true,
+	},
+	CountryUnitedStates: {
+		name:                    "United States",
+		currency:                CurrencyUnitedStates,
+		bankIDLength:            BankIDLengthUnitedStates,
+		bankIDRequired:          true,
+		bankIDCode:              BankIDCodeUnitedStates,
+		bankIDCodeRequired:      true,
+		bicRequired:             true,
+		accountNumberLengthFrom: AccountNumberLengthUnitedStatesStart,
+		accountNumberLengthTo:   AccountNumberLengthUnitedStatesStop,
+	},
+}
+
+// attributes every country accepts, in the order of the Attributes fields
+var countryCommonOptionalFields = []string{
+	"account_number",
+	"base_currency",
+	"customer_id",
+	"title",
+	"first_name",
+	"name",
+	"bank_account_name",
+	"alternative_names",
+	"alternative_bank_account_names",
+	"joint_account",
+	"account_classification",
+	"status",
+	"secondary_identification",
+	"private_identification",
+	"organisation_identification",
+	"account_matching_opt_out",
+}
+
+// CountryMetadata describes the attributes an account of a country requires and accepts.
+type CountryMetadata struct {
+	// ISO 3166-1 alpha-2 code, e.g. 'GB'.
+	Code string `json:"code"`
+
+	// English name of the country.
+	Name string `json:"name"`
+
+	// JSON names of the attributes that must be set.
+	RequiredFields []string `json:"required_fields"`
+
+	// JSON names of the attributes that may be set, the others must be blank.
+	OptionalFields []string `json:"optional_fields"`
+
+	// Length of the bank ID. 0 if the country doesn't support bank IDs.
+	BankIDLength int `json:"bank_id_length"`
+
+	// Length of the bank ID when an account number is set. Only differs from
+	// BankIDLength for Italy, where the bank ID then includes the check character.
+	BankIDLengthWithAccountNumber int `json:"bank_id_length_with_account_number"`
+
+	// Length range of the account number. Both are the same for fixed length account numbers.
+	AccountNumberLengthFrom int `json:"account_number_length_from"`
+	AccountNumberLengthTo   int `json:"account_number_length_to"`
+
+	// Bank ID codes allowed for the country. Empty if the country doesn't support bank ID codes.
+	BankIDCodes []string `json:"bank_id_codes"`
+
+	// ISO 4217 code of the only base currency allowed.
+	Currency string `json:"currency"`
+
+	// BICRequired is true if the BIC must be set.
+	BICRequired bool `json:"bic_required"`
+
+	// IBANSupported is true if the server generates an IBAN for the accounts of the country.
+	IBANSupported bool `json:"iban_supported"`
+}
+
+// CountryInfo returns the metadata of a supported country. It returns an UnsupportedCountryError
+// for an ISO 3166-1 country that is not supported and an InvalidCountryError otherwise.
+func CountryInfo(country string) (CountryMetadata, error) {
+	rules, ok := countries[country]
+	if !ok {
+		return CountryMetadata{}, checkCountry(country)
+	}
+
+	info := CountryMetadata{
+		Code:                          country,
+		Name:                          rules.name,
+		RequiredFields:                []string{"country"},
+		OptionalFields:                []string{},
+		BankIDLength:                  rules.bankIDLength,
+		BankIDLengthWithAccountNumber: rules.bankIDLength,
+		AccountNumberLengthFrom:       rules.accountNumberLengthFrom,
+		AccountNumberLengthTo:         rules.accountNumberLengthTo,
+		BankIDCodes:                   []string{},
+		Currency:                      rules.currency,
+		BICRequired:                   rules.bicRequired,
+		IBANSupported:                 rules.ibanSupported,
+	}
+
+	if rules.bankIDLengthWithAccountNumber != 0 {
+		info.BankIDLengthWithAccountNumber = rules.bankIDLengthWithAccountNumber
+	}
+
+	if rules.bankIDCode != "" {
+		info.BankIDCodes = append(info.BankIDCodes, rules.bankIDCode)
+	}
+
+	addField := func(field string, supported, required bool) {
+		switch {
+		case required:
+			info.RequiredFields = append(info.RequiredFields, field)
+		case supported:
+			info.OptionalFields = append(info.OptionalFields, field)
+		}
+	}
+
+	addField("bank_id", rules.bankIDLength != 0, rules.bankIDRequired)
+	addField("bank_id_code", rules.bankIDCode != "", rules.bankIDCodeRequired)
+	addField("bic", true, rules.bicRequired)
+	info.OptionalFields = append(info.OptionalFields, countryCommonOptionalFields...)
+	addField("switched", country == CountryUnitedKingdom, false)
+
+	return info, nil
+}
+
+// SupportedCountries returns the ISO 3166-1 alpha-2 codes of the supported countries, sorted.
+func SupportedCountries() []string {
+	codes := make([]string, 0, len(countries))
+	for code := range countries {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
@@ -0,0 +1,147 @@
+package accountapi_test
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	. "github.com/alexdreptu/form3-accountapi-client"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var randomBankIDs = map[string]func() string{
+	CountryUnitedKingdom: randomBankIDUnitedKingdom,
+	CountryAustralia:     randomBankIDAustralia,
+	CountryAustria:       randomBankIDAustria,
+	CountryBelgium:       randomBankIDBelgium,
+	CountryCanada:        randomBankIDCanada,
+	CountryDenmark:       randomBankIDDenmark,
+	CountryFinland:       randomBankIDFinland,
+	CountryFrance:        randomBankIDFrance,
+	CountryGermany:       randomBankIDGermany,
+	CountryGreece:        randomBankIDGreece,
+	CountryHongKong:      randomBankIDHongKong,
+	CountryIreland:       randomBankIDIreland,
+	CountryItaly:         func() string { return randomBankIDItaly() },
+	CountryLuxembourg:    randomBankIDLuxembourg,
+	CountryNetherlands:   randomBankIDNetherlands,
+	CountryNorway:        randomBankIDNorway,
+	CountryPoland:        randomBankIDPoland,
+	CountryPortugal:      randomBankIDPortugal,
+	CountrySpain:         randomBankIDSpain,
+	CountrySweden:        randomBankIDSweden,
+	CountrySwitzerland:   randomBankIDSwitzerland,
+	CountryUnitedStates:  randomBankIDUnitedStates,
+}
+
+// newCountryInfoAccount creates an account with only the fields and the extra attributes set
+func newCountryInfoAccount(info CountryMetadata, fields []string, extra ...Attribute) error {
+	attributes := []Attribute{}
+	for _, field := range fields {
+		switch field {
+		case "country":
+			attributes = append(attributes, WithAttrCountry(info.Code))
+		case "bank_id":
+			attributes = append(attributes, WithAttrBankID(randomBankIDs[info.Code]()))
+		case "bank_id_code":
+			attributes = append(attributes, WithAttrBankIDCode(info.BankIDCodes[0]))
+		case "bic":
+			attributes = append(attributes, WithAttrBIC(randomBIC()))
+		}
+	}
+	attributes = append(attributes, extra...)
+
+	_, err := NewAccount(&Options{
+		Type:           accountType,
+		ID:             uuid.New().String(),
+		OrganisationID: uuid.New().String(),
+		Attributes:     attributes,
+	})
+	return err
+}
+
+func TestCountryInfo(t *testing.T) {
+	info, err := CountryInfo(CountryUnitedKingdom)
+	require.NoError(t, err)
+	assert.Equal(t, CountryUnitedKingdom, info.Code)
+	assert.Equal(t, "United Kingdom", info.Name)
+	assert.Equal(t, []string{"country", "bank_id", "bank_id_code", "bic"}, info.RequiredFields)
+	assert.Contains(t, info.OptionalFields, "account_number")
+	assert.Contains(t, info.OptionalFields, "switched")
+	assert.Equal(t, BankIDLengthUnitedKingdom, info.BankIDLength)
+	assert.Equal(t, AccountNumberLengthUnitedKingdom, info.AccountNumberLengthFrom)
+	assert.Equal(t, AccountNumberLengthUnitedKingdom, info.AccountNumberLengthTo)
+	assert.Equal(t, []string{BankIDCodeUnitedKingdom}, info.BankIDCodes)
+	assert.Equal(t, CurrencyUnitedKingdom, info.Currency)
+	assert.True(t, info.BICRequired)
+	assert.True(t, info.IBANSupported)
+
+	info, err = CountryInfo(CountryNetherlands)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"country", "bic"}, info.RequiredFields)
+	assert.NotContains(t, info.OptionalFields, "bank_id")
+	assert.NotContains(t, info.OptionalFields, "bank_id_code")
+	assert.NotContains(t, info.OptionalFields, "switched")
+	assert.Zero(t, info.BankIDLength)
+	assert.Empty(t, info.BankIDCodes)
+
+	info, err = CountryInfo(CountryItaly)
+	require.NoError(t, err)
+	assert.Equal(t, BankIDLengthItalyAccountNumberNotPresent, info.BankIDLength)
+	assert.Equal(t, BankIDLengthItalyAccountNumberPresent, info.BankIDLengthWithAccountNumber)
+
+	info, err = CountryInfo(CountryAustralia)
+	require.NoError(t, err)
+	assert.Equal(t, AccountNumberLengthAustraliaStart, info.AccountNumberLengthFrom)
+	assert.Equal(t, AccountNumberLengthAustraliaStop, info.AccountNumberLengthTo)
+	assert.False(t, info.IBANSupported)
+}
+
+func TestCountryInfo_InvalidCountry(t *testing.T) {
+	_, err := CountryInfo("XX")
+	require.Error(t, err)
+	var e *InvalidCountryError
+	assert.True(t, errors.As(err, &e))
+}
+
+// TestCountryInfo_Validation checks the metadata agrees with the validation of the attributes
+func TestCountryInfo_Validation(t *testing.T) {
+	for _, country := range SupportedCountries() {
+		info, err := CountryInfo(country)
+		require.NoError(t, err)
+
+		t.Run(country, func(t *testing.T) {
+			require.NoError(t, newCountryInfoAccount(info, info.RequiredFields))
+
+			for i := range info.RequiredFields {
+				fields := append([]string{}, info.RequiredFields[:i]...)
+				fields = append(fields, info.RequiredFields[i+1:]...)
+				assert.Error(t, newCountryInfoAccount(info, fields), info.RequiredFields[i])
+			}
+
+			assert.NoError(t, newCountryInfoAccount(info, info.RequiredFields, WithAttrBaseCurrency(info.Currency)))
+			assert.Error(t, newCountryInfoAccount(info, info.RequiredFields, WithAttrBaseCurrency("XXX")))
+
+			// the fields that are neither required nor optional must be blank
+			if info.BankIDLength == 0 {
+				assert.NotContains(t, info.OptionalFields, "bank_id")
+				assert.Error(t, newCountryInfoAccount(info, info.RequiredFields, WithAttrBankID("123456")))
+			}
+			if len(info.BankIDCodes) == 0 {
+				assert.NotContains(t, info.OptionalFields, "bank_id_code")
+				assert.Error(t, newCountryInfoAccount(info, info.RequiredFields, WithAttrBankIDCode("ABCDEF")))
+			}
+		})
+	}
+}
+
+func TestSupportedCountries(t *testing.T) {
+	countries := SupportedCountries()
+	assert.Len(t, countries, len(randomBankIDs))
+	assert.True(t, sort.StringsAreSorted(countries))
+	for _, country := range countries {
+		assert.Contains(t, randomBankIDs, country)
+	}
+}
@@ -0,0 +1,358 @@
+package accountapi
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// DirectoryEntry is a bank or a branch of a bank in a Directory.
+type DirectoryEntry struct {
+	// ISO 3166-1 code of the country of the bank, e.g. 'GB'.
+	Country string `json:"country"`
+
+	// Local country bank identifier, e.g. a sort code.
+	BankID string `json:"bank_id,omitempty"`
+
+	// SWIFT BIC, always in the 11 character format.
+	BIC string `json:"bic,omitempty"`
+
+	BankName   string `json:"bank_name,omitempty"`
+	BranchName string `json:"branch_name,omitempty"`
+	Town       string `json:"town,omitempty"`
+	Postcode   string `json:"postcode,omitempty"`
+}
+
+// column names of the reference files, mapped to the DirectoryEntry fields
+var directoryColumns = map[string]string{
+	"country":               "country",
+	"country_code":          "country",
+	"sort_code":             "bank_id",
+	"sortcode":              "bank_id",
+	"bank_id":               "bank_id",
+	"national_id":           "bank_id",
+	"bic":                   "bic",
+	"bank_name":             "bank_name",
+	"institution_name":      "bank_name",
+	"owning_bank_long_name": "bank_name",
+	"branch_name":           "branch_name",
+	"full_branch_title":     "branch_name",
+	"town":                  "town",
+	"city":                  "town",
+	"postcode":              "postcode",
+	"post_code":             "postcode",
+}
+
+// directoryFile is a reference file loaded into a Directory
+type directoryFile struct {
+	path string
+
+	// bics is true for BIC directories and false for sort code files
+	bics bool
+
+	modTime time.Time
+	size    int64
+}
+
+// Directory is an in-memory index of banks, loaded from local reference files:
+// EISCD-style sort code files and BIC directories. It's safe for concurrent use.
+type Directory struct {
+	mu        sync.RWMutex
+	files     []*directoryFile
+	bankIDs   map[string]DirectoryEntry
+	bics      map[string]DirectoryEntry
+	countries map[string]bool
+}
+
+// NewDirectory returns an empty Directory.
+func NewDirectory() *Directory {
+	return &Directory{
+		bankIDs:   map[string]DirectoryEntry{},
+		bics:      map[string]DirectoryEntry{},
+		countries: map[string]bool{},
+	}
+}
+
+// LoadSortCodeFile loads an EISCD-style CSV file of UK sort codes. The first row is the
+// header, the 'Sort Code' column is required and 'BIC', 'Bank Name', 'Branch Name',
+// 'Town' and 'Postcode' are optional. Sort codes can contain hyphens, e.g. '40-03-00'.
+func (d *Directory) LoadSortCodeFile(path string) error {
+	return d.load(&directoryFile{path: path})
+}
+
+// LoadBICFile loads a BIC directory CSV file. The first row is the header, the 'BIC'
+// column is required and 'Country', 'Bank ID', 'Bank Name', 'Branch Name', 'Town'
+// and 'Postcode' are optional. The country defaults to the country of the BIC.
+// Rows with a bank ID can also be looked up with Lookup.
+func (d *Directory) LoadBICFile(path string) error {
+	return d.load(&directoryFile{path: path, bics: true})
+}
+
+func (d *Directory) load(file *directoryFile) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	files := append(d.files[:len(d.files):len(d.files)], file)
+	if err := d.index(files); err != nil {
+		return err
+	}
+	d.files = files
+	return nil
+}
+
+// Reload loads all the files again. If a file cannot be loaded the directory is left unchanged.
+func (d *Directory) Reload() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.index(d.files)
+}
+
+// Watch checks the loaded files for changes every interval, and reloads the directory
+// when one of them has been modified. It blocks until the context is done. Errors
+// are passed to onError, if set, and the directory keeps the last loaded version.
+func (d *Directory) Watch(ctx context.Context, interval time.Duration, onError func(err error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !d.modified() {
+				continue
+			}
+			if err := d.Reload(); err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+// modified reports if any of the files changed since they were last checked,
+// so a file that cannot be loaded is only reported once
+func (d *Directory) modified() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	modified := false
+	for _, f := range d.files {
+		info, err := os.Stat(f.path)
+		if err != nil {
+			continue
+		}
+		if !info.ModTime().Equal(f.modTime) || info.Size() != f.size {
+			f.modTime, f.size = info.ModTime(), info.Size()
+			modified = true
+		}
+	}
+	return modified
+}
+
+// index parses the files and replaces the index, it must be called with the lock held
+func (d *Directory) index(files []*directoryFile) error {
+	bankIDs := map[string]DirectoryEntry{}
+	bics := map[string]DirectoryEntry{}
+	countries := map[string]bool{}
+	modTimes := make([]time.Time, len(files))
+	sizes := make([]int64, len(files))
+
+	for i, f := range files {
+		file, err := os.Open(f.path)
+		if err != nil {
+			return err
+		}
+
+		info, err := file.Stat()
+		if err != nil {
+			file.Close()
+			return err
+		}
+		modTimes[i], sizes[i] = info.ModTime(), info.Size()
+
+		var entries []DirectoryEntry
+		if f.bics {
+			entries, err = parseBICs(file)
+		} else {
+			entries, err = parseSortCodes(file)
+		}
+		file.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %w", f.path, err)
+		}
+
+		for _, e := range entries {
+			if e.BankID != "" {
+				bankIDs[directoryKey(e.Country, e.BankID)] = e
+				countries[e.Country] = true
+			}
+			if f.bics {
+				bics[e.BIC] = e
+			}
+		}
+	}
+
+	for i, f := range files {
+		f.modTime, f.size = modTimes[i], sizes[i]
+	}
+	d.bankIDs, d.bics, d.countries = bankIDs, bics, countries
+	return nil
+}
+
+// Lookup returns the bank or branch with the bank ID in the country. Separators in the
+// bank ID are ignored. It returns an UnknownBankIDError if there is no such bank.
+func (d *Directory) Lookup(country, bankID string) (DirectoryEntry, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	entry, ok := d.bankIDs[directoryKey(country, bankID)]
+	if !ok {
+		return DirectoryEntry{}, &UnknownBankIDError{Country: country, BankID: bankID}
+	}
+	return entry, nil
+}
+
+// LookupBIC returns the bank or branch with the BIC, in either 8 or 11 character format.
+// It returns an UnknownBICError if there is no such bank.
+func (d *Directory) LookupBIC(bic string) (DirectoryEntry, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	entry, ok := d.bics[normalizeDirectoryBIC(bic)]
+	if !ok {
+		return DirectoryEntry{}, &UnknownBICError{bic}
+	}
+	return entry, nil
+}
+
+// validate checks the bank ID and the BIC of the attributes exist in the directory.
+// Bank IDs are only checked for countries the directory has bank IDs for, and BICs
+// only if a BIC directory has been loaded.
+func (d *Directory) validate(a *Attributes) error {
+	d.mu.RLock()
+	checkBankID := a.BankID != "" && d.countries[a.Country]
+	checkBIC := a.BIC != "" && len(d.bics) != 0
+	d.mu.RUnlock()
+
+	errs := validation.Errors{}
+	if checkBankID {
+		if _, err := d.Lookup(a.Country, a.BankID); err != nil {
+			errs["bank_id"] = err
+		}
+	}
+	if checkBIC {
+		if _, err := d.LookupBIC(a.BIC); err != nil {
+			errs["bic"] = err
+		}
+	}
+	return errs.Filter()
+}
+
+func directoryKey(country, bankID string) string {
+	return strings.ToUpper(country) + "/" + removeCharacters(bankID, numberSeparators)
+}
+
+// normalizeDirectoryBIC converts a BIC to the 11 character format
+func normalizeDirectoryBIC(bic string) string {
+	bic = strings.ToUpper(bic)
+	if len(bic) == BICLength8 {
+		bic += "XXX"
+	}
+	return bic
+}
+
+func parseSortCodes(r io.Reader) ([]DirectoryEntry, error) {
+	entries, err := parseDirectoryCSV(r, "bank_id")
+	if err != nil {
+		return nil, err
+	}
+	for i := range entries {
+		entries[i].Country = CountryUnitedKingdom
+	}
+	return entries, nil
+}
+
+func parseBICs(r io.Reader) ([]DirectoryEntry, error) {
+	entries, err := parseDirectoryCSV(r, "bic")
+	if err != nil {
+		return nil, err
+	}
+	for i := range entries {
+		if entries[i].Country == "" && len(entries[i].BIC) >= 6 {
+			entries[i].Country = entries[i].BIC[4:6]
+		}
+	}
+	return entries, nil
+}
+
+// parseDirectoryCSV reads the entries of a CSV file with a header row, the required
+// column must be set in every row
+func parseDirectoryCSV(r io.Reader, required string) ([]DirectoryEntry, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("missing header")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		name = strings.NewReplacer(" ", "_", "-", "_").Replace(name)
+		if field, ok := directoryColumns[name]; ok {
+			columns[field] = i
+		}
+	}
+	if _, ok := columns[required]; !ok {
+		return nil, fmt.Errorf("missing column '%s'", required)
+	}
+
+	entries := []DirectoryEntry{}
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		value := func(field string) string {
+			i, ok := columns[field]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		if value(required) == "" {
+			return nil, fmt.Errorf("line %d: missing %s", line, required)
+		}
+
+		entry := DirectoryEntry{
+			Country:    strings.ToUpper(value("country")),
+			BankID:     removeCharacters(value("bank_id"), numberSeparators),
+			BankName:   value("bank_name"),
+			BranchName: value("branch_name"),
+			Town:       value("town"),
+			Postcode:   value("postcode"),
+		}
+		if bic := value("bic"); bic != "" {
+			entry.BIC = normalizeDirectoryBIC(bic)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
@@ -0,0 +1,195 @@
+package accountapi_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/alexdreptu/form3-accountapi-client"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSortCodes = `Sort Code,BIC,Owning Bank Long Name,Full Branch Title,Town,Postcode
+40-03-00,HBUKGB4B,HSBC UK BANK PLC,Leicester Clock Tower,Leicester,LE1 5WE
+20-00-00,BARCGB22,BARCLAYS BANK PLC,1 Churchill Place,London,E14 5HP
+`
+
+const testBICs = `BIC,Country,National ID,Institution Name,City
+HBUKGB4BXXX,,,HSBC UK BANK PLC,Birmingham
+BARCGB22,,,BARCLAYS BANK PLC,London
+DEUTDEFF,DE,50070010,DEUTSCHE BANK AG,Frankfurt am Main
+`
+
+// writeDirectoryFile writes a reference file to a temporary directory and returns its path
+func writeDirectoryFile(t *testing.T, name, content string) string {
+	dir, err := ioutil.TempDir("", "directory")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	path := filepath.Join(dir, name)
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+func newTestDirectory(t *testing.T) *Directory {
+	d := NewDirectory()
+	require.NoError(t, d.LoadSortCodeFile(writeDirectoryFile(t, "sortcodes.csv", testSortCodes)))
+	require.NoError(t, d.LoadBICFile(writeDirectoryFile(t, "bics.csv", testBICs)))
+	return d
+}
+
+func TestDirectory_Lookup(t *testing.T) {
+	d := newTestDirectory(t)
+
+	entry, err := d.Lookup(CountryUnitedKingdom, "400300")
+	require.NoError(t, err)
+	assert.Equal(t, DirectoryEntry{
+		Country:    CountryUnitedKingdom,
+		BankID:     "400300",
+		BIC:        "HBUKGB4BXXX",
+		BankName:   "HSBC UK BANK PLC",
+		BranchName: "Leicester Clock Tower",
+		Town:       "Leicester",
+		Postcode:   "LE1 5WE",
+	}, entry)
+
+	entry, err = d.Lookup(CountryUnitedKingdom, "20-00-00")
+	require.NoError(t, err)
+	assert.Equal(t, "BARCLAYS BANK PLC", entry.BankName)
+
+	// bank IDs from the BIC directory
+	entry, err = d.Lookup(CountryGermany, "50070010")
+	require.NoError(t, err)
+	assert.Equal(t, "DEUTDEFFXXX", entry.BIC)
+
+	_, err = d.Lookup(CountryUnitedKingdom, "999999")
+	require.Error(t, err)
+	var e *UnknownBankIDError
+	assert.True(t, errors.As(err, &e))
+}
+
+func TestDirectory_LookupBIC(t *testing.T) {
+	d := newTestDirectory(t)
+
+	for _, bic := range []string{"HBUKGB4B", "HBUKGB4BXXX", "hbukgb4b"} {
+		entry, err := d.LookupBIC(bic)
+		require.NoError(t, err, bic)
+		assert.Equal(t, CountryUnitedKingdom, entry.Country)
+		assert.Equal(t, "Birmingham", entry.Town)
+	}
+
+	entry, err := d.LookupBIC("DEUTDEFFXXX")
+	require.NoError(t, err)
+	assert.Equal(t, CountryGermany, entry.Country)
+
+	_, err = d.LookupBIC("NWBKGB22")
+	require.Error(t, err)
+	var e *UnknownBICError
+	assert.True(t, errors.As(err, &e))
+}
+
+func TestDirectory_InvalidFile(t *testing.T) {
+	d := NewDirectory()
+	assert.Error(t, d.LoadSortCodeFile(writeDirectoryFile(t, "sortcodes.csv", "BIC,Town\nHBUKGB4B,Leicester\n")))
+	assert.Error(t, d.LoadSortCodeFile(writeDirectoryFile(t, "sortcodes.csv", "Sort Code,Town\n,Leicester\n")))
+	assert.Error(t, d.LoadBICFile(writeDirectoryFile(t, "bics.csv", "")))
+	assert.Error(t, d.LoadBICFile(filepath.Join(os.TempDir(), uuid.New().String())))
+}
+
+func newDirectoryTestAccount(d *Directory, country, bankID, bic string) error {
+	bankIDCode := BankIDCodeUnitedKingdom
+	if country == CountryGermany {
+		bankIDCode = BankIDCodeGermany
+	}
+
+	_, err := NewAccount(&Options{
+		Type:           accountType,
+		ID:             uuid.New().String(),
+		OrganisationID: uuid.New().String(),
+		Attributes: []Attribute{
+			WithAttrCountry(country),
+			WithAttrBIC(bic),
+			WithAttrBankID(bankID),
+			WithAttrBankIDCode(bankIDCode),
+		},
+		Directory: d,
+	})
+	return err
+}
+
+func TestNewAccount_Directory(t *testing.T) {
+	d := newTestDirectory(t)
+
+	require.NoError(t, newDirectoryTestAccount(d, CountryUnitedKingdom, "400300", "HBUKGB4B"))
+
+	err := newDirectoryTestAccount(d, CountryUnitedKingdom, "999999", "NWBKGB22")
+	require.Error(t, err)
+	var errs validation.Errors
+	require.True(t, errors.As(err, &errs))
+	var unknownBankID *UnknownBankIDError
+	assert.True(t, errors.As(errs["bank_id"], &unknownBankID))
+	var unknownBIC *UnknownBICError
+	assert.True(t, errors.As(errs["bic"], &unknownBIC))
+
+	require.NoError(t, newDirectoryTestAccount(d, CountryGermany, "50070010", "DEUTDEFF"))
+
+	// BICs are only checked if a BIC directory is loaded and bank IDs
+	// only for the countries in the directory
+	d = NewDirectory()
+	require.NoError(t, d.LoadSortCodeFile(writeDirectoryFile(t, "sortcodes.csv", testSortCodes)))
+	require.NoError(t, newDirectoryTestAccount(d, CountryUnitedKingdom, "400300", "NWBKGB22"))
+	require.NoError(t, newDirectoryTestAccount(d, CountryGermany, randomBankIDGermany(), "DEUTDEFF"))
+}
+
+func TestDirectory_Reload(t *testing.T) {
+	path := writeDirectoryFile(t, "sortcodes.csv", testSortCodes)
+	d := NewDirectory()
+	require.NoError(t, d.LoadSortCodeFile(path))
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("Sort Code\n600001\n"), 0644))
+	require.NoError(t, d.Reload())
+	_, err := d.Lookup(CountryUnitedKingdom, "600001")
+	assert.NoError(t, err)
+	_, err = d.Lookup(CountryUnitedKingdom, "400300")
+	assert.Error(t, err)
+
+	// a file that cannot be loaded leaves the directory unchanged
+	require.NoError(t, ioutil.WriteFile(path, []byte("Town\nLeicester\n"), 0644))
+	assert.Error(t, d.Reload())
+	_, err = d.Lookup(CountryUnitedKingdom, "600001")
+	assert.NoError(t, err)
+}
+
+func TestDirectory_Watch(t *testing.T) {
+	path := writeDirectoryFile(t, "sortcodes.csv", testSortCodes)
+	d := NewDirectory()
+	require.NoError(t, d.LoadSortCodeFile(path))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errs := make(chan error, 10)
+	go d.Watch(ctx, 10*time.Millisecond, func(err error) { errs <- err })
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(testSortCodes+"60-00-01,NWBKGB2L\n"), 0644))
+	assert.Eventually(t, func() bool {
+		_, err := d.Lookup(CountryUnitedKingdom, "600001")
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("Town\nLeicester\n"), 0644))
+	select {
+	case err := <-errs:
+		assert.Error(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("no reload error")
+	}
+	_, err := d.Lookup(CountryUnitedKingdom, "600001")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,117 @@
+package accountapi
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RecordedRequest is a request a Client in dry-run mode didn't send.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+
+	// Body of the request, a JSON string if the body isn't JSON.
+	Body json.RawMessage `json:"body,omitempty"`
+
+	Time time.Time `json:"time"`
+}
+
+// DryRun records the mutating requests of a Client instead of sending them, see Client.DryRun.
+// It's safe for concurrent use.
+type DryRun struct {
+	mu       sync.Mutex
+	requests []RecordedRequest
+}
+
+// NewDryRun returns a DryRun with no recorded requests.
+func NewDryRun() *DryRun {
+	return &DryRun{requests: []RecordedRequest{}}
+}
+
+// Requests returns the recorded requests, in the order they were made.
+func (d *DryRun) Requests() []RecordedRequest {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	requests := make([]RecordedRequest, len(d.requests))
+	copy(requests, d.requests)
+	return requests
+}
+
+// Reset removes the recorded requests.
+func (d *DryRun) Reset() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.requests = []RecordedRequest{}
+}
+
+// MarshalJSON encodes the recorded requests as a JSON array.
+func (d *DryRun) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.Requests())
+}
+
+// record records the request and returns the response the server would send:
+// the request body for creates and updates and no content for deletes
+func (d *DryRun) record(req *http.Request) (*Response, error) {
+	var body []byte
+	if req.GetBody != nil {
+		reader, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		if body, err = ioutil.ReadAll(reader); err != nil {
+			return nil, err
+		}
+	}
+
+	recorded := RecordedRequest{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Header: req.Header.Clone(),
+		Time:   time.Now(),
+	}
+	if len(body) != 0 {
+		if json.Valid(body) {
+			recorded.Body = append(json.RawMessage(nil), body...)
+		} else {
+			recorded.Body, _ = json.Marshal(string(body))
+		}
+	}
+
+	d.mu.Lock()
+	d.requests = append(d.requests, recorded)
+	d.mu.Unlock()
+
+	response := &Response{
+		Method: req.Method,
+		URL:    recorded.URL,
+		Header: http.Header{},
+		DryRun: true,
+	}
+
+	switch req.Method {
+	case http.MethodPost:
+		response.StatusCode, response.body = http.StatusCreated, body
+	case http.MethodDelete:
+		response.StatusCode = http.StatusNoContent
+	default:
+		response.StatusCode, response.body = http.StatusOK, body
+	}
+
+	return response, nil
+}
+
+// isReadMethod reports if requests with the method don't change the accounts
+func isReadMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,116 @@
+package accountapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/alexdreptu/form3-accountapi-client"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_DryRun(t *testing.T) {
+	methods := []string{}
+	var sent []byte
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			methods = append(methods, r.Method)
+			switch r.Method {
+			case http.MethodPost:
+				sent, _ = ioutil.ReadAll(r.Body)
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write(sent)
+			case http.MethodGet:
+				_, _ = w.Write([]byte(`{"data": [], "links": {}}`))
+			}
+		},
+	))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	dryRun := NewDryRun()
+	responses := []*Response{}
+	client := NewClient(&http.Client{}, server.URL)
+	client.DryRun = dryRun
+	client.OnResponse = func(resp *Response) {
+		responses = append(responses, resp)
+	}
+
+	account := newValidateTestAccount(t)
+	created, err := client.CreateAccount(ctx, account)
+	require.NoError(t, err)
+	assert.Equal(t, account.Data.ID, created.Data.ID)
+	assert.Equal(t, account.Data.Attributes, created.Data.Attributes)
+
+	require.NoError(t, client.DeleteAccount(ctx, account.Data.ID, 3))
+
+	// reads are sent
+	_, err = client.ListAccounts(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{http.MethodGet}, methods)
+
+	requests := dryRun.Requests()
+	require.Len(t, requests, 2)
+
+	assert.Equal(t, http.MethodPost, requests[0].Method)
+	assert.Equal(t, server.URL, requests[0].URL)
+	assert.Equal(t, "application/vnd.api+json", requests[0].Header.Get("Content-Type"))
+
+	assert.Equal(t, http.MethodDelete, requests[1].Method)
+	assert.Equal(t, server.URL+"/"+account.Data.ID+"?version=3", requests[1].URL)
+	assert.Empty(t, requests[1].Body)
+
+	require.Len(t, responses, 3)
+	assert.True(t, responses[0].DryRun)
+	assert.Equal(t, http.StatusCreated, responses[0].StatusCode)
+	assert.True(t, responses[1].DryRun)
+	assert.Equal(t, http.StatusNoContent, responses[1].StatusCode)
+	assert.False(t, responses[2].DryRun)
+
+	// the recorded body is the one that is sent without dry-run
+	client.DryRun = nil
+	_, err = client.CreateAccount(ctx, account)
+	require.NoError(t, err)
+	assert.Equal(t, string(sent), string(requests[0].Body))
+
+	data, err := json.Marshal(dryRun)
+	require.NoError(t, err)
+	var decoded []RecordedRequest
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Len(t, decoded, 2)
+	assert.Equal(t, requests[1].URL, decoded[1].URL)
+	assert.JSONEq(t, string(sent), string(decoded[0].Body))
+
+	dryRun.Reset()
+	assert.Empty(t, dryRun.Requests())
+}
+
+func TestClient_DryRun_Validation(t *testing.T) {
+	dryRun := NewDryRun()
+	client := NewClient(&http.Client{}, "http://localhost:1")
+	client.DryRun = dryRun
+	client.SkipValidation = true
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// accounts are validated in dry-run mode even if validation is skipped
+	account := newValidateTestAccount(t)
+	account.Data.Attributes.BankID = "1"
+	_, err := client.CreateAccount(ctx, account)
+	require.Error(t, err)
+	var errs validation.Errors
+	assert.True(t, errors.As(err, &errs))
+
+	assert.Error(t, client.DeleteAccount(ctx, "invalid", 0))
+	assert.Empty(t, dryRun.Requests())
+}
@@ -0,0 +1,481 @@
+package accountapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Export formats
+const (
+	ExportCSV    = "csv"
+	ExportNDJSON = "ndjson"
+)
+
+// ExportColumn is a column of an export. Value returns a string, a bool, an int or
+// a []string; lists are joined in CSV files and kept as arrays in NDJSON files.
+type ExportColumn struct {
+	Name string
+
+	// PII marks the columns that are masked when ExportOptions.MaskPII is set.
+	PII bool
+
+	Value func(d *Data) interface{}
+}
+
+func exportAttribute(name string, pii bool, value func(a *Attributes) interface{}) ExportColumn {
+	return ExportColumn{Name: name, PII: pii, Value: func(d *Data) interface{} {
+		if d.Attributes == nil {
+			return value(&Attributes{})
+		}
+		return value(d.Attributes)
+	}}
+}
+
+func exportPrivateIdentification(name string, value func(id *PrivateIdentification) interface{}) ExportColumn {
+	return exportAttribute("private_identification."+name, true, func(a *Attributes) interface{} {
+		if a.PrivateIdentification == nil {
+			return value(&PrivateIdentification{})
+		}
+		return value(a.PrivateIdentification)
+	})
+}
+
+func exportOrganisationIdentification(name string, pii bool, value func(id *OrganisationIdentification) interface{}) ExportColumn {
+	return exportAttribute("organisation_identification."+name, pii, func(a *Attributes) interface{} {
+		if a.OrganisationIdentification == nil {
+			return value(&OrganisationIdentification{})
+		}
+		return value(a.OrganisationIdentification)
+	})
+}
+
+func exportRepresentative(name string, value func(r *Representative) interface{}) ExportColumn {
+	return exportOrganisationIdentification("representative."+name, true, func(id *OrganisationIdentification) interface{} {
+		if id.Representative == nil {
+			return value(&Representative{})
+		}
+		return value(id.Representative)
+	})
+}
+
+// ExportColumns returns every column an export can have, in their default order: the details
+// of the resource, the attributes by JSON name, and the identification attributes flattened,
+// e.g. 'private_identification.birth_date'. Names, account numbers, customer IDs and
+// identifications are PII.
+func ExportColumns() []ExportColumn {
+	return []ExportColumn{
+		{Name: "id", Value: func(d *Data) interface{} { return d.ID }},
+		{Name: "organisation_id", Value: func(d *Data) interface{} { return d.OrganisationID }},
+		{Name: "type", Value: func(d *Data) interface{} { return d.Type }},
+		{Name: "version", Value: func(d *Data) interface{} { return d.Version }},
+		{Name: "created_on", Value: func(d *Data) interface{} { return d.CreatedOn }},
+		{Name: "modified_on", Value: func(d *Data) interface{} { return d.ModifiedOn }},
+
+		exportAttribute("country", false, func(a *Attributes) interface{} { return a.Country }),
+		exportAttribute("base_currency", false, func(a *Attributes) interface{} { return a.BaseCurrency }),
+		exportAttribute("bank_id", false, func(a *Attributes) interface{} { return a.BankID }),
+		exportAttribute("bank_id_code", false, func(a *Attributes) interface{} { return a.BankIDCode }),
+		exportAttribute("account_number", true, func(a *Attributes) interface{} { return a.AccountNumber }),
+		exportAttribute("bic", false, func(a *Attributes) interface{} { return a.BIC }),
+		exportAttribute("customer_id", true, func(a *Attributes) interface{} { return a.CustomerID }),
+		exportAttribute("title", true, func(a *Attributes) interface{} { return a.Title }),
+		exportAttribute("first_name", true, func(a *Attributes) interface{} { return a.FirstName }),
+		exportAttribute("name", true, func(a *Attributes) interface{} { return a.Name }),
+		exportAttribute("bank_account_name", true, func(a *Attributes) interface{} { return a.BankAccountName }),
+		exportAttribute("alternative_names", true, func(a *Attributes) interface{} { return a.AlternativeNames }),
+		exportAttribute("alternative_bank_account_names", true, func(a *Attributes) interface{} {
+			return a.AlternativeBankAccountNames
+		}),
+		exportAttribute("joint_account", false, func(a *Attributes) interface{} { return a.JointAccount }),
+		exportAttribute("account_classification", false, func(a *Attributes) interface{} { return a.AccountClassification }),
+		exportAttribute("status", false, func(a *Attributes) interface{} { return a.Status }),
+		exportAttribute("switched", false, func(a *Attributes) interface{} { return a.Switched }),
+		exportAttribute("secondary_identification", true, func(a *Attributes) interface{} {
+			return a.SecondaryIdentification
+		}),
+		exportAttribute("account_matching_opt_out", false, func(a *Attributes) interface{} { return a.AccountMatchingOptOut }),
+
+		exportPrivateIdentification("birth_date", func(id *PrivateIdentification) interface{} { return id.BirthDate }),
+		exportPrivateIdentification("birth_country", func(id *PrivateIdentification) interface{} { return id.BirthCountry }),
+		exportPrivateIdentification("identification", func(id *PrivateIdentification) interface{} { return id.Identification }),
+		exportPrivateIdentification("address", func(id *PrivateIdentification) interface{} { return id.Address }),
+		exportPrivateIdentification("city", func(id *PrivateIdentification) interface{} { return id.City }),
+		exportPrivateIdentification("country", func(id *PrivateIdentification) interface{} { return id.Country }),
+
+		exportOrganisationIdentification("identification", false, func(id *OrganisationIdentification) interface{} {
+			return id.Identification
+		}),
+		exportOrganisationIdentification("registration_number", false, func(id *OrganisationIdentification) interface{} {
+			return id.RegistrationNumber
+		}),
+		exportRepresentative("name", func(r *Representative) interface{} { return r.Name }),
+		exportRepresentative("birth_date", func(r *Representative) interface{} { return r.BirthDate }),
+		exportRepresentative("residency", func(r *Representative) interface{} { return r.Residency }),
+		exportOrganisationIdentification("address", false, func(id *OrganisationIdentification) interface{} {
+			return id.Address
+		}),
+		exportOrganisationIdentification("city", false, func(id *OrganisationIdentification) interface{} { return id.City }),
+		exportOrganisationIdentification("country", false, func(id *OrganisationIdentification) interface{} {
+			return id.Country
+		}),
+	}
+}
+
+// SelectExportColumns returns the columns of ExportColumns with the names, in that order.
+func SelectExportColumns(names ...string) ([]ExportColumn, error) {
+	all := map[string]ExportColumn{}
+	for _, column := range ExportColumns() {
+		all[column.Name] = column
+	}
+
+	columns := make([]ExportColumn, 0, len(names))
+	for _, name := range names {
+		column, ok := all[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown export column '%s'", name)
+		}
+		columns = append(columns, column)
+	}
+	return columns, nil
+}
+
+// MaskValue replaces every character of the value but the last 4 with '*',
+// or every character if the value has 4 or fewer. It's the default mask of Client.Export.
+func MaskValue(value string) string {
+	length := utf8.RuneCountInString(value)
+	if length <= 4 {
+		return strings.Repeat("*", length)
+	}
+	runes := []rune(value)
+	return strings.Repeat("*", length-4) + string(runes[length-4:])
+}
+
+// ExportOptions configures Client.Export
+type ExportOptions struct {
+	// Format is ExportCSV, the default, or ExportNDJSON.
+	Format string
+
+	// Columns of the export, defaults to ExportColumns.
+	Columns []ExportColumn
+
+	// OrganisationID, if set, exports only the accounts of the organisation, they are
+	// listed with WithFilterOrganisationID.
+	OrganisationID string
+
+	// PageSize of the ListAccounts calls, defaults to 100.
+	PageSize int
+
+	// MaskPII masks the values of the PII columns with Mask, which defaults to MaskValue.
+	MaskPII bool
+	Mask    func(value string) string
+
+	// ListSeparator joins the lists in CSV files, defaults to ";".
+	ListSeparator string
+
+	// StartPage is the first page that is exported. The CSV header is only
+	// written if the export starts at page 0.
+	StartPage int
+
+	// CheckpointFile, if set, records the last page that was written, so an export that
+	// was interrupted resumes after it, see ExportCheckpoint. The output is truncated to the
+	// end of that page, to drop the records of an incomplete page, so it must be a file, or
+	// a writer with Truncate and Seek methods like *os.File; a resume fails with
+	// ErrExportNotResumable otherwise.
+	//
+	// The checkpoint is a page number: accounts created or deleted between the runs shift
+	// the pages, so a resumed export can miss or repeat accounts. Resume only exports of
+	// accounts that don't change meanwhile, or start again.
+	CheckpointFile string
+
+	// OnPage, if set, is called after every page is written.
+	OnPage func(checkpoint ExportCheckpoint)
+}
+
+// ExportCheckpoint is the progress of an export, after a page was written.
+type ExportCheckpoint struct {
+	// Last page that was written, -1 if none.
+	Page int `json:"page"`
+
+	// Accounts written so far, including the previous runs.
+	Accounts int `json:"accounts"`
+
+	// Offset of the end of the page in the output.
+	Offset int64 `json:"offset"`
+}
+
+// ReadExportCheckpoint reads the checkpoint file of an export.
+func ReadExportCheckpoint(path string) (ExportCheckpoint, error) {
+	var checkpoint ExportCheckpoint
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return checkpoint, err
+	}
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		return checkpoint, fmt.Errorf("%s: %w", path, err)
+	}
+	return checkpoint, nil
+}
+
+// writeExportCheckpoint replaces the checkpoint file, through a temporary file so
+// a crash leaves either the old or the new checkpoint
+func writeExportCheckpoint(path string, checkpoint ExportCheckpoint) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// countingWriter counts the bytes written to w
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// Export writes every account, walking all the pages of ListAccounts, to w as CSV with
+// a header row or as NDJSON, a JSON object per line. It returns the checkpoint after the
+// last page. The pages are read until one is empty or has no link to a next page.
+func (c *Client) Export(ctx context.Context, w io.Writer, opt ExportOptions) (ExportCheckpoint, error) {
+	if opt.Format == "" {
+		opt.Format = ExportCSV
+	}
+	if opt.Format != ExportCSV && opt.Format != ExportNDJSON {
+		return ExportCheckpoint{}, fmt.Errorf("unknown export format '%s'", opt.Format)
+	}
+	if opt.Columns == nil {
+		opt.Columns = ExportColumns()
+	}
+	if opt.PageSize <= 0 {
+		opt.PageSize = 100
+	}
+	if opt.Mask == nil {
+		opt.Mask = MaskValue
+	}
+	if opt.ListSeparator == "" {
+		opt.ListSeparator = ";"
+	}
+
+	checkpoint := ExportCheckpoint{Page: opt.StartPage - 1}
+	if opt.CheckpointFile != "" {
+		previous, err := ReadExportCheckpoint(opt.CheckpointFile)
+		switch {
+		case err == nil:
+			checkpoint = previous
+			if err := resumeExportOutput(w, previous.Offset); err != nil {
+				return checkpoint, err
+			}
+		case !os.IsNotExist(err):
+			return checkpoint, err
+		}
+	}
+
+	out := &countingWriter{w: w, n: checkpoint.Offset}
+	e := &exporter{opt: &opt, out: out}
+	if opt.Format == ExportCSV {
+		e.csv = csv.NewWriter(out)
+		if checkpoint.Page < 0 {
+			if err := e.writeHeader(); err != nil {
+				return checkpoint, err
+			}
+		}
+	}
+
+	filters := []ListFilter{}
+	if opt.OrganisationID != "" {
+		filters = append(filters, WithFilterOrganisationID(opt.OrganisationID))
+	}
+
+	for page := checkpoint.Page + 1; ; page++ {
+		accounts, err := c.ListAccounts(ctx, page, opt.PageSize, filters...)
+		if err != nil {
+			return checkpoint, err
+		}
+
+		written := 0
+		for i := range accounts.Data {
+			d := &accounts.Data[i]
+			// accounts of other organisations are skipped if the server ignores the filter
+			if opt.OrganisationID != "" && d.OrganisationID != opt.OrganisationID {
+				continue
+			}
+			if err := e.writeRecord(d); err != nil {
+				return checkpoint, err
+			}
+			written++
+		}
+		if err := e.flush(); err != nil {
+			return checkpoint, err
+		}
+
+		checkpoint = ExportCheckpoint{Page: page, Accounts: checkpoint.Accounts + written, Offset: out.n}
+		if opt.CheckpointFile != "" {
+			if err := writeExportCheckpoint(opt.CheckpointFile, checkpoint); err != nil {
+				return checkpoint, err
+			}
+		}
+		if opt.OnPage != nil {
+			opt.OnPage(checkpoint)
+		}
+
+		if accounts.lastPage() {
+			return checkpoint, nil
+		}
+	}
+}
+
+// exportTruncater is an output an export can resume, like *os.File
+type exportTruncater interface {
+	io.Seeker
+	Truncate(size int64) error
+}
+
+// resumeExportOutput drops what was written after the checkpoint
+func resumeExportOutput(w io.Writer, offset int64) error {
+	output, ok := w.(exportTruncater)
+	if !ok {
+		return ErrExportNotResumable
+	}
+	if err := output.Truncate(offset); err != nil {
+		return err
+	}
+	_, err := output.Seek(offset, io.SeekStart)
+	return err
+}
+
+// exporter writes the records of an export
+type exporter struct {
+	opt *ExportOptions
+	out *countingWriter
+	csv *csv.Writer
+}
+
+func (e *exporter) writeHeader() error {
+	header := make([]string, len(e.opt.Columns))
+	for i, column := range e.opt.Columns {
+		header[i] = column.Name
+	}
+	return e.csv.Write(header)
+}
+
+// value returns the value of the column, masked if it's PII
+func (e *exporter) value(column ExportColumn, d *Data) interface{} {
+	value := column.Value(d)
+	if !column.PII || !e.opt.MaskPII {
+		return value
+	}
+
+	switch v := value.(type) {
+	case string:
+		return e.opt.Mask(v)
+	case []string:
+		masked := make([]string, len(v))
+		for i := range v {
+			masked[i] = e.opt.Mask(v[i])
+		}
+		return masked
+	default:
+		return value
+	}
+}
+
+func (e *exporter) writeRecord(d *Data) error {
+	if e.csv != nil {
+		record := make([]string, len(e.opt.Columns))
+		for i, column := range e.opt.Columns {
+			switch v := e.value(column, d).(type) {
+			case nil:
+			case string:
+				record[i] = v
+			case []string:
+				record[i] = strings.Join(v, e.opt.ListSeparator)
+			case bool:
+				record[i] = strconv.FormatBool(v)
+			case int:
+				record[i] = strconv.Itoa(v)
+			default:
+				record[i] = fmt.Sprint(v)
+			}
+		}
+		return e.csv.Write(record)
+	}
+
+	// the members are written in the order of the columns
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, column := range e.opt.Columns {
+		if i != 0 {
+			b.WriteByte(',')
+		}
+		name, err := json.Marshal(column.Name)
+		if err != nil {
+			return err
+		}
+		value, err := json.Marshal(e.value(column, d))
+		if err != nil {
+			return err
+		}
+		b.Write(name)
+		b.WriteByte(':')
+		b.Write(value)
+	}
+	b.WriteString("}\n")
+	_, err := e.out.Write(b.Bytes())
+	return err
+}
+
+// flush writes the buffered records and syncs files, before a page is recorded as written
+func (e *exporter) flush() error {
+	if e.csv != nil {
+		e.csv.Flush()
+		if err := e.csv.Error(); err != nil {
+			return err
+		}
+	}
+
+	// the records must be on disk before the checkpoint that includes them
+	if e.opt.CheckpointFile == "" {
+		return nil
+	}
+	file, ok := e.out.w.(*os.File)
+	if !ok {
+		return nil
+	}
+	if info, err := file.Stat(); err != nil || !info.Mode().IsRegular() {
+		return nil
+	}
+	return file.Sync()
+}
@@ -0,0 +1,282 @@
+package accountapi_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/alexdreptu/form3-accountapi-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	exportTestOrganisationID = "eb0bd6f5-c3f5-44b2-b677-acd23cdde73c"
+	exportOtherOrganisation  = "1b1b1b1b-c3f5-44b2-b677-acd23cdde73c"
+)
+
+// exportTestServer serves the pages of ListAccounts from a slice
+type exportTestServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	accounts []Data
+	pages    []int
+
+	// maxPageSize, if set, caps the page size asked for
+	maxPageSize int
+
+	// organisations the accounts were filtered by
+	organisations []string
+
+	// failPage, if not negative, is answered with an error once
+	failPage int
+}
+
+func newExportTestServer(t *testing.T, n int) *exportTestServer {
+	s := &exportTestServer{failPage: -1}
+	for i := 0; i < n; i++ {
+		organisationID := exportTestOrganisationID
+		if i%5 == 4 {
+			organisationID = exportOtherOrganisation
+		}
+		s.accounts = append(s.accounts, Data{
+			Details: Details{
+				Type:           "accounts",
+				ID:             fmt.Sprintf("%08d-9605-4b4b-a0e5-3003ea9cc4dc", i),
+				OrganisationID: organisationID,
+				Version:        i % 3,
+			},
+			Attributes: &Attributes{
+				Country:                     CountryUnitedKingdom,
+				BankID:                      "400300",
+				AccountNumber:               fmt.Sprintf("%08d", 41426800+i),
+				Name:                        []string{"Samantha", "Holder"},
+				AlternativeBankAccountNames: []string{"Sam Holder", "S Holder"},
+				JointAccount:                i%2 == 0,
+			},
+		})
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *exportTestServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page[number]"))
+	size, _ := strconv.Atoi(r.URL.Query().Get("page[size]"))
+	if page == s.failPage {
+		s.failPage = -1
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{`))
+		return
+	}
+	s.pages = append(s.pages, page)
+	if s.maxPageSize > 0 && size > s.maxPageSize {
+		size = s.maxPageSize
+	}
+
+	organisationID := r.URL.Query().Get("filter[organisation_id]")
+	s.organisations = append(s.organisations, organisationID)
+	all := []Data{}
+	for _, d := range s.accounts {
+		if organisationID == "" || d.OrganisationID == organisationID {
+			all = append(all, d)
+		}
+	}
+
+	accounts := Accounts{Data: []Data{}}
+	for i := page * size; i < len(all) && i < (page+1)*size; i++ {
+		accounts.Data = append(accounts.Data, all[i])
+	}
+	if (page+1)*size < len(all) {
+		accounts.Links.Next = fmt.Sprintf("/?page[number]=%d&page[size]=%d", page+1, size)
+	}
+	_ = json.NewEncoder(w).Encode(accounts)
+}
+
+func exportTestContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func TestExport_CSV(t *testing.T) {
+	server := newExportTestServer(t, 25)
+	client := NewClient(&http.Client{}, server.URL)
+
+	var out bytes.Buffer
+	checkpoint, err := client.Export(exportTestContext(t), &out, ExportOptions{PageSize: 10})
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2}, server.pages)
+	assert.Equal(t, ExportCheckpoint{Page: 2, Accounts: 25, Offset: int64(out.Len())}, checkpoint)
+
+	records, err := csv.NewReader(&out).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 26)
+
+	header := records[0]
+	assert.Len(t, header, len(ExportColumns()))
+	assert.Equal(t, []string{"id", "organisation_id", "type", "version"}, header[:4])
+	assert.Contains(t, header, "private_identification.birth_date")
+	assert.Contains(t, header, "organisation_identification.representative.name")
+
+	row := map[string]string{}
+	for i, name := range header {
+		row[name] = records[2][i]
+	}
+	assert.Equal(t, server.accounts[1].ID, row["id"])
+	assert.Equal(t, "1", row["version"])
+	assert.Equal(t, "41426801", row["account_number"])
+	assert.Equal(t, "Samantha;Holder", row["name"])
+	assert.Equal(t, "Sam Holder;S Holder", row["alternative_bank_account_names"])
+	assert.Equal(t, "false", row["joint_account"])
+	assert.Equal(t, "", row["private_identification.birth_date"])
+}
+
+func TestExport_ColumnsAndMasking(t *testing.T) {
+	server := newExportTestServer(t, 10)
+	client := NewClient(&http.Client{}, server.URL)
+
+	columns, err := SelectExportColumns("id", "account_number", "name", "joint_account")
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	checkpoint, err := client.Export(exportTestContext(t), &out, ExportOptions{
+		Format:         ExportNDJSON,
+		Columns:        columns,
+		OrganisationID: exportTestOrganisationID,
+		MaskPII:        true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 8, checkpoint.Accounts)
+	// the organisation is filtered by the server
+	assert.Equal(t, []string{exportTestOrganisationID}, server.organisations)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 8)
+	// members are written in the order of the columns
+	assert.Equal(t, `{"id":"`+server.accounts[0].ID+`","account_number":"****6800",`+
+		`"name":["****ntha","**lder"],"joint_account":true}`, lines[0])
+
+	// custom columns and masks
+	out.Reset()
+	_, err = client.Export(exportTestContext(t), &out, ExportOptions{
+		Columns: []ExportColumn{
+			columns[0],
+			{Name: "holder", PII: true, Value: func(d *Data) interface{} { return d.Attributes.FullName() }},
+		},
+		MaskPII:       true,
+		Mask:          func(string) string { return "redacted" },
+		ListSeparator: "|",
+	})
+	require.NoError(t, err)
+	records, err := csv.NewReader(&out).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id", "holder"}, records[0])
+	assert.Equal(t, []string{server.accounts[0].ID, "redacted"}, records[1])
+
+	_, err = SelectExportColumns("id", "iban")
+	assert.EqualError(t, err, "unknown export column 'iban'")
+
+	_, err = client.Export(exportTestContext(t), &out, ExportOptions{Format: "parquet"})
+	assert.Error(t, err)
+}
+
+func TestExport_CappedPageSize(t *testing.T) {
+	// the server serves fewer accounts than the page size asked for
+	server := newExportTestServer(t, 25)
+	server.maxPageSize = 4
+	client := NewClient(&http.Client{}, server.URL)
+
+	var out bytes.Buffer
+	checkpoint, err := client.Export(exportTestContext(t), &out, ExportOptions{Format: ExportNDJSON, PageSize: 10})
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6}, server.pages)
+	assert.Equal(t, 25, checkpoint.Accounts)
+	assert.Equal(t, 25, strings.Count(out.String(), "\n"))
+}
+
+func TestExport_Resume(t *testing.T) {
+	dir, err := ioutil.TempDir("", "export")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	outputFile := filepath.Join(dir, "accounts.ndjson")
+	checkpointFile := filepath.Join(dir, "accounts.checkpoint")
+
+	server := newExportTestServer(t, 35)
+	server.failPage = 2
+	client := NewClient(&http.Client{}, server.URL)
+
+	opt := ExportOptions{Format: ExportNDJSON, PageSize: 10, CheckpointFile: checkpointFile}
+	checkpoints := []ExportCheckpoint{}
+	opt.OnPage = func(checkpoint ExportCheckpoint) {
+		checkpoints = append(checkpoints, checkpoint)
+	}
+
+	file, err := os.OpenFile(outputFile, os.O_RDWR|os.O_CREATE, 0600)
+	require.NoError(t, err)
+	_, err = client.Export(exportTestContext(t), file, opt)
+	require.Error(t, err)
+	require.Len(t, checkpoints, 2)
+
+	checkpoint, err := ReadExportCheckpoint(checkpointFile)
+	require.NoError(t, err)
+	assert.Equal(t, checkpoints[1], checkpoint)
+	assert.Equal(t, ExportCheckpoint{Page: 1, Accounts: 20, Offset: checkpoint.Offset}, checkpoint)
+
+	// an output that can't be truncated to the checkpoint can't be resumed
+	var buffer bytes.Buffer
+	_, err = client.Export(exportTestContext(t), &buffer, opt)
+	assert.True(t, errors.Is(err, ErrExportNotResumable), err)
+	assert.Zero(t, buffer.Len())
+
+	// the process crashed in the middle of a page
+	_, err = file.WriteString(`{"id":"incomplete`)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	file, err = os.OpenFile(outputFile, os.O_RDWR, 0600)
+	require.NoError(t, err)
+	checkpoint, err = client.Export(exportTestContext(t), file, opt)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	assert.Equal(t, 3, checkpoint.Page)
+	assert.Equal(t, 35, checkpoint.Accounts)
+	assert.Equal(t, []int{0, 1, 2, 3}, server.pages)
+
+	file, err = os.Open(outputFile)
+	require.NoError(t, err)
+	defer file.Close()
+	ids := []string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record struct {
+			ID string `json:"id"`
+		}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record), scanner.Text())
+		ids = append(ids, record.ID)
+	}
+	require.Len(t, ids, 35)
+	for i, id := range ids {
+		assert.Equal(t, server.accounts[i].ID, id)
+	}
+}
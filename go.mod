@@ -6,4 +6,6 @@ require (
 	github.com/go-ozzo/ozzo-validation/v4 v4.1.0
 	github.com/google/uuid v1.1.1
 	github.com/stretchr/testify v1.5.1
+	golang.org/x/text v0.3.3
+	gopkg.in/yaml.v2 v2.2.2
 )
@@ -0,0 +1,262 @@
+package accountapi
+
+import (
+	"time"
+	"unicode/utf8"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// date format used by the identification dates
+const dateFormat = "2006-01-02"
+
+// Identification attribute lengths
+const (
+	identificationLengthStop     = 140
+	addressArrayLengthStart      = 1
+	addressArrayLengthStop       = 3
+	addressElemLengthStart       = 1
+	addressElemLengthStop        = 140
+	cityLengthStop               = 35
+	representativeNameLengthStop = 140
+)
+
+// PrivateIdentification holds the identification of a private account holder.
+type PrivateIdentification struct {
+	// Date of birth of the account holder, formatted as YYYY-MM-DD.
+	BirthDate string `json:"birth_date,omitempty"`
+
+	// ISO 3166-1 code of the country of birth of the account holder.
+	BirthCountry string `json:"birth_country,omitempty"`
+
+	// Identification number of the account holder, e.g. a passport or national ID number.
+	// Up to 140 characters.
+	Identification string `json:"identification,omitempty"`
+
+	// Address of the account holder, up to 3 lines of up to 140 characters each.
+	Address []string `json:"address,omitempty"`
+
+	// City of the account holder, up to 35 characters.
+	City string `json:"city,omitempty"`
+
+	// ISO 3166-1 code of the country of residence of the account holder.
+	Country string `json:"country,omitempty"`
+}
+
+// OrganisationIdentification holds the identification of an organisation account holder.
+type OrganisationIdentification struct {
+	// Identification of the organisation, e.g. a tax or VAT number.
+	// Up to 140 characters.
+	Identification string `json:"identification,omitempty"`
+
+	// Registration number of the organisation in the local company register.
+	// Up to 140 characters.
+	RegistrationNumber string `json:"registration_number,omitempty"`
+
+	// Person acting on behalf of the organisation.
+	Representative *Representative `json:"representative,omitempty"`
+
+	// Address of the organisation, up to 3 lines of up to 140 characters each.
+	Address []string `json:"address,omitempty"`
+
+	// City of the organisation, up to 35 characters.
+	City string `json:"city,omitempty"`
+
+	// ISO 3166-1 code of the country of the organisation.
+	Country string `json:"country,omitempty"`
+}
+
+// Representative holds the details of a person acting on behalf of an organisation.
+type Representative struct {
+	// Name of the representative, up to 140 characters.
+	Name string `json:"name,omitempty"`
+
+	// Date of birth of the representative, formatted as YYYY-MM-DD.
+	BirthDate string `json:"birth_date,omitempty"`
+
+	// ISO 3166-1 code of the country of residence of the representative.
+	Residency string `json:"residency,omitempty"`
+}
+
+func WithAttrPrivateIdentification(id PrivateIdentification) Attribute {
+	return func(a *Attributes) {
+		a.PrivateIdentification = &id
+	}
+}
+
+func WithAttrOrganisationIdentification(id OrganisationIdentification) Attribute {
+	return func(a *Attributes) {
+		a.OrganisationIdentification = &id
+	}
+}
+
+var validateCountryCodeMatch = validation.By(
+	func(value interface{}) error {
+		country, _ := value.(string)
+		if country == "" {
+			return nil
+		}
+		length := len(country)
+		if length != countryLength {
+			return &InvalidCountryLengthError{
+				MustLength: countryLength,
+				Length:     length,
+			}
+		}
+		if c, ok := iso3166Index[country]; !ok || c.Alpha2 != country {
+			return &InvalidCountryError{country}
+		}
+		return nil
+	},
+)
+
+var validateBirthDate = validation.By(
+	func(value interface{}) error {
+		date, _ := value.(string)
+		if date == "" {
+			return nil
+		}
+		t, err := time.Parse(dateFormat, date)
+		if err != nil {
+			return &InvalidDateError{date}
+		}
+		if t.After(time.Now()) {
+			return ErrDateInFuture
+		}
+		return nil
+	},
+)
+
+var validateIdentificationLength = validation.By(
+	func(value interface{}) error {
+		id, _ := value.(string)
+		length := utf8.RuneCountInString(id)
+		if length > identificationLengthStop {
+			return &InvalidIdentificationLengthError{
+				MustLengthTo: identificationLengthStop,
+				Length:       length,
+			}
+		}
+		return nil
+	},
+)
+
+var validateAddressArrayLength = validation.By(
+	func(value interface{}) error {
+		array, _ := value.([]string)
+		length := len(array)
+		if length != 0 &&
+			!(length >= addressArrayLengthStart &&
+				length <= addressArrayLengthStop) {
+			return &InvalidAddressArrayLengthError{
+				MustLengthFrom: addressArrayLengthStart,
+				MustLengthTo:   addressArrayLengthStop,
+				Length:         length,
+			}
+		}
+		return nil
+	},
+)
+
+var validateAddressElemLength = validation.By(
+	func(value interface{}) error {
+		line, _ := value.(string)
+		length := utf8.RuneCountInString(line)
+		if !(length >= addressElemLengthStart &&
+			length <= addressElemLengthStop) {
+			return &InvalidAddressElemLengthError{
+				MustLengthFrom: addressElemLengthStart,
+				MustLengthTo:   addressElemLengthStop,
+				Length:         length,
+			}
+		}
+		return nil
+	},
+)
+
+var validateCityLength = validation.By(
+	func(value interface{}) error {
+		city, _ := value.(string)
+		length := utf8.RuneCountInString(city)
+		if length > cityLengthStop {
+			return &InvalidCityLengthError{
+				MustLengthTo: cityLengthStop,
+				Length:       length,
+			}
+		}
+		return nil
+	},
+)
+
+func (p *PrivateIdentification) validate() error {
+	if p == nil {
+		return nil
+	}
+
+	validateAddress := []validation.Rule{
+		validateAddressArrayLength,
+		validation.Each(validateAddressElemLength),
+	}
+
+	return validation.ValidateStruct(p,
+		validation.Field(&p.BirthDate, validateBirthDate),
+		validation.Field(&p.BirthCountry, validateCountryCodeMatch),
+		validation.Field(&p.Identification, validateIdentificationLength),
+		validation.Field(&p.Address, validateAddress...),
+		validation.Field(&p.City, validateCityLength),
+		validation.Field(&p.Country, validateCountryCodeMatch),
+	)
+}
+
+func (o *OrganisationIdentification) validate() error {
+	if o == nil {
+		return nil
+	}
+
+	validateAddress := []validation.Rule{
+		validateAddressArrayLength,
+		validation.Each(validateAddressElemLength),
+	}
+
+	validateRepresentative := validation.By(
+		func(value interface{}) error {
+			r, _ := value.(*Representative)
+			return r.validate()
+		},
+	)
+
+	return validation.ValidateStruct(o,
+		validation.Field(&o.Identification, validateIdentificationLength),
+		validation.Field(&o.RegistrationNumber, validateIdentificationLength),
+		validation.Field(&o.Representative, validateRepresentative),
+		validation.Field(&o.Address, validateAddress...),
+		validation.Field(&o.City, validateCityLength),
+		validation.Field(&o.Country, validateCountryCodeMatch),
+	)
+}
+
+func (r *Representative) validate() error {
+	if r == nil {
+		return nil
+	}
+
+	validateNameLength := validation.By(
+		func(value interface{}) error {
+			name, _ := value.(string)
+			length := utf8.RuneCountInString(name)
+			if length > representativeNameLengthStop {
+				return &InvalidRepresentativeNameLengthError{
+					MustLengthTo: representativeNameLengthStop,
+					Length:       length,
+				}
+			}
+			return nil
+		},
+	)
+
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Name, validateNameLength),
+		validation.Field(&r.BirthDate, validateBirthDate),
+		validation.Field(&r.Residency, validateCountryCodeMatch),
+	)
+}
@@ -0,0 +1,226 @@
+package accountapi_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/alexdreptu/form3-accountapi-client"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAccount_Identification(t *testing.T) {
+	tomorrow := time.Now().AddDate(0, 0, 1).Format("2006-01-02")
+
+	testCases := []struct {
+		name         string
+		shouldError  bool
+		private      *PrivateIdentification
+		organisation *OrganisationIdentification
+	}{
+		{
+			name:        "private identification",
+			shouldError: false,
+			private: &PrivateIdentification{
+				BirthDate:      "2017-07-23",
+				BirthCountry:   CountryUnitedKingdom,
+				Identification: "13YH458762",
+				Address:        []string{"10 Avenue des Champs"},
+				City:           "London",
+				Country:        CountryUnitedKingdom,
+			},
+		},
+		{
+			name:        "invalid private identification birth date",
+			shouldError: true,
+			private:     &PrivateIdentification{BirthDate: "23/07/2017"},
+		},
+		{
+			name:        "invalid private identification birth date in future",
+			shouldError: true,
+			private:     &PrivateIdentification{BirthDate: tomorrow},
+		},
+		{
+			name:        "invalid private identification birth country",
+			shouldError: true,
+			private:     &PrivateIdentification{BirthCountry: "gb"},
+		},
+		{
+			name:        "invalid private identification identification length 141",
+			shouldError: true,
+			private:     &PrivateIdentification{Identification: randomAlpha(141)},
+		},
+		{
+			name:        "invalid private identification address array length 4",
+			shouldError: true,
+			private:     &PrivateIdentification{Address: []string{"a", "b", "c", "d"}},
+		},
+		{
+			name:        "invalid private identification address element blank",
+			shouldError: true,
+			private:     &PrivateIdentification{Address: []string{""}},
+		},
+		{
+			name:        "invalid private identification city length 36",
+			shouldError: true,
+			private:     &PrivateIdentification{City: randomAlpha(36)},
+		},
+		{
+			name:        "private identification accented lengths in characters",
+			shouldError: false,
+			private: &PrivateIdentification{
+				Identification: strings.Repeat("é", 140),
+				Address:        []string{strings.Repeat("é", 140)},
+				City:           strings.Repeat("é", 35),
+			},
+		},
+		{
+			name:        "invalid private identification accented city length 36",
+			shouldError: true,
+			private:     &PrivateIdentification{City: strings.Repeat("é", 36)},
+		},
+		{
+			name:        "organisation identification",
+			shouldError: false,
+			organisation: &OrganisationIdentification{
+				Identification:     "123654",
+				RegistrationNumber: "SC123456",
+				Representative: &Representative{
+					Name:      "Jeff Page",
+					BirthDate: "1970-01-01",
+					Residency: CountryUnitedKingdom,
+				},
+				Address: []string{"Unit 2", "10 Avenue des Champs"},
+				City:    "London",
+				Country: CountryUnitedKingdom,
+			},
+		},
+		{
+			name:        "organisation identification accented representative name length 140",
+			shouldError: false,
+			organisation: &OrganisationIdentification{
+				Representative: &Representative{Name: strings.Repeat("é", 140)},
+				Address:        []string{strings.Repeat("é", 140)},
+				City:           strings.Repeat("é", 35),
+			},
+		},
+		{
+			name:        "invalid organisation identification registration number length 141",
+			shouldError: true,
+			organisation: &OrganisationIdentification{
+				RegistrationNumber: randomAlpha(141),
+			},
+		},
+		{
+			name:        "invalid organisation identification representative birth date",
+			shouldError: true,
+			organisation: &OrganisationIdentification{
+				Representative: &Representative{BirthDate: "1970-13-01"},
+			},
+		},
+		{
+			name:        "invalid organisation identification representative residency",
+			shouldError: true,
+			organisation: &OrganisationIdentification{
+				Representative: &Representative{Residency: "GBR"},
+			},
+		},
+		{
+			name:        "invalid organisation identification country",
+			shouldError: true,
+			organisation: &OrganisationIdentification{
+				Country: "G1",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			attributes := []Attribute{
+				WithAttrCountry(CountryUnitedKingdom),
+				WithAttrBIC(randomBIC()),
+				WithAttrBankID(randomBankIDUnitedKingdom()),
+				WithAttrBankIDCode(BankIDCodeUnitedKingdom),
+			}
+			if tc.private != nil {
+				attributes = append(attributes, WithAttrPrivateIdentification(*tc.private))
+			}
+			if tc.organisation != nil {
+				attributes = append(attributes, WithAttrOrganisationIdentification(*tc.organisation))
+			}
+
+			account, err := NewAccount(&Options{
+				Type:           accountType,
+				ID:             uuid.New().String(),
+				OrganisationID: uuid.New().String(),
+				Attributes:     attributes,
+			})
+			if tc.shouldError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.private, account.Data.Attributes.PrivateIdentification)
+				assert.Equal(t, tc.organisation, account.Data.Attributes.OrganisationIdentification)
+			}
+		})
+	}
+}
+
+func TestIdentification_RoundTrip(t *testing.T) {
+	var stored []byte
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost {
+				stored, _ = ioutil.ReadAll(r.Body)
+				w.WriteHeader(http.StatusCreated)
+			}
+			_, _ = w.Write(stored)
+		},
+	))
+	defer server.Close()
+
+	id := uuid.New().String()
+	account, err := NewAccount(&Options{
+		Type:           accountType,
+		ID:             id,
+		OrganisationID: uuid.New().String(),
+		Attributes: []Attribute{
+			WithAttrCountry(CountryUnitedKingdom),
+			WithAttrBIC(randomBIC()),
+			WithAttrBankID(randomBankIDUnitedKingdom()),
+			WithAttrBankIDCode(BankIDCodeUnitedKingdom),
+			WithAttrPrivateIdentification(PrivateIdentification{
+				BirthDate:    "2017-07-23",
+				BirthCountry: CountryUnitedKingdom,
+				Address:      []string{"10 Avenue des Champs"},
+			}),
+			WithAttrOrganisationIdentification(OrganisationIdentification{
+				Identification: "123654",
+				Representative: &Representative{Name: "Jeff Page"},
+			}),
+		},
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	client := NewClient(&http.Client{}, server.URL)
+	createdAccount, err := client.CreateAccount(ctx, account)
+	require.NoError(t, err)
+	assert.Equal(t, account.Data.Attributes, createdAccount.Data.Attributes)
+	assert.True(t, strings.Contains(string(stored), `"private_identification":{"birth_date":"2017-07-23"`))
+	assert.True(t, strings.Contains(string(stored), `"representative":{"name":"Jeff Page"}`))
+
+	fetchedAccount, err := client.FetchAccount(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, account.Data.Attributes.PrivateIdentification, fetchedAccount.Data.Attributes.PrivateIdentification)
+	assert.Equal(t, account.Data.Attributes.OrganisationIdentification, fetchedAccount.Data.Attributes.OrganisationIdentification)
+}
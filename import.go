@@ -0,0 +1,466 @@
+package accountapi
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+// Statuses of the imported rows
+const (
+	ImportStatusValid   = "valid"
+	ImportStatusInvalid = "invalid"
+	ImportStatusCreated = "created"
+	ImportStatusFailed  = "failed"
+)
+
+// fields of the Options an import column can be mapped to
+const (
+	importFieldType           = "type"
+	importFieldID             = "id"
+	importFieldOrganisationID = "organisation_id"
+)
+
+// importAttributes sets the Attributes field a column is mapped to, by JSON name.
+// Lists are split by the separator.
+var importAttributes = map[string]func(value, separator string) (Attribute, error){
+	"country":                        importString(WithAttrCountry),
+	"base_currency":                  importString(WithAttrBaseCurrency),
+	"bank_id":                        importString(WithAttrBankID),
+	"bank_id_code":                   importString(WithAttrBankIDCode),
+	"account_number":                 importString(WithAttrAccountNumber),
+	"bic":                            importString(WithAttrBIC),
+	"customer_id":                    importString(WithAttrCustomerID),
+	"title":                          importString(WithAttrTitle),
+	"first_name":                     importString(WithAttrFirstName),
+	"name":                           importList(WithAttrName),
+	"bank_account_name":              importString(WithAttrBankAccountName),
+	"alternative_names":              importList(WithAttrAlternativeNames),
+	"alternative_bank_account_names": importList(WithAttrAlternativeBankAccountNames),
+	"joint_account":                  importBool(WithAttrJointAccount),
+	"account_classification":         importString(WithAttrAccountClassification),
+	"status":                         importString(WithAttrStatus),
+	"switched":                       importBool(WithAttrSwitched),
+	"secondary_identification":       importString(WithAttrSecondaryIdentification),
+	"account_matching_opt_out":       importBool(WithAttrAccountMatchingOptOut),
+}
+
+// importOptionFields are the JSON names of the Options fields, by the names their
+// validation errors are reported with
+var importOptionFields = map[string]string{
+	"Type":           importFieldType,
+	"ID":             importFieldID,
+	"OrganisationID": importFieldOrganisationID,
+}
+
+func importString(attr func(string) Attribute) func(string, string) (Attribute, error) {
+	return func(value, _ string) (Attribute, error) {
+		return attr(value), nil
+	}
+}
+
+func importList(attr func(...string) Attribute) func(string, string) (Attribute, error) {
+	return func(value, separator string) (Attribute, error) {
+		if value == "" {
+			return attr(), nil
+		}
+		values := strings.Split(value, separator)
+		for i := range values {
+			values[i] = strings.TrimSpace(values[i])
+		}
+		return attr(values...), nil
+	}
+}
+
+func importBool(attr func(bool) Attribute) func(string, string) (Attribute, error) {
+	return func(value, _ string) (Attribute, error) {
+		if value == "" {
+			return attr(false), nil
+		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid boolean '%s'", value)
+		}
+		return attr(b), nil
+	}
+}
+
+// ImportOptions configures Import
+type ImportOptions struct {
+	// Columns maps CSV headers to the JSON names of the Attributes fields, e.g.
+	// "Sort Code" to "bank_id", or to "id", "organisation_id" and "type". Headers
+	// are matched case insensitively, with spaces and dashes read as underscores.
+	// Headers that are not mapped are matched against the JSON names themselves,
+	// other columns are ignored.
+	Columns map[string]string
+
+	// Type and OrganisationID are used for the rows that don't set them,
+	// Type defaults to "accounts". Rows without an ID get a new UUID.
+	Type           string
+	OrganisationID string
+
+	// ListSeparator splits the name and alternative names columns, defaults to ";".
+	ListSeparator string
+
+	// Normalize, TransliterateNames and Directory are passed to NewAccount, see Options.
+	Normalize          bool
+	TransliterateNames bool
+	Directory          *Directory
+
+	// Client, if set, creates the valid rows.
+	Client *Client
+
+	// Report, if set, receives the result of every row and is closed by Import.
+	// See NewCSVImportReport and NewJSONImportReport.
+	Report ImportReport
+}
+
+// ImportResult is the outcome of a row of an import.
+type ImportResult struct {
+	// Row number, starting at 1 for the row after the header.
+	Row int `json:"row"`
+
+	ID     string `json:"id,omitempty"`
+	Status string `json:"status"`
+
+	// Validation errors by JSON field name, nested fields are joined with dots.
+	Errors map[string]string `json:"errors,omitempty"`
+
+	// Error of a row that failed to be created, e.g. the APIError with the message of
+	// the server if it rejected the row, or of a row that is invalid for a reason that
+	// isn't a field.
+	Error string `json:"error,omitempty"`
+}
+
+// ImportSummary counts the rows of an import by status.
+type ImportSummary struct {
+	Rows    int `json:"rows"`
+	Valid   int `json:"valid"`
+	Invalid int `json:"invalid"`
+	Created int `json:"created"`
+	Failed  int `json:"failed"`
+}
+
+// ImportReport receives the results of an import, a row at a time.
+type ImportReport interface {
+	WriteResult(result ImportResult) error
+	Close() error
+}
+
+// Import reads accounts from a CSV file with a header row and builds every row with
+// NewAccount, so rows are validated as if they were created by hand. Valid rows are
+// created if opt.Client is set. Rows are read, reported and created one at a time, so
+// the file doesn't have to fit in memory. Invalid rows don't stop the import, it returns
+// an error if the CSV can't be read, the report can't be written or ctx is done.
+func Import(ctx context.Context, r io.Reader, opt ImportOptions) (summary ImportSummary, err error) {
+	if opt.Report != nil {
+		defer func() {
+			if closeErr := opt.Report.Close(); closeErr != nil && err == nil {
+				err = closeErr
+			}
+		}()
+	}
+
+	if opt.Type == "" {
+		opt.Type = "accounts"
+	}
+	if opt.ListSeparator == "" {
+		opt.ListSeparator = ";"
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return summary, fmt.Errorf("missing header")
+	}
+	if err != nil {
+		return summary, err
+	}
+
+	columns, err := importColumns(header, opt.Columns)
+	if err != nil {
+		return summary, err
+	}
+
+	for row := 1; ; row++ {
+		if err := ctx.Err(); err != nil {
+			return summary, err
+		}
+
+		record, err := reader.Read()
+		if err == io.EOF {
+			return summary, nil
+		}
+		if err != nil {
+			return summary, err
+		}
+
+		result := importRow(ctx, record, columns, &opt)
+		result.Row = row
+
+		summary.Rows++
+		switch result.Status {
+		case ImportStatusValid:
+			summary.Valid++
+		case ImportStatusInvalid:
+			summary.Invalid++
+		case ImportStatusCreated:
+			summary.Created++
+		case ImportStatusFailed:
+			summary.Failed++
+		}
+
+		if opt.Report != nil {
+			if err := opt.Report.WriteResult(result); err != nil {
+				return summary, err
+			}
+		}
+	}
+}
+
+// importColumns returns the field of every column, blank for the columns that are ignored
+func importColumns(header []string, mapping map[string]string) ([]string, error) {
+	mapped := map[string]string{}
+	for name, field := range mapping {
+		if _, ok := importAttributes[field]; !ok &&
+			field != importFieldType && field != importFieldID && field != importFieldOrganisationID {
+			return nil, fmt.Errorf("column '%s' is mapped to unknown field '%s'", name, field)
+		}
+		mapped[importHeader(name)] = field
+	}
+
+	columns := make([]string, len(header))
+	seen := map[string]string{}
+	for i, name := range header {
+		name = importHeader(name)
+		field, ok := mapped[name]
+		if !ok {
+			if _, known := importAttributes[name]; known ||
+				name == importFieldType || name == importFieldID || name == importFieldOrganisationID {
+				field = name
+			}
+		}
+		if field == "" {
+			continue
+		}
+		if other, ok := seen[field]; ok {
+			return nil, fmt.Errorf("columns '%s' and '%s' are both mapped to '%s'", other, header[i], field)
+		}
+		seen[field] = header[i]
+		columns[i] = field
+	}
+	return columns, nil
+}
+
+// importHeader returns the header as it's matched, without the byte order mark
+// spreadsheets write at the start of the file
+func importHeader(name string) string {
+	name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+	return strings.NewReplacer(" ", "_", "-", "_").Replace(name)
+}
+
+// importRow builds the account of a row, and creates it if the options have a client
+func importRow(ctx context.Context, record []string, columns []string, opt *ImportOptions) ImportResult {
+	options := &Options{
+		Type:               opt.Type,
+		OrganisationID:     opt.OrganisationID,
+		Normalize:          opt.Normalize,
+		TransliterateNames: opt.TransliterateNames,
+		Directory:          opt.Directory,
+	}
+	errs := validation.Errors{}
+
+	for i, field := range columns {
+		if field == "" || i >= len(record) {
+			continue
+		}
+		value := strings.TrimSpace(record[i])
+
+		switch field {
+		case importFieldType:
+			if value != "" {
+				options.Type = value
+			}
+		case importFieldID:
+			options.ID = value
+		case importFieldOrganisationID:
+			if value != "" {
+				options.OrganisationID = value
+			}
+		default:
+			attr, err := importAttributes[field](value, opt.ListSeparator)
+			if err != nil {
+				errs[field] = err
+				continue
+			}
+			options.Attributes = append(options.Attributes, attr)
+		}
+	}
+
+	if options.ID == "" {
+		options.ID = uuid.New().String()
+	}
+	result := ImportResult{ID: options.ID, Status: ImportStatusInvalid}
+
+	account, err := NewAccount(options)
+	if err != nil {
+		var fieldErrs validation.Errors
+		if !errors.As(err, &fieldErrs) {
+			result.Error = err.Error()
+			return result
+		}
+		for field, fieldErr := range fieldErrs {
+			if name, ok := importOptionFields[field]; ok {
+				field = name
+			}
+			if _, ok := errs[field]; !ok {
+				errs[field] = fieldErr
+			}
+		}
+	}
+	if len(errs) != 0 {
+		result.Errors = flattenImportErrors("", errs)
+		return result
+	}
+
+	if opt.Client == nil {
+		result.Status = ImportStatusValid
+		return result
+	}
+
+	if _, err := opt.Client.CreateAccount(ctx, account); err != nil {
+		result.Status = ImportStatusFailed
+		result.Error = err.Error()
+		return result
+	}
+	result.Status = ImportStatusCreated
+	return result
+}
+
+// flattenImportErrors returns the messages of the errors by field, with the
+// fields of nested errors joined with dots
+func flattenImportErrors(prefix string, errs validation.Errors) map[string]string {
+	flat := map[string]string{}
+	for field, err := range errs {
+		if prefix != "" {
+			field = prefix + "." + field
+		}
+		var nested validation.Errors
+		if errors.As(err, &nested) {
+			for name, message := range flattenImportErrors(field, nested) {
+				flat[name] = message
+			}
+			continue
+		}
+		flat[field] = err.Error()
+	}
+	return flat
+}
+
+// csvImportReport writes a CSV line per row, see NewCSVImportReport
+type csvImportReport struct {
+	w      *csv.Writer
+	header bool
+}
+
+// NewCSVImportReport returns an ImportReport that writes a CSV file with the columns
+// row, id, status and errors. The errors of a row are sorted by field and joined with
+// "; ", e.g. "bank_id: the length must be exactly 6; country: cannot be blank".
+// Closing the report doesn't close w.
+func NewCSVImportReport(w io.Writer) ImportReport {
+	return &csvImportReport{w: csv.NewWriter(w)}
+}
+
+func (r *csvImportReport) WriteResult(result ImportResult) error {
+	if !r.header {
+		if err := r.w.Write([]string{"row", "id", "status", "errors"}); err != nil {
+			return err
+		}
+		r.header = true
+	}
+
+	fields := make([]string, 0, len(result.Errors))
+	for field := range result.Errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	messages := make([]string, 0, len(fields)+1)
+	if result.Error != "" {
+		messages = append(messages, result.Error)
+	}
+	for _, field := range fields {
+		messages = append(messages, field+": "+result.Errors[field])
+	}
+
+	if err := r.w.Write([]string{
+		strconv.Itoa(result.Row), result.ID, result.Status, strings.Join(messages, "; "),
+	}); err != nil {
+		return err
+	}
+	return r.w.Error()
+}
+
+func (r *csvImportReport) Close() error {
+	if !r.header {
+		if err := r.w.Write([]string{"row", "id", "status", "errors"}); err != nil {
+			return err
+		}
+	}
+	r.w.Flush()
+	return r.w.Error()
+}
+
+// jsonImportReport writes a JSON array of the results, see NewJSONImportReport
+type jsonImportReport struct {
+	w       io.Writer
+	results int
+}
+
+// NewJSONImportReport returns an ImportReport that writes a JSON array of ImportResult,
+// an element per row as soon as it's imported. The array is ended by Close, which
+// doesn't close w.
+func NewJSONImportReport(w io.Writer) ImportReport {
+	return &jsonImportReport{w: w}
+}
+
+func (r *jsonImportReport) WriteResult(result ImportResult) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	prefix := ",\n"
+	if r.results == 0 {
+		prefix = "[\n"
+	}
+	if _, err := io.WriteString(r.w, prefix); err != nil {
+		return err
+	}
+	if _, err := r.w.Write(data); err != nil {
+		return err
+	}
+	r.results++
+	return nil
+}
+
+func (r *jsonImportReport) Close() error {
+	end := "\n]\n"
+	if r.results == 0 {
+		end = "[]\n"
+	}
+	_, err := io.WriteString(r.w, end)
+	return err
+}
@@ -0,0 +1,211 @@
+package accountapi_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/alexdreptu/form3-accountapi-client"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const importTestOrganisationID = "eb0bd6f5-c3f5-44b2-b677-acd23cdde73c"
+
+func TestImport(t *testing.T) {
+	id := uuid.New().String()
+	data := "\ufeffAccount ID,Sort Code,Country,BIC,Bank ID Code,Names,Joint,Notes\n" +
+		id + ",400300,GB,NWBKGB22,GBDSC,Samantha Holder;Sam Holder,true,first\n" +
+		",40-03-01,gb,nwbkgb22,GBDSC,,,normalized\n" +
+		",1234,GB,NWBKGB22,GBDSC,,maybe,invalid\n" +
+		",400302,GB,NWBKGB22,GBDSC,,,\n"
+
+	var report bytes.Buffer
+	summary, err := Import(context.Background(), strings.NewReader(data), ImportOptions{
+		Columns: map[string]string{
+			"account id":   "id",
+			"Sort Code":    "bank_id",
+			"names":        "name",
+			"JOINT":        "joint_account",
+			"bank-id-code": "bank_id_code",
+		},
+		OrganisationID: importTestOrganisationID,
+		Normalize:      true,
+		Report:         NewJSONImportReport(&report),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ImportSummary{Rows: 4, Valid: 3, Invalid: 1}, summary)
+
+	var results []ImportResult
+	require.NoError(t, json.Unmarshal(report.Bytes(), &results), report.String())
+	require.Len(t, results, 4)
+
+	assert.Equal(t, ImportResult{Row: 1, ID: id, Status: ImportStatusValid}, results[0])
+
+	assert.Equal(t, 2, results[1].Row)
+	assert.Equal(t, ImportStatusValid, results[1].Status)
+	_, err = uuid.Parse(results[1].ID)
+	assert.NoError(t, err, "a missing ID is generated")
+	assert.NotEqual(t, results[1].ID, results[3].ID)
+
+	assert.Equal(t, ImportStatusInvalid, results[2].Status)
+	assert.Contains(t, results[2].Errors, "bank_id")
+	assert.Equal(t, "invalid boolean 'maybe'", results[2].Errors["joint_account"])
+}
+
+func TestImport_OptionErrors(t *testing.T) {
+	data := "id,organisation_id,type,country,bank_id,bank_id_code,bic\n" +
+		"invalid,,,GB,400300,GBDSC,NWBKGB22\n" +
+		",,payments,GB,400300,GBDSC,NWBKGB22\n"
+
+	var report bytes.Buffer
+	summary, err := Import(context.Background(), strings.NewReader(data), ImportOptions{
+		Report: NewCSVImportReport(&report),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, summary.Invalid)
+
+	records, err := csv.NewReader(&report).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, []string{"row", "id", "status", "errors"}, records[0])
+
+	// the errors of the options are reported with the column names, sorted
+	assert.Equal(t, []string{"1", "invalid", "invalid"}, records[1][:3])
+	assert.Regexp(t, `^id: .+; organisation_id: cannot be blank$`, records[1][3])
+	assert.Regexp(t, `^organisation_id: cannot be blank; type: .+$`, records[2][3])
+}
+
+func TestImport_Create(t *testing.T) {
+	created := []string{}
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var account Account
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&account))
+			switch account.Data.Attributes.CustomerID {
+			case "duplicate":
+				w.WriteHeader(http.StatusConflict)
+				return
+			case "rejected":
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error_message": "validation failure list:\nbic in body is not allowed"}`))
+				return
+			}
+			created = append(created, account.Data.Attributes.CustomerID)
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(account)
+		},
+	))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	data := "customer_id,country,bank_id,bank_id_code,bic\n" +
+		"10001,GB,400300,GBDSC,NWBKGB22\n" +
+		"10002,GB,123,GBDSC,NWBKGB22\n" +
+		"duplicate,GB,400300,GBDSC,NWBKGB22\n" +
+		"rejected,GB,400300,GBDSC,NWBKGB22\n" +
+		"10003,GB,400300,GBDSC,NWBKGB22\n"
+
+	var report bytes.Buffer
+	summary, err := Import(ctx, strings.NewReader(data), ImportOptions{
+		OrganisationID: importTestOrganisationID,
+		Client:         NewClient(&http.Client{}, server.URL),
+		Report:         NewCSVImportReport(&report),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ImportSummary{Rows: 5, Invalid: 1, Created: 2, Failed: 2}, summary)
+	assert.Equal(t, []string{"10001", "10003"}, created)
+
+	records, err := csv.NewReader(&report).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, ImportStatusFailed, records[3][2])
+	assert.Contains(t, records[3][3], "duplicate account")
+
+	// rows the server rejects fail with its message
+	assert.Equal(t, ImportStatusFailed, records[4][2])
+	assert.Contains(t, records[4][3], "400 Bad Request: validation failure list:\nbic in body is not allowed")
+}
+
+func TestImport_InvalidInput(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := Import(ctx, strings.NewReader(""), ImportOptions{})
+	assert.Error(t, err)
+
+	_, err = Import(ctx, strings.NewReader("country\n"), ImportOptions{
+		Columns: map[string]string{"country": "nationality"},
+	})
+	assert.EqualError(t, err, "column 'country' is mapped to unknown field 'nationality'")
+
+	_, err = Import(ctx, strings.NewReader("sort code,bank_id\n"), ImportOptions{
+		Columns: map[string]string{"sort code": "bank_id"},
+	})
+	assert.Error(t, err)
+
+	// the rows before a broken line are reported
+	var report bytes.Buffer
+	summary, err := Import(ctx, strings.NewReader("country\nGB\n\"broken\n"), ImportOptions{
+		Report: NewJSONImportReport(&report),
+	})
+	assert.Error(t, err)
+	assert.Equal(t, 1, summary.Rows)
+	var results []ImportResult
+	require.NoError(t, json.Unmarshal(report.Bytes(), &results))
+	assert.Len(t, results, 1)
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = Import(canceled, strings.NewReader("country\nGB\n"), ImportOptions{})
+	assert.Equal(t, context.Canceled, err)
+
+	report.Reset()
+	_, err = Import(ctx, strings.NewReader("country\n"), ImportOptions{Report: NewJSONImportReport(&report)})
+	require.NoError(t, err)
+	assert.Equal(t, "[]\n", report.String())
+}
+
+// importTestFile generates the rows of a CSV file as it's read
+type importTestFile struct {
+	rows, row int
+	buf       bytes.Buffer
+}
+
+func (f *importTestFile) Read(p []byte) (int, error) {
+	for f.buf.Len() < len(p) && f.row <= f.rows {
+		if f.row == 0 {
+			f.buf.WriteString("organisation_id,country,bank_id,bank_id_code,bic\n")
+		} else {
+			fmt.Fprintf(&f.buf, "%s,GB,%06d,GBDSC,NWBKGB22\n", importTestOrganisationID, f.row%1000000)
+		}
+		f.row++
+	}
+	if f.buf.Len() == 0 {
+		return 0, io.EOF
+	}
+	return f.buf.Read(p)
+}
+
+func TestImport_Stream(t *testing.T) {
+	rows := 10000
+	if testing.Short() {
+		rows = 1000
+	}
+
+	summary, err := Import(context.Background(), &importTestFile{rows: rows}, ImportOptions{
+		Report: NewCSVImportReport(ioutil.Discard),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ImportSummary{Rows: rows, Valid: rows}, summary)
+}
@@ -0,0 +1,34 @@
+// Command genschema writes the JSON Schema of the create account payload,
+// see accountapi.AccountJSONSchema.
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+
+	accountapi "github.com/alexdreptu/form3-accountapi-client"
+)
+
+func main() {
+	output := flag.String("o", "", "output file, defaults to stdout")
+	flag.Parse()
+
+	data, err := accountapi.AccountJSONSchema()
+	if err != nil {
+		log.Fatal(err)
+	}
+	data = append(data, '\n')
+
+	if *output == "" {
+		if _, err := os.Stdout.Write(data); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if err := ioutil.WriteFile(*output, data, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,162 @@
+package accountapi
+
+import "strings"
+
+// ISOCountry is a country as defined by ISO 3166-1.
+type ISOCountry struct {
+	// Two letter code, e.g. 'GB'.
+	Alpha2 string `json:"alpha2"`
+
+	// Three letter code, e.g. 'GBR'.
+	Alpha3 string `json:"alpha3"`
+
+	// Three digit code, with leading zeros, e.g. '826'.
+	Numeric string `json:"numeric"`
+
+	// English short name.
+	Name string `json:"name"`
+}
+
+// ISOCurrency is a currency as defined by ISO 4217.
+type ISOCurrency struct {
+	// Three letter code, e.g. 'GBP'.
+	Code string `json:"code"`
+
+	// Three digit code, with leading zeros, e.g. '826'.
+	Numeric string `json:"numeric"`
+
+	// Number of digits after the decimal separator,
+	// -1 for funds and precious metals that have none defined.
+	MinorUnits int `json:"minor_units"`
+
+	// English name.
+	Name string `json:"name"`
+
+	// Withdrawn is true if the currency is no longer in use.
+	Withdrawn bool `json:"withdrawn"`
+}
+
+var iso3166Index = indexISOCountries()
+
+var iso4217Index = indexISOCurrencies()
+
+// indexISOCountries maps the alpha-2, alpha-3 and numeric codes to the countries
+func indexISOCountries() map[string]ISOCountry {
+	index := make(map[string]ISOCountry, 3*len(iso3166Countries))
+	for _, c := range iso3166Countries {
+		index[c.Alpha2] = c
+		index[c.Alpha3] = c
+		index[c.Numeric] = c
+	}
+	return index
+}
+
+// indexISOCurrencies maps the alphabetic and numeric codes to the currencies. Numeric codes
+// of withdrawn currencies can be reused, those map to the active currency.
+func indexISOCurrencies() map[string]ISOCurrency {
+	index := make(map[string]ISOCurrency, 2*len(iso4217Currencies))
+	for _, c := range iso4217Currencies {
+		index[c.Code] = c
+		if existing, ok := index[c.Numeric]; !ok || existing.Withdrawn {
+			index[c.Numeric] = c
+		}
+	}
+	return index
+}
+
+// LookupISOCountry returns the ISO 3166-1 country with the alpha-2, alpha-3 or numeric code.
+// Letters are case insensitive. It returns an InvalidCountryError if there is no such country.
+func LookupISOCountry(code string) (ISOCountry, error) {
+	country, ok := iso3166Index[strings.ToUpper(code)]
+	if !ok {
+		return ISOCountry{}, &InvalidCountryError{code}
+	}
+	return country, nil
+}
+
+// ISOCountries returns all the ISO 3166-1 countries, sorted by alpha-2 code.
+func ISOCountries() []ISOCountry {
+	countries := make([]ISOCountry, len(iso3166Countries))
+	copy(countries, iso3166Countries)
+	return countries
+}
+
+// CountryAlpha2 converts an alpha-3 or numeric country code to its alpha-2 code.
+func CountryAlpha2(code string) (string, error) {
+	country, err := LookupISOCountry(code)
+	return country.Alpha2, err
+}
+
+// CountryAlpha3 converts an alpha-2 or numeric country code to its alpha-3 code.
+func CountryAlpha3(code string) (string, error) {
+	country, err := LookupISOCountry(code)
+	return country.Alpha3, err
+}
+
+// CountryNumeric converts an alpha-2 or alpha-3 country code to its numeric code.
+func CountryNumeric(code string) (string, error) {
+	country, err := LookupISOCountry(code)
+	return country.Numeric, err
+}
+
+// LookupISOCurrency returns the ISO 4217 currency, active or withdrawn, with the alphabetic
+// or numeric code. Letters are case insensitive. It returns an UnknownCurrencyError
+// if there is no such currency.
+func LookupISOCurrency(code string) (ISOCurrency, error) {
+	currency, ok := iso4217Index[strings.ToUpper(code)]
+	if !ok {
+		return ISOCurrency{}, &UnknownCurrencyError{code}
+	}
+	return currency, nil
+}
+
+// ISOCurrencies returns all the ISO 4217 currencies, active and withdrawn, sorted by code.
+func ISOCurrencies() []ISOCurrency {
+	currencies := make([]ISOCurrency, len(iso4217Currencies))
+	copy(currencies, iso4217Currencies)
+	return currencies
+}
+
+// CurrencyCode converts a numeric currency code to its alphabetic code.
+func CurrencyCode(numeric string) (string, error) {
+	currency, err := LookupISOCurrency(numeric)
+	return currency.Code, err
+}
+
+// CurrencyNumeric converts an alphabetic currency code to its numeric code.
+func CurrencyNumeric(code string) (string, error) {
+	currency, err := LookupISOCurrency(code)
+	return currency.Numeric, err
+}
+
+// checkCountry returns nil if the country is supported by the account API, an
+// UnsupportedCountryError if it is an ISO 3166-1 country the API doesn't support
+// and an InvalidCountryError otherwise
+func checkCountry(code string) error {
+	if _, ok := countries[code]; ok {
+		return nil
+	}
+
+	// only upper case alpha-2 codes are accepted by the API
+	country, ok := iso3166Index[code]
+	if !ok || country.Alpha2 != code {
+		return &InvalidCountryError{code}
+	}
+
+	return &UnsupportedCountryError{Country: code, Name: country.Name}
+}
+
+// checkCurrency returns an UnknownCurrencyError if the code isn't an ISO 4217 alphabetic code
+// and a WithdrawnCurrencyError if the currency is no longer in use
+func checkCurrency(code string) error {
+	currency, ok := iso4217Index[code]
+	if !ok || currency.Code != code {
+		return &UnknownCurrencyError{code}
+	}
+
+	if currency.Withdrawn {
+		return &WithdrawnCurrencyError{Currency: code, Name: currency.Name}
+	}
+
+	return nil
+}
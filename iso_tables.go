@@ -0,0 +1,476 @@
+package accountapi
+
+// ISO 3166-1 countries, sorted by alpha-2 code
+var iso3166Countries = []ISOCountry{
+	{Alpha2: "AD", Alpha3: "AND", Numeric: "020", Name: "Andorra"},
+	{Alpha2: "AE", Alpha3: "ARE", Numeric: "784", Name: "United Arab Emirates"},
+	{Alpha2: "AF", Alpha3: "AFG", Numeric: "004", Name: "Afghanistan"},
+	{Alpha2: "AG", Alpha3: "ATG", Numeric: "028", Name: "Antigua and Barbuda"},
+	{Alpha2: "AI", Alpha3: "AIA", Numeric: "660", Name: "Anguilla"},
+	{Alpha2: "AL", Alpha3: "ALB", Numeric: "008", Name: "Albania"},
+	{Alpha2: "AM", Alpha3: "ARM", Numeric: "051", Name: "Armenia"},
+	{Alpha2: "AO", Alpha3: "AGO", Numeric: "024", Name: "Angola"},
+	{Alpha2: "AQ", Alpha3: "ATA", Numeric: "010", Name: "Antarctica"},
+	{Alpha2: "AR", Alpha3: "ARG", Numeric: "032", Name: "Argentina"},
+	{Alpha2: "AS", Alpha3: "ASM", Numeric: "016", Name: "American Samoa"},
+	{Alpha2: "AT", Alpha3: "AUT", Numeric: "040", Name: "Austria"},
+	{Alpha2: "AU", Alpha3: "AUS", Numeric: "036", Name: "Australia"},
+	{Alpha2: "AW", Alpha3: "ABW", Numeric: "533", Name: "Aruba"},
+	{Alpha2: "AX", Alpha3: "ALA", Numeric: "248", Name: "Åland Islands"},
+	{Alpha2: "AZ", Alpha3: "AZE", Numeric: "031", Name: "Azerbaijan"},
+	{Alpha2: "BA", Alpha3: "BIH", Numeric: "070", Name: "Bosnia and Herzegovina"},
+	{Alpha2: "BB", Alpha3: "BRB", Numeric: "052", Name: "Barbados"},
+	{Alpha2: "BD", Alpha3: "BGD", Numeric: "050", Name: "Bangladesh"},
+	{Alpha2: "BE", Alpha3: "BEL", Numeric: "056", Name: "Belgium"},
+	{Alpha2: "BF", Alpha3: "BFA", Numeric: "854", Name: "Burkina Faso"},
+	{Alpha2: "BG", Alpha3: "BGR", Numeric: "100", Name: "Bulgaria"},
+	{Alpha2: "BH", Alpha3: "BHR", Numeric: "048", Name: "Bahrain"},
+	{Alpha2: "BI", Alpha3: "BDI", Numeric: "108", Name: "Burundi"},
+	{Alpha2: "BJ", Alpha3: "BEN", Numeric: "204", Name: "Benin"},
+	{Alpha2: "BL", Alpha3: "BLM", Numeric: "652", Name: "Saint Barthélemy"},
+	{Alpha2: "BM", Alpha3: "BMU", Numeric: "060", Name: "Bermuda"},
+	{Alpha2: "BN", Alpha3: "BRN", Numeric: "096", Name: "Brunei Darussalam"},
+	{Alpha2: "BO", Alpha3: "BOL", Numeric: "068", Name: "Bolivia (Plurinational State of)"},
+	{Alpha2: "BQ", Alpha3: "BES", Numeric: "535", Name: "Bonaire, Sint Eustatius and Saba"},
+	{Alpha2: "BR", Alpha3: "BRA", Numeric: "076", Name: "Brazil"},
+	{Alpha2: "BS", Alpha3: "BHS", Numeric: "044", Name: "Bahamas"},
+	{Alpha2: "BT", Alpha3: "BTN", Numeric: "064", Name: "Bhutan"},
+	{Alpha2: "BV", Alpha3: "BVT", Numeric: "074", Name: "Bouvet Island"},
+	{Alpha2: "BW", Alpha3: "BWA", Numeric: "072", Name: "Botswana"},
+	{Alpha2: "BY", Alpha3: "BLR", Numeric: "112", Name: "Belarus"},
+	{Alpha2: "BZ", Alpha3: "BLZ", Numeric: "084", Name: "Belize"},
+	{Alpha2: "CA", Alpha3: "CAN", Numeric: "124", Name: "Canada"},
+	{Alpha2: "CC", Alpha3: "CCK", Numeric: "166", Name: "Cocos (Keeling) Islands"},
+	{Alpha2: "CD", Alpha3: "COD", Numeric: "180", Name: "Congo (the Democratic Republic of the)"},
+	{Alpha2: "CF", Alpha3: "CAF", Numeric: "140", Name: "Central African Republic"},
+	{Alpha2: "CG", Alpha3: "COG", Numeric: "178", Name: "Congo"},
+	{Alpha2: "CH", Alpha3: "CHE", Numeric: "756", Name: "Switzerland"},
+	{Alpha2: "CI", Alpha3: "CIV", Numeric: "384", Name: "Côte d'Ivoire"},
+	{Alpha2: "CK", Alpha3: "COK", Numeric: "184", Name: "Cook Islands"},
+	{Alpha2: "CL", Alpha3: "CHL", Numeric: "152", Name: "Chile"},
+	{Alpha2: "CM", Alpha3: "CMR", Numeric: "120", Name: "Cameroon"},
+	{Alpha2: "CN", Alpha3: "CHN", Numeric: "156", Name: "China"},
+	{Alpha2: "CO", Alpha3: "COL", Numeric: "170", Name: "Colombia"},
+	{Alpha2: "CR", Alpha3: "CRI", Numeric: "188", Name: "Costa Rica"},
+	{Alpha2: "CU", Alpha3: "CUB", Numeric: "192", Name: "Cuba"},
+	{Alpha2: "CV", Alpha3: "CPV", Numeric: "132", Name: "Cabo Verde"},
+	{Alpha2: "CW", Alpha3: "CUW", Numeric: "531", Name: "Curaçao"},
+	{Alpha2: "CX", Alpha3: "CXR", Numeric: "162", Name: "Christmas Island"},
+	{Alpha2: "CY", Alpha3: "CYP", Numeric: "196", Name: "Cyprus"},
+	{Alpha2: "CZ", Alpha3: "CZE", Numeric: "203", Name: "Czechia"},
+	{Alpha2: "DE", Alpha3: "DEU", Numeric: "276", Name: "Germany"},
+	{Alpha2: "DJ", Alpha3: "DJI", Numeric: "262", Name: "Djibouti"},
+	{Alpha2: "DK", Alpha3: "DNK", Numeric: "208", Name: "Denmark"},
+	{Alpha2: "DM", Alpha3: "DMA", Numeric: "212", Name: "Dominica"},
+	{Alpha2: "DO", Alpha3: "DOM", Numeric: "214", Name: "Dominican Republic"},
+	{Alpha2: "DZ", Alpha3: "DZA", Numeric: "012", Name: "Algeria"},
+	{Alpha2: "EC", Alpha3: "ECU", Numeric: "218", Name: "Ecuador"},
+	{Alpha2: "EE", Alpha3: "EST", Numeric: "233", Name: "Estonia"},
+	{Alpha2: "EG", Alpha3: "EGY", Numeric: "818", Name: "Egypt"},
+	{Alpha2: "EH", Alpha3: "ESH", Numeric: "732", Name: "Western Sahara"},
+	{Alpha2: "ER", Alpha3: "ERI", Numeric: "232", Name: "Eritrea"},
+	{Alpha2: "ES", Alpha3: "ESP", Numeric: "724", Name: "Spain"},
+	{Alpha2: "ET", Alpha3: "ETH", Numeric: "231", Name: "Ethiopia"},
+	{Alpha2: "FI", Alpha3: "FIN", Numeric: "246", Name: "Finland"},
+	{Alpha2: "FJ", Alpha3: "FJI", Numeric: "242", Name: "Fiji"},
+	{Alpha2: "FK", Alpha3: "FLK", Numeric: "238", Name: "Falkland Islands (Malvinas)"},
+	{Alpha2: "FM", Alpha3: "FSM", Numeric: "583", Name: "Micronesia (Federated States of)"},
+	{Alpha2: "FO", Alpha3: "FRO", Numeric: "234", Name: "Faroe Islands"},
+	{Alpha2: "FR", Alpha3: "FRA", Numeric: "250", Name: "France"},
+	{Alpha2: "GA", Alpha3: "GAB", Numeric: "266", Name: "Gabon"},
+	{Alpha2: "GB", Alpha3: "GBR", Numeric: "826", Name: "United Kingdom of Great Britain and Northern Ireland"},
+	{Alpha2: "GD", Alpha3: "GRD", Numeric: "308", Name: "Grenada"},
+	{Alpha2: "GE", Alpha3: "GEO", Numeric: "268", Name: "Georgia"},
+	{Alpha2: "GF", Alpha3: "GUF", Numeric: "254", Name: "French Guiana"},
+	{Alpha2: "GG", Alpha3: "GGY", Numeric: "831", Name: "Guernsey"},
+	{Alpha2: "GH", Alpha3: "GHA", Numeric: "288", Name: "Ghana"},
+	{Alpha2: "GI", Alpha3: "GIB", Numeric: "292", Name: "Gibraltar"},
+	{Alpha2: "GL", Alpha3: "GRL", Numeric: "304", Name: "Greenland"},
+	{Alpha2: "GM", Alpha3: "GMB", Numeric: "270", Name: "Gambia"},
+	{Alpha2: "GN", Alpha3: "GIN", Numeric: "324", Name: "Guinea"},
+	{Alpha2: "GP", Alpha3: "GLP", Numeric: "312", Name: "Guadeloupe"},
+	{Alpha2: "GQ", Alpha3: "GNQ", Numeric: "226", Name: "Equatorial Guinea"},
+	{Alpha2: "GR", Alpha3: "GRC", Numeric: "300", Name: "Greece"},
+	{Alpha2: "GS", Alpha3: "SGS", Numeric: "239", Name: "South Georgia and the South Sandwich Islands"},
+	{Alpha2: "GT", Alpha3: "GTM", Numeric: "320", Name: "Guatemala"},
+	{Alpha2: "GU", Alpha3: "GUM", Numeric: "316", Name: "Guam"},
+	{Alpha2: "GW", Alpha3: "GNB", Numeric: "624", Name: "Guinea-Bissau"},
+	{Alpha2: "GY", Alpha3: "GUY", Numeric: "328", Name: "Guyana"},
+	{Alpha2: "HK", Alpha3: "HKG", Numeric: "344", Name: "Hong Kong"},
+	{Alpha2: "HM", Alpha3: "HMD", Numeric: "334", Name: "Heard Island and McDonald Islands"},
+	{Alpha2: "HN", Alpha3: "HND", Numeric: "340", Name: "Honduras"},
+	{Alpha2: "HR", Alpha3: "HRV", Numeric: "191", Name: "Croatia"},
+	{Alpha2: "HT", Alpha3: "HTI", Numeric: "332", Name: "Haiti"},
+	{Alpha2: "HU", Alpha3: "HUN", Numeric: "348", Name: "Hungary"},
+	{Alpha2: "ID", Alpha3: "IDN", Numeric: "360", Name: "Indonesia"},
+	{Alpha2: "IE", Alpha3: "IRL", Numeric: "372", Name: "Ireland"},
+	{Alpha2: "IL", Alpha3: "ISR", Numeric: "376", Name: "Israel"},
+	{Alpha2: "IM", Alpha3: "IMN", Numeric: "833", Name: "Isle of Man"},
+	{Alpha2: "IN", Alpha3: "IND", Numeric: "356", Name: "India"},
+	{Alpha2: "IO", Alpha3: "IOT", Numeric: "086", Name: "British Indian Ocean Territory"},
+	{Alpha2: "IQ", Alpha3: "IRQ", Numeric: "368", Name: "Iraq"},
+	{Alpha2: "IR", Alpha3: "IRN", Numeric: "364", Name: "Iran (Islamic Republic of)"},
+	{Alpha2: "IS", Alpha3: "ISL", Numeric: "352", Name: "Iceland"},
+	{Alpha2: "IT", Alpha3: "ITA", Numeric: "380", Name: "Italy"},
+	{Alpha2: "JE", Alpha3: "JEY", Numeric: "832", Name: "Jersey"},
+	{Alpha2: "JM", Alpha3: "JAM", Numeric: "388", Name: "Jamaica"},
+	{Alpha2: "JO", Alpha3: "JOR", Numeric: "400", Name: "Jordan"},
+	{Alpha2: "JP", Alpha3: "JPN", Numeric: "392", Name: "Japan"},
+	{Alpha2: "KE", Alpha3: "KEN", Numeric: "404", Name: "Kenya"},
+	{Alpha2: "KG", Alpha3: "KGZ", Numeric: "417", Name: "Kyrgyzstan"},
+	{Alpha2: "KH", Alpha3: "KHM", Numeric: "116", Name: "Cambodia"},
+	{Alpha2: "KI", Alpha3: "KIR", Numeric: "296", Name: "Kiribati"},
+	{Alpha2: "KM", Alpha3: "COM", Numeric: "174", Name: "Comoros"},
+	{Alpha2: "KN", Alpha3: "KNA", Numeric: "659", Name: "Saint Kitts and Nevis"},
+	{Alpha2: "KP", Alpha3: "PRK", Numeric: "408", Name: "Korea (the Democratic People's Republic of)"},
+	{Alpha2: "KR", Alpha3: "KOR", Numeric: "410", Name: "Korea (the Republic of)"},
+	{Alpha2: "KW", Alpha3: "KWT", Numeric: "414", Name: "Kuwait"},
+	{Alpha2: "KY", Alpha3: "CYM", Numeric: "136", Name: "Cayman Islands"},
+	{Alpha2: "KZ", Alpha3: "KAZ", Numeric: "398", Name: "Kazakhstan"},
+	{Alpha2: "LA", Alpha3: "LAO", Numeric: "418", Name: "Lao People's Democratic Republic"},
+	{Alpha2: "LB", Alpha3: "LBN", Numeric: "422", Name: "Lebanon"},
+	{Alpha2: "LC", Alpha3: "LCA", Numeric: "662", Name: "Saint Lucia"},
+	{Alpha2: "LI", Alpha3: "LIE", Numeric: "438", Name: "Liechtenstein"},
+	{Alpha2: "LK", Alpha3: "LKA", Numeric: "144", Name: "Sri Lanka"},
+	{Alpha2: "LR", Alpha3: "LBR", Numeric: "430", Name: "Liberia"},
+	{Alpha2: "LS", Alpha3: "LSO", Numeric: "426", Name: "Lesotho"},
+	{Alpha2: "LT", Alpha3: "LTU", Numeric: "440", Name: "Lithuania"},
+	{Alpha2: "LU", Alpha3: "LUX", Numeric: "442", Name: "Luxembourg"},
+	{Alpha2: "LV", Alpha3: "LVA", Numeric: "428", Name: "Latvia"},
+	{Alpha2: "LY", Alpha3: "LBY", Numeric: "434", Name: "Libya"},
+	{Alpha2: "MA", Alpha3: "MAR", Numeric: "504", Name: "Morocco"},
+	{Alpha2: "MC", Alpha3: "MCO", Numeric: "492", Name: "Monaco"},
+	{Alpha2: "MD", Alpha3: "MDA", Numeric: "498", Name: "Moldova (the Republic of)"},
+	{Alpha2: "ME", Alpha3: "MNE", Numeric: "499", Name: "Montenegro"},
+	{Alpha2: "MF", Alpha3: "MAF", Numeric: "663", Name: "Saint Martin (French part)"},
+	{Alpha2: "MG", Alpha3: "MDG", Numeric: "450", Name: "Madagascar"},
+	{Alpha2: "MH", Alpha3: "MHL", Numeric: "584", Name: "Marshall Islands"},
+	{Alpha2: "MK", Alpha3: "MKD", Numeric: "807", Name: "North Macedonia"},
+	{Alpha2: "ML", Alpha3: "MLI", Numeric: "466", Name: "Mali"},
+	{Alpha2: "MM", Alpha3: "MMR", Numeric: "104", Name: "Myanmar"},
+	{Alpha2: "MN", Alpha3: "MNG", Numeric: "496", Name: "Mongolia"},
+	{Alpha2: "MO", Alpha3: "MAC", Numeric: "446", Name: "Macao"},
+	{Alpha2: "MP", Alpha3: "MNP", Numeric: "580", Name: "Northern Mariana Islands"},
+	{Alpha2: "MQ", Alpha3: "MTQ", Numeric: "474", Name: "Martinique"},
+	{Alpha2: "MR", Alpha3: "MRT", Numeric: "478", Name: "Mauritania"},
+	{Alpha2: "MS", Alpha3: "MSR", Numeric: "500", Name: "Montserrat"},
+	{Alpha2: "MT", Alpha3: "MLT", Numeric: "470", Name: "Malta"},
+	{Alpha2: "MU", Alpha3: "MUS", Numeric: "480", Name: "Mauritius"},
+	{Alpha2: "MV", Alpha3: "MDV", Numeric: "462", Name: "Maldives"},
+	{Alpha2: "MW", Alpha3: "MWI", Numeric: "454", Name: "Malawi"},
+	{Alpha2: "MX", Alpha3: "MEX", Numeric: "484", Name: "Mexico"},
+	{Alpha2: "MY", Alpha3: "MYS", Numeric: "458", Name: "Malaysia"},
+	{Alpha2: "MZ", Alpha3: "MOZ", Numeric: "508", Name: "Mozambique"},
+	{Alpha2: "NA", Alpha3: "NAM", Numeric: "516", Name: "Namibia"},
+	{Alpha2: "NC", Alpha3: "NCL", Numeric: "540", Name: "New Caledonia"},
+	{Alpha2: "NE", Alpha3: "NER", Numeric: "562", Name: "Niger"},
+	{Alpha2: "NF", Alpha3: "NFK", Numeric: "574", Name: "Norfolk Island"},
+	{Alpha2: "NG", Alpha3: "NGA", Numeric: "566", Name: "Nigeria"},
+	{Alpha2: "NI", Alpha3: "NIC", Numeric: "558", Name: "Nicaragua"},
+	{Alpha2: "NL", Alpha3: "NLD", Numeric: "528", Name: "Netherlands (Kingdom of the)"},
+	{Alpha2: "NO", Alpha3: "NOR", Numeric: "578", Name: "Norway"},
+	{Alpha2: "NP", Alpha3: "NPL", Numeric: "524", Name: "Nepal"},
+	{Alpha2: "NR", Alpha3: "NRU", Numeric: "520", Name: "Nauru"},
+	{Alpha2: "NU", Alpha3: "NIU", Numeric: "570", Name: "Niue"},
+	{Alpha2: "NZ", Alpha3: "NZL", Numeric: "554", Name: "New Zealand"},
+	{Alpha2: "OM", Alpha3: "OMN", Numeric: "512", Name: "Oman"},
+	{Alpha2: "PA", Alpha3: "PAN", Numeric: "591", Name: "Panama"},
+	{Alpha2: "PE", Alpha3: "PER", Numeric: "604", Name: "Peru"},
+	{Alpha2: "PF", Alpha3: "PYF", Numeric: "258", Name: "French Polynesia"},
+	{Alpha2: "PG", Alpha3: "PNG", Numeric: "598", Name: "Papua New Guinea"},
+	{Alpha2: "PH", Alpha3: "PHL", Numeric: "608", Name: "Philippines"},
+	{Alpha2: "PK", Alpha3: "PAK", Numeric: "586", Name: "Pakistan"},
+	{Alpha2: "PL", Alpha3: "POL", Numeric: "616", Name: "Poland"},
+	{Alpha2: "PM", Alpha3: "SPM", Numeric: "666", Name: "Saint Pierre and Miquelon"},
+	{Alpha2: "PN", Alpha3: "PCN", Numeric: "612", Name: "Pitcairn"},
+	{Alpha2: "PR", Alpha3: "PRI", Numeric: "630", Name: "Puerto Rico"},
+	{Alpha2: "PS", Alpha3: "PSE", Numeric: "275", Name: "Palestine, State of"},
+	{Alpha2: "PT", Alpha3: "PRT", Numeric: "620", Name: "Portugal"},
+	{Alpha2: "PW", Alpha3: "PLW", Numeric: "585", Name: "Palau"},
+	{Alpha2: "PY", Alpha3: "PRY", Numeric: "600", Name: "Paraguay"},
+	{Alpha2: "QA", Alpha3: "QAT", Numeric: "634", Name: "Qatar"},
+	{Alpha2: "RE", Alpha3: "REU", Numeric: "638", Name: "Réunion"},
+	{Alpha2: "RO", Alpha3: "ROU", Numeric: "642", Name: "Romania"},
+	{Alpha2: "RS", Alpha3: "SRB", Numeric: "688", Name: "Serbia"},
+	{Alpha2: "RU", Alpha3: "RUS", Numeric: "643", Name: "Russian Federation"},
+	{Alpha2: "RW", Alpha3: "RWA", Numeric: "646", Name: "Rwanda"},
+	{Alpha2: "SA", Alpha3: "SAU", Numeric: "682", Name: "Saudi Arabia"},
+	{Alpha2: "SB", Alpha3: "SLB", Numeric: "090", Name: "Solomon Islands"},
+	{Alpha2: "SC", Alpha3: "SYC", Numeric: "690", Name: "Seychelles"},
+	{Alpha2: "SD", Alpha3: "SDN", Numeric: "729", Name: "Sudan"},
+	{Alpha2: "SE", Alpha3: "SWE", Numeric: "752", Name: "Sweden"},
+	{Alpha2: "SG", Alpha3: "SGP", Numeric: "702", Name: "Singapore"},
+	{Alpha2: "SH", Alpha3: "SHN", Numeric: "654", Name: "Saint Helena, Ascension and Tristan da Cunha"},
+	{Alpha2: "SI", Alpha3: "SVN", Numeric: "705", Name: "Slovenia"},
+	{Alpha2: "SJ", Alpha3: "SJM", Numeric: "744", Name: "Svalbard and Jan Mayen"},
+	{Alpha2: "SK", Alpha3: "SVK", Numeric: "703", Name: "Slovakia"},
+	{Alpha2: "SL", Alpha3: "SLE", Numeric: "694", Name: "Sierra Leone"},
+	{Alpha2: "SM", Alpha3: "SMR", Numeric: "674", Name: "San Marino"},
+	{Alpha2: "SN", Alpha3: "SEN", Numeric: "686", Name: "Senegal"},
+	{Alpha2: "SO", Alpha3: "SOM", Numeric: "706", Name: "Somalia"},
+	{Alpha2: "SR", Alpha3: "SUR", Numeric: "740", Name: "Suriname"},
+	{Alpha2: "SS", Alpha3: "SSD", Numeric: "728", Name: "South Sudan"},
+	{Alpha2: "ST", Alpha3: "STP", Numeric: "678", Name: "Sao Tome and Principe"},
+	{Alpha2: "SV", Alpha3: "SLV", Numeric: "222", Name: "El Salvador"},
+	{Alpha2: "SX", Alpha3: "SXM", Numeric: "534", Name: "Sint Maarten (Dutch part)"},
+	{Alpha2: "SY", Alpha3: "SYR", Numeric: "760", Name: "Syrian Arab Republic"},
+	{Alpha2: "SZ", Alpha3: "SWZ", Numeric: "748", Name: "Eswatini"},
+	{Alpha2: "TC", Alpha3: "TCA", Numeric: "796", Name: "Turks and Caicos Islands"},
+	{Alpha2: "TD", Alpha3: "TCD", Numeric: "148", Name: "Chad"},
+	{Alpha2: "TF", Alpha3: "ATF", Numeric: "260", Name: "French Southern Territories"},
+	{Alpha2: "TG", Alpha3: "TGO", Numeric: "768", Name: "Togo"},
+	{Alpha2: "TH", Alpha3: "THA", Numeric: "764", Name: "Thailand"},
+	{Alpha2: "TJ", Alpha3: "TJK", Numeric: "762", Name: "Tajikistan"},
+	{Alpha2: "TK", Alpha3: "TKL", Numeric: "772", Name: "Tokelau"},
+	{Alpha2: "TL", Alpha3: "TLS", Numeric: "626", Name: "Timor-Leste"},
+	{Alpha2: "TM", Alpha3: "TKM", Numeric: "795", Name: "Turkmenistan"},
+	{Alpha2: "TN", Alpha3: "TUN", Numeric: "788", Name: "Tunisia"},
+	{Alpha2: "TO", Alpha3: "TON", Numeric: "776", Name: "Tonga"},
+	{Alpha2: "TR", Alpha3: "TUR", Numeric: "792", Name: "Türkiye"},
+	{Alpha2: "TT", Alpha3: "TTO", Numeric: "780", Name: "Trinidad and Tobago"},
+	{Alpha2: "TV", Alpha3: "TUV", Numeric: "798", Name: "Tuvalu"},
+	{Alpha2: "TW", Alpha3: "TWN", Numeric: "158", Name: "Taiwan (Province of China)"},
+	{Alpha2: "TZ", Alpha3: "TZA", Numeric: "834", Name: "Tanzania, the United Republic of"},
+	{Alpha2: "UA", Alpha3: "UKR", Numeric: "804", Name: "Ukraine"},
+	{Alpha2: "UG", Alpha3: "UGA", Numeric: "800", Name: "Uganda"},
+	{Alpha2: "UM", Alpha3: "UMI", Numeric: "581", Name: "United States Minor Outlying Islands"},
+	{Alpha2: "US", Alpha3: "USA", Numeric: "840", Name: "United States of America"},
+	{Alpha2: "UY", Alpha3: "URY", Numeric: "858", Name: "Uruguay"},
+	{Alpha2: "UZ", Alpha3: "UZB", Numeric: "860", Name: "Uzbekistan"},
+	{Alpha2: "VA", Alpha3: "VAT", Numeric: "336", Name: "Holy See"},
+	{Alpha2: "VC", Alpha3: "VCT", Numeric: "670", Name: "Saint Vincent and the Grenadines"},
+	{Alpha2: "VE", Alpha3: "VEN", Numeric: "862", Name: "Venezuela (Bolivarian Republic of)"},
+	{Alpha2: "VG", Alpha3: "VGB", Numeric: "092", Name: "Virgin Islands (British)"},
+	{Alpha2: "VI", Alpha3: "VIR", Numeric: "850", Name: "Virgin Islands (U.S.)"},
+	{Alpha2: "VN", Alpha3: "VNM", Numeric: "704", Name: "Viet Nam"},
+	{Alpha2: "VU", Alpha3: "VUT", Numeric: "548", Name: "Vanuatu"},
+	{Alpha2: "WF", Alpha3: "WLF", Numeric: "876", Name: "Wallis and Futuna"},
+	{Alpha2: "WS", Alpha3: "WSM", Numeric: "882", Name: "Samoa"},
+	{Alpha2: "YE", Alpha3: "YEM", Numeric: "887", Name: "Yemen"},
+	{Alpha2: "YT", Alpha3: "MYT", Numeric: "175", Name: "Mayotte"},
+	{Alpha2: "ZA", Alpha3: "ZAF", Numeric: "710", Name: "South Africa"},
+	{Alpha2: "ZM", Alpha3: "ZMB", Numeric: "894", Name: "Zambia"},
+	{Alpha2: "ZW", Alpha3: "ZWE", Numeric: "716", Name: "Zimbabwe"},
+}
+
+// ISO 4217 currencies, active and withdrawn, sorted by code
+var iso4217Currencies = []ISOCurrency{
+	{Code: "AED", Numeric: "784", MinorUnits: 2, Name: "UAE Dirham"},
+	{Code: "AFN", Numeric: "971", MinorUnits: 2, Name: "Afghani"},
+	{Code: "ALL", Numeric: "008", MinorUnits: 2, Name: "Lek"},
+	{Code: "AMD", Numeric: "051", MinorUnits: 2, Name: "Armenian Dram"},
+	{Code: "ANG", Numeric: "532", MinorUnits: 2, Name: "Netherlands Antillean Guilder"},
+	{Code: "AOA", Numeric: "973", MinorUnits: 2, Name: "Kwanza"},
+	{Code: "ARS", Numeric: "032", MinorUnits: 2, Name: "Argentine Peso"},
+	{Code: "ATS", Numeric: "040", MinorUnits: 2, Name: "Schilling", Withdrawn: true},
+	{Code: "AUD", Numeric: "036", MinorUnits: 2, Name: "Australian Dollar"},
+	{Code: "AWG", Numeric: "533", MinorUnits: 2, Name: "Aruban Florin"},
+	{Code: "AZM", Numeric: "031", MinorUnits: 2, Name: "Azerbaijanian Manat", Withdrawn: true},
+	{Code: "AZN", Numeric: "944", MinorUnits: 2, Name: "Azerbaijan Manat"},
+	{Code: "BAM", Numeric: "977", MinorUnits: 2, Name: "Convertible Mark"},
+	{Code: "BBD", Numeric: "052", MinorUnits: 2, Name: "Barbados Dollar"},
+	{Code: "BDT", Numeric: "050", MinorUnits: 2, Name: "Taka"},
+	{Code: "BEF", Numeric: "056", MinorUnits: 0, Name: "Belgian Franc", Withdrawn: true},
+	{Code: "BGN", Numeric: "975", MinorUnits: 2, Name: "Bulgarian Lev"},
+	{Code: "BHD", Numeric: "048", MinorUnits: 3, Name: "Bahraini Dinar"},
+	{Code: "BIF", Numeric: "108", MinorUnits: 0, Name: "Burundi Franc"},
+	{Code: "BMD", Numeric: "060", MinorUnits: 2, Name: "Bermudian Dollar"},
+	{Code: "BND", Numeric: "096", MinorUnits: 2, Name: "Brunei Dollar"},
+	{Code: "BOB", Numeric: "068", MinorUnits: 2, Name: "Boliviano"},
+	{Code: "BOV", Numeric: "984", MinorUnits: 2, Name: "Mvdol"},
+	{Code: "BRL", Numeric: "986", MinorUnits: 2, Name: "Brazilian Real"},
+	{Code: "BSD", Numeric: "044", MinorUnits: 2, Name: "Bahamian Dollar"},
+	{Code: "BTN", Numeric: "064", MinorUnits: 2, Name: "Ngultrum"},
+	{Code: "BWP", Numeric: "072", MinorUnits: 2, Name: "Pula"},
+	{Code: "BYN", Numeric: "933", MinorUnits: 2, Name: "Belarusian Ruble"},
+	{Code: "BYR", Numeric: "974", MinorUnits: 0, Name: "Belarusian Ruble", Withdrawn: true},
+	{Code: "BZD", Numeric: "084", MinorUnits: 2, Name: "Belize Dollar"},
+	{Code: "CAD", Numeric: "124", MinorUnits: 2, Name: "Canadian Dollar"},
+	{Code: "CDF", Numeric: "976", MinorUnits: 2, Name: "Congolese Franc"},
+	{Code: "CHE", Numeric: "947", MinorUnits: 2, Name: "WIR Euro"},
+	{Code: "CHF", Numeric: "756", MinorUnits: 2, Name: "Swiss Franc"},
+	{Code: "CHW", Numeric: "948", MinorUnits: 2, Name: "WIR Franc"},
+	{Code: "CLF", Numeric: "990", MinorUnits: 4, Name: "Unidad de Fomento"},
+	{Code: "CLP", Numeric: "152", MinorUnits: 0, Name: "Chilean Peso"},
+	{Code: "CNY", Numeric: "156", MinorUnits: 2, Name: "Yuan Renminbi"},
+	{Code: "COP", Numeric: "170", MinorUnits: 2, Name: "Colombian Peso"},
+	{Code: "COU", Numeric: "970", MinorUnits: 2, Name: "Unidad de Valor Real"},
+	{Code: "CRC", Numeric: "188", MinorUnits: 2, Name: "Costa Rican Colon"},
+	{Code: "CSD", Numeric: "891", MinorUnits: 2, Name: "Serbian Dinar", Withdrawn: true},
+	{Code: "CUC", Numeric: "931", MinorUnits: 2, Name: "Peso Convertible"},
+	{Code: "CUP", Numeric: "192", MinorUnits: 2, Name: "Cuban Peso"},
+	{Code: "CVE", Numeric: "132", MinorUnits: 2, Name: "Cabo Verde Escudo"},
+	{Code: "CYP", Numeric: "196", MinorUnits: 2, Name: "Cyprus Pound", Withdrawn: true},
+	{Code: "CZK", Numeric: "203", MinorUnits: 2, Name: "Czech Koruna"},
+	{Code: "DEM", Numeric: "276", MinorUnits: 2, Name: "Deutsche Mark", Withdrawn: true},
+	{Code: "DJF", Numeric: "262", MinorUnits: 0, Name: "Djibouti Franc"},
+	{Code: "DKK", Numeric: "208", MinorUnits: 2, Name: "Danish Krone"},
+	{Code: "DOP", Numeric: "214", MinorUnits: 2, Name: "Dominican Peso"},
+	{Code: "DZD", Numeric: "012", MinorUnits: 2, Name: "Algerian Dinar"},
+	{Code: "EEK", Numeric: "233", MinorUnits: 2, Name: "Kroon", Withdrawn: true},
+	{Code: "EGP", Numeric: "818", MinorUnits: 2, Name: "Egyptian Pound"},
+	{Code: "ERN", Numeric: "232", MinorUnits: 2, Name: "Nakfa"},
+	{Code: "ESP", Numeric: "724", MinorUnits: 0, Name: "Spanish Peseta", Withdrawn: true},
+	{Code: "ETB", Numeric: "230", MinorUnits: 2, Name: "Ethiopian Birr"},
+	{Code: "EUR", Numeric: "978", MinorUnits: 2, Name: "Euro"},
+	{Code: "FIM", Numeric: "246", MinorUnits: 2, Name: "Markka", Withdrawn: true},
+	{Code: "FJD", Numeric: "242", MinorUnits: 2, Name: "Fiji Dollar"},
+	{Code: "FKP", Numeric: "238", MinorUnits: 2, Name: "Falkland Islands Pound"},
+	{Code: "FRF", Numeric: "250", MinorUnits: 2, Name: "French Franc", Withdrawn: true},
+	{Code: "GBP", Numeric: "826", MinorUnits: 2, Name: "Pound Sterling"},
+	{Code: "GEL", Numeric: "981", MinorUnits: 2, Name: "Lari"},
+	{Code: "GHC", Numeric: "288", MinorUnits: 2, Name: "Cedi", Withdrawn: true},
+	{Code: "GHS", Numeric: "936", MinorUnits: 2, Name: "Ghana Cedi"},
+	{Code: "GIP", Numeric: "292", MinorUnits: 2, Name: "Gibraltar Pound"},
+	{Code: "GMD", Numeric: "270", MinorUnits: 2, Name: "Dalasi"},
+	{Code: "GNF", Numeric: "324", MinorUnits: 0, Name: "Guinea Franc"},
+	{Code: "GRD", Numeric: "300", MinorUnits: 0, Name: "Drachma", Withdrawn: true},
+	{Code: "GTQ", Numeric: "320", MinorUnits: 2, Name: "Quetzal"},
+	{Code: "GYD", Numeric: "328", MinorUnits: 2, Name: "Guyana Dollar"},
+	{Code: "HKD", Numeric: "344", MinorUnits: 2, Name: "Hong Kong Dollar"},
+	{Code: "HNL", Numeric: "340", MinorUnits: 2, Name: "Lempira"},
+	{Code: "HRK", Numeric: "191", MinorUnits: 2, Name: "Kuna", Withdrawn: true},
+	{Code: "HTG", Numeric: "332", MinorUnits: 2, Name: "Gourde"},
+	{Code: "HUF", Numeric: "348", MinorUnits: 2, Name: "Forint"},
+	{Code: "IDR", Numeric: "360", MinorUnits: 2, Name: "Rupiah"},
+	{Code: "IEP", Numeric: "372", MinorUnits: 2, Name: "Irish Pound", Withdrawn: true},
+	{Code: "ILS", Numeric: "376", MinorUnits: 2, Name: "New Israeli Sheqel"},
+	{Code: "INR", Numeric: "356", MinorUnits: 2, Name: "Indian Rupee"},
+	{Code: "IQD", Numeric: "368", MinorUnits: 3, Name: "Iraqi Dinar"},
+	{Code: "IRR", Numeric: "364", MinorUnits: 2, Name: "Iranian Rial"},
+	{Code: "ISK", Numeric: "352", MinorUnits: 0, Name: "Iceland Krona"},
+	{Code: "ITL", Numeric: "380", MinorUnits: 0, Name: "Italian Lira", Withdrawn: true},
+	{Code: "JMD", Numeric: "388", MinorUnits: 2, Name: "Jamaican Dollar"},
+	{Code: "JOD", Numeric: "400", MinorUnits: 3, Name: "Jordanian Dinar"},
+	{Code: "JPY", Numeric: "392", MinorUnits: 0, Name: "Yen"},
+	{Code: "KES", Numeric: "404", MinorUnits: 2, Name: "Kenyan Shilling"},
+	{Code: "KGS", Numeric: "417", MinorUnits: 2, Name: "Som"},
+	{Code: "KHR", Numeric: "116", MinorUnits: 2, Name: "Riel"},
+	{Code: "KMF", Numeric: "174", MinorUnits: 0, Name: "Comoro Franc"},
+	{Code: "KPW", Numeric: "408", MinorUnits: 2, Name: "North Korean Won"},
+	{Code: "KRW", Numeric: "410", MinorUnits: 0, Name: "Won"},
+	{Code: "KWD", Numeric: "414", MinorUnits: 3, Name: "Kuwaiti Dinar"},
+	{Code: "KYD", Numeric: "136", MinorUnits: 2, Name: "Cayman Islands Dollar"},
+	{Code: "KZT", Numeric: "398", MinorUnits: 2, Name: "Tenge"},
+	{Code: "LAK", Numeric: "418", MinorUnits: 2, Name: "Kip"},
+	{Code: "LBP", Numeric: "422", MinorUnits: 2, Name: "Lebanese Pound"},
+	{Code: "LKR", Numeric: "144", MinorUnits: 2, Name: "Sri Lanka Rupee"},
+	{Code: "LRD", Numeric: "430", MinorUnits: 2, Name: "Liberian Dollar"},
+	{Code: "LSL", Numeric: "426", MinorUnits: 2, Name: "Loti"},
+	{Code: "LTL", Numeric: "440", MinorUnits: 2, Name: "Lithuanian Litas", Withdrawn: true},
+	{Code: "LUF", Numeric: "442", MinorUnits: 0, Name: "Luxembourg Franc", Withdrawn: true},
+	{Code: "LVL", Numeric: "428", MinorUnits: 2, Name: "Latvian Lats", Withdrawn: true},
+	{Code: "LYD", Numeric: "434", MinorUnits: 3, Name: "Libyan Dinar"},
+	{Code: "MAD", Numeric: "504", MinorUnits: 2, Name: "Moroccan Dirham"},
+	{Code: "MDL", Numeric: "498", MinorUnits: 2, Name: "Moldovan Leu"},
+	{Code: "MGA", Numeric: "969", MinorUnits: 2, Name: "Malagasy Ariary"},
+	{Code: "MKD", Numeric: "807", MinorUnits: 2, Name: "Denar"},
+	{Code: "MMK", Numeric: "104", MinorUnits: 2, Name: "Kyat"},
+	{Code: "MNT", Numeric: "496", MinorUnits: 2, Name: "Tugrik"},
+	{Code: "MOP", Numeric: "446", MinorUnits: 2, Name: "Pataca"},
+	{Code: "MRO", Numeric: "478", MinorUnits: 2, Name: "Ouguiya", Withdrawn: true},
+	{Code: "MRU", Numeric: "929", MinorUnits: 2, Name: "Ouguiya"},
+	{Code: "MTL", Numeric: "470", MinorUnits: 2, Name: "Maltese Lira", Withdrawn: true},
+	{Code: "MUR", Numeric: "480", MinorUnits: 2, Name: "Mauritius Rupee"},
+	{Code: "MVR", Numeric: "462", MinorUnits: 2, Name: "Rufiyaa"},
+	{Code: "MWK", Numeric: "454", MinorUnits: 2, Name: "Kwacha"},
+	{Code: "MXN", Numeric: "484", MinorUnits: 2, Name: "Mexican Peso"},
+	{Code: "MXV", Numeric: "979", MinorUnits: 2, Name: "Mexican Unidad de Inversion (UDI)"},
+	{Code: "MYR", Numeric: "458", MinorUnits: 2, Name: "Malaysian Ringgit"},
+	{Code: "MZM", Numeric: "508", MinorUnits: 2, Name: "Mozambique Metical", Withdrawn: true},
+	{Code: "MZN", Numeric: "943", MinorUnits: 2, Name: "Mozambique Metical"},
+	{Code: "NAD", Numeric: "516", MinorUnits: 2, Name: "Namibia Dollar"},
+	{Code: "NGN", Numeric: "566", MinorUnits: 2, Name: "Naira"},
+	{Code: "NIO", Numeric: "558", MinorUnits: 2, Name: "Cordoba Oro"},
+	{Code: "NLG", Numeric: "528", MinorUnits: 2, Name: "Netherlands Guilder", Withdrawn: true},
+	{Code: "NOK", Numeric: "578", MinorUnits: 2, Name: "Norwegian Krone"},
+	{Code: "NPR", Numeric: "524", MinorUnits: 2, Name: "Nepalese Rupee"},
+	{Code: "NZD", Numeric: "554", MinorUnits: 2, Name: "New Zealand Dollar"},
+	{Code: "OMR", Numeric: "512", MinorUnits: 3, Name: "Rial Omani"},
+	{Code: "PAB", Numeric: "590", MinorUnits: 2, Name: "Balboa"},
+	{Code: "PEN", Numeric: "604", MinorUnits: 2, Name: "Nuevo Sol"},
+	{Code: "PGK", Numeric: "598", MinorUnits: 2, Name: "Kina"},
+	{Code: "PHP", Numeric: "608", MinorUnits: 2, Name: "Philippine Peso"},
+	{Code: "PKR", Numeric: "586", MinorUnits: 2, Name: "Pakistan Rupee"},
+	{Code: "PLN", Numeric: "985", MinorUnits: 2, Name: "Zloty"},
+	{Code: "PTE", Numeric: "620", MinorUnits: 0, Name: "Portuguese Escudo", Withdrawn: true},
+	{Code: "PYG", Numeric: "600", MinorUnits: 0, Name: "Guarani"},
+	{Code: "QAR", Numeric: "634", MinorUnits: 2, Name: "Qatari Rial"},
+	{Code: "ROL", Numeric: "642", MinorUnits: 2, Name: "Leu", Withdrawn: true},
+	{Code: "RON", Numeric: "946", MinorUnits: 2, Name: "Romanian Leu"},
+	{Code: "RSD", Numeric: "941", MinorUnits: 2, Name: "Serbian Dinar"},
+	{Code: "RUB", Numeric: "643", MinorUnits: 2, Name: "Russian Ruble"},
+	{Code: "RWF", Numeric: "646", MinorUnits: 0, Name: "Rwanda Franc"},
+	{Code: "SAR", Numeric: "682", MinorUnits: 2, Name: "Saudi Riyal"},
+	{Code: "SBD", Numeric: "090", MinorUnits: 2, Name: "Solomon Islands Dollar"},
+	{Code: "SCR", Numeric: "690", MinorUnits: 2, Name: "Seychelles Rupee"},
+	{Code: "SDD", Numeric: "736", MinorUnits: 2, Name: "Sudanese Dinar", Withdrawn: true},
+	{Code: "SDG", Numeric: "938", MinorUnits: 2, Name: "Sudanese Pound"},
+	{Code: "SEK", Numeric: "752", MinorUnits: 2, Name: "Swedish Krona"},
+	{Code: "SGD", Numeric: "702", MinorUnits: 2, Name: "Singapore Dollar"},
+	{Code: "SHP", Numeric: "654", MinorUnits: 2, Name: "Saint Helena Pound"},
+	{Code: "SIT", Numeric: "705", MinorUnits: 2, Name: "Tolar", Withdrawn: true},
+	{Code: "SKK", Numeric: "703", MinorUnits: 2, Name: "Slovak Koruna", Withdrawn: true},
+	{Code: "SLE", Numeric: "925", MinorUnits: 2, Name: "Leone"},
+	{Code: "SLL", Numeric: "694", MinorUnits: 2, Name: "Leone"},
+	{Code: "SOS", Numeric: "706", MinorUnits: 2, Name: "Somali Shilling"},
+	{Code: "SRD", Numeric: "968", MinorUnits: 2, Name: "Surinam Dollar"},
+	{Code: "SRG", Numeric: "740", MinorUnits: 2, Name: "Surinam Guilder", Withdrawn: true},
+	{Code: "SSP", Numeric: "728", MinorUnits: 2, Name: "South Sudanese Pound"},
+	{Code: "STD", Numeric: "678", MinorUnits: 2, Name: "Dobra", Withdrawn: true},
+	{Code: "STN", Numeric: "930", MinorUnits: 2, Name: "Dobra"},
+	{Code: "SVC", Numeric: "222", MinorUnits: 2, Name: "El Salvador Colon"},
+	{Code: "SYP", Numeric: "760", MinorUnits: 2, Name: "Syrian Pound"},
+	{Code: "SZL", Numeric: "748", MinorUnits: 2, Name: "Lilangeni"},
+	{Code: "THB", Numeric: "764", MinorUnits: 2, Name: "Baht"},
+	{Code: "TJS", Numeric: "972", MinorUnits: 2, Name: "Somoni"},
+	{Code: "TMM", Numeric: "795", MinorUnits: 2, Name: "Turkmenistan Manat", Withdrawn: true},
+	{Code: "TMT", Numeric: "934", MinorUnits: 2, Name: "Turkmenistan New Manat"},
+	{Code: "TND", Numeric: "788", MinorUnits: 3, Name: "Tunisian Dinar"},
+	{Code: "TOP", Numeric: "776", MinorUnits: 2, Name: "Pa’anga"},
+	{Code: "TRL", Numeric: "792", MinorUnits: 0, Name: "Turkish Lira", Withdrawn: true},
+	{Code: "TRY", Numeric: "949", MinorUnits: 2, Name: "Turkish Lira"},
+	{Code: "TTD", Numeric: "780", MinorUnits: 2, Name: "Trinidad and Tobago Dollar"},
+	{Code: "TWD", Numeric: "901", MinorUnits: 2, Name: "New Taiwan Dollar"},
+	{Code: "TZS", Numeric: "834", MinorUnits: 2, Name: "Tanzanian Shilling"},
+	{Code: "UAH", Numeric: "980", MinorUnits: 2, Name: "Hryvnia"},
+	{Code: "UGX", Numeric: "800", MinorUnits: 0, Name: "Uganda Shilling"},
+	{Code: "USD", Numeric: "840", MinorUnits: 2, Name: "US Dollar"},
+	{Code: "USN", Numeric: "997", MinorUnits: 2, Name: "US Dollar Next day"},
+	{Code: "UYI", Numeric: "940", MinorUnits: 0, Name: "Uruguay Peso en Unidades Indexadas (URUIURUI)"},
+	{Code: "UYU", Numeric: "858", MinorUnits: 2, Name: "Peso Uruguayo"},
+	{Code: "UYW", Numeric: "927", MinorUnits: 4, Name: "Unidad Previsional"},
+	{Code: "UZS", Numeric: "860", MinorUnits: 2, Name: "Uzbekistan Sum"},
+	{Code: "VEB", Numeric: "862", MinorUnits: 2, Name: "Bolivar", Withdrawn: true},
+	{Code: "VED", Numeric: "926", MinorUnits: 2, Name: "Bolívar Soberano"},
+	{Code: "VEF", Numeric: "937", MinorUnits: 2, Name: "Bolívar", Withdrawn: true},
+	{Code: "VES", Numeric: "928", MinorUnits: 2, Name: "Bolívar Soberano"},
+	{Code: "VND", Numeric: "704", MinorUnits: 0, Name: "Dong"},
+	{Code: "VUV", Numeric: "548", MinorUnits: 0, Name: "Vatu"},
+	{Code: "WST", Numeric: "882", MinorUnits: 2, Name: "Tala"},
+	{Code: "XAF", Numeric: "950", MinorUnits: 0, Name: "CFA Franc BEAC"},
+	{Code: "XAG", Numeric: "961", MinorUnits: -1, Name: "Silver"},
+	{Code: "XAU", Numeric: "959", MinorUnits: -1, Name: "Gold"},
+	{Code: "XBA", Numeric: "955", MinorUnits: -1, Name: "Bond Markets Unit European Composite Unit (EURCO)"},
+	{Code: "XBB", Numeric: "956", MinorUnits: -1, Name: "Bond Markets Unit European Monetary Unit (E.M.U.-6)"},
+	{Code: "XBC", Numeric: "957", MinorUnits: -1, Name: "Bond Markets Unit European Unit of Account 9 (E.U.A.-9)"},
+	{Code: "XBD", Numeric: "958", MinorUnits: -1, Name: "Bond Markets Unit European Unit of Account 17 (E.U.A.-17)"},
+	{Code: "XCD", Numeric: "951", MinorUnits: 2, Name: "East Caribbean Dollar"},
+	{Code: "XDR", Numeric: "960", MinorUnits: -1, Name: "SDR (Special Drawing Right)"},
+	{Code: "XOF", Numeric: "952", MinorUnits: 0, Name: "CFA Franc BCEAO"},
+	{Code: "XPD", Numeric: "964", MinorUnits: -1, Name: "Palladium"},
+	{Code: "XPF", Numeric: "953", MinorUnits: 0, Name: "CFP Franc"},
+	{Code: "XPT", Numeric: "962", MinorUnits: -1, Name: "Platinum"},
+	{Code: "XSU", Numeric: "994", MinorUnits: -1, Name: "Sucre"},
+	{Code: "XTS", Numeric: "963", MinorUnits: -1, Name: "Codes specifically reserved for testing purposes"},
+	{Code: "XUA", Numeric: "965", MinorUnits: -1, Name: "ADB Unit of Account"},
+	{Code: "XXX", Numeric: "999", MinorUnits: -1, Name: "The codes assigned for transactions where no currency is involved"},
+	{Code: "YER", Numeric: "886", MinorUnits: 2, Name: "Yemeni Rial"},
+	{Code: "YUD", Numeric: "891", MinorUnits: 2, Name: "New Yugoslavian Dinar", Withdrawn: true},
+	{Code: "ZAR", Numeric: "710", MinorUnits: 2, Name: "Rand"},
+	{Code: "ZMK", Numeric: "894", MinorUnits: 2, Name: "Zambian Kwacha", Withdrawn: true},
+	{Code: "ZMW", Numeric: "967", MinorUnits: 2, Name: "Zambian Kwacha"},
+	{Code: "ZWD", Numeric: "716", MinorUnits: 2, Name: "Zimbabwe Dollar", Withdrawn: true},
+	{Code: "ZWG", Numeric: "924", MinorUnits: 2, Name: "Zimbabwe Gold"},
+	{Code: "ZWL", Numeric: "932", MinorUnits: 2, Name: "Zimbabwe Dollar", Withdrawn: true},
+}
@@ -0,0 +1,176 @@
+package accountapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/alexdreptu/form3-accountapi-client"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestLookupISOCountry(t *testing.T) {
+	for _, code := range []string{"GB", "gb", "GBR", "826"} {
+		country, err := LookupISOCountry(code)
+		require.NoError(t, err, code)
+		assert.Equal(t, ISOCountry{
+			Alpha2:  "GB",
+			Alpha3:  "GBR",
+			Numeric: "826",
+			Name:    "United Kingdom of Great Britain and Northern Ireland",
+		}, country)
+	}
+
+	_, err := LookupISOCountry("XX")
+	require.Error(t, err)
+	var e *InvalidCountryError
+	assert.True(t, errors.As(err, &e))
+}
+
+func TestCountryCodeConversions(t *testing.T) {
+	alpha2, err := CountryAlpha2("AUT")
+	require.NoError(t, err)
+	assert.Equal(t, "AT", alpha2)
+
+	alpha3, err := CountryAlpha3("040")
+	require.NoError(t, err)
+	assert.Equal(t, "AUT", alpha3)
+
+	numeric, err := CountryNumeric("AU")
+	require.NoError(t, err)
+	assert.Equal(t, "036", numeric)
+
+	_, err = CountryAlpha3("ZZZ")
+	assert.Error(t, err)
+}
+
+func TestISOCountries(t *testing.T) {
+	countries := ISOCountries()
+	assert.Len(t, countries, 249)
+
+	seen := map[string]bool{}
+	for _, c := range countries {
+		assert.Len(t, c.Alpha2, 2)
+		assert.Len(t, c.Alpha3, 3)
+		assert.Len(t, c.Numeric, 3)
+		assert.NotEmpty(t, c.Name)
+		assert.False(t, seen[c.Alpha2], c.Alpha2)
+		seen[c.Alpha2] = true
+	}
+
+	// the returned slice is a copy
+	countries[0].Name = ""
+	assert.NotEmpty(t, ISOCountries()[0].Name)
+}
+
+func TestLookupISOCurrency(t *testing.T) {
+	currency, err := LookupISOCurrency("gbp")
+	require.NoError(t, err)
+	assert.Equal(t, ISOCurrency{Code: "GBP", Numeric: "826", MinorUnits: 2, Name: "Pound Sterling"}, currency)
+
+	currency, err = LookupISOCurrency("JPY")
+	require.NoError(t, err)
+	assert.Equal(t, 0, currency.MinorUnits)
+
+	currency, err = LookupISOCurrency("KWD")
+	require.NoError(t, err)
+	assert.Equal(t, 3, currency.MinorUnits)
+
+	currency, err = LookupISOCurrency("XAU")
+	require.NoError(t, err)
+	assert.Equal(t, -1, currency.MinorUnits)
+
+	currency, err = LookupISOCurrency("DEM")
+	require.NoError(t, err)
+	assert.True(t, currency.Withdrawn)
+
+	_, err = LookupISOCurrency("ABC")
+	require.Error(t, err)
+	var e *UnknownCurrencyError
+	assert.True(t, errors.As(err, &e))
+}
+
+func TestCurrencyCodeConversions(t *testing.T) {
+	numeric, err := CurrencyNumeric("EUR")
+	require.NoError(t, err)
+	assert.Equal(t, "978", numeric)
+
+	code, err := CurrencyCode("752")
+	require.NoError(t, err)
+	assert.Equal(t, CurrencySweden, code)
+
+	_, err = CurrencyCode("000")
+	assert.Error(t, err)
+}
+
+// TestISOSupportedCountries checks every supported country and its currency are in the ISO tables
+func TestISOSupportedCountries(t *testing.T) {
+	for _, code := range SupportedCountries() {
+		_, err := LookupISOCountry(code)
+		require.NoError(t, err, code)
+
+		info, err := CountryInfo(code)
+		require.NoError(t, err)
+		currency, err := LookupISOCurrency(info.Currency)
+		require.NoError(t, err, info.Currency)
+		assert.False(t, currency.Withdrawn, info.Currency)
+	}
+}
+
+func newISOTestAccount(country, currency string) error {
+	_, err := NewAccount(&Options{
+		Type:           accountType,
+		ID:             uuid.New().String(),
+		OrganisationID: uuid.New().String(),
+		Attributes: []Attribute{
+			WithAttrCountry(country),
+			WithAttrBIC(randomBIC()),
+			WithAttrBankID(randomBankIDUnitedKingdom()),
+			WithAttrBankIDCode(BankIDCodeUnitedKingdom),
+			WithAttrBaseCurrency(currency),
+		},
+	})
+	return err
+}
+
+func TestNewAccount_ISOCountry(t *testing.T) {
+	err := newISOTestAccount("JP", "")
+	require.Error(t, err)
+	var unsupported *UnsupportedCountryError
+	require.True(t, errors.As(err, &unsupported))
+	assert.Equal(t, "Japan", unsupported.Name)
+	assert.Equal(t, "le pays 'JP' (Japan) n'est pas pris en charge", LocalizeError(err, language.French))
+
+	err = newISOTestAccount("XX", "")
+	require.Error(t, err)
+	var invalid *InvalidCountryError
+	assert.True(t, errors.As(err, &invalid))
+	assert.False(t, errors.As(err, &unsupported))
+
+	_, err = CountryInfo("JP")
+	assert.True(t, errors.As(err, &unsupported))
+
+	_, err = GenerateAccountNumber("XX", "")
+	assert.True(t, errors.As(err, &invalid))
+}
+
+func TestNewAccount_ISOCurrency(t *testing.T) {
+	require.NoError(t, newISOTestAccount(CountryUnitedKingdom, CurrencyUnitedKingdom))
+
+	err := newISOTestAccount(CountryUnitedKingdom, "ABC")
+	require.Error(t, err)
+	var errs validation.Errors
+	require.True(t, errors.As(err, &errs))
+	var unknown *UnknownCurrencyError
+	assert.True(t, errors.As(errs["base_currency"], &unknown))
+
+	err = newISOTestAccount(CountryUnitedKingdom, "DEM")
+	require.Error(t, err)
+	require.True(t, errors.As(err, &errs))
+	var withdrawn *WithdrawnCurrencyError
+	require.True(t, errors.As(errs["base_currency"], &withdrawn))
+	assert.Equal(t, "Deutsche Mark", withdrawn.Name)
+}
@@ -0,0 +1,144 @@
+package accountapi
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// characters allowed in names besides letters and combining marks
+const nameSpecialCharacters = " -'’."
+
+// characters allowed by the restricted SWIFT character set besides letters and digits
+const swiftSpecialCharacters = "/-?:().,'+ "
+
+// replaces the characters that cannot be represented in the SWIFT character set
+const swiftReplacementCharacter = "?"
+
+// letters that don't decompose into a latin letter and a combining mark
+var swiftLetters = map[rune]string{
+	'ß': "ss", 'ẞ': "SS",
+	'æ': "ae", 'Æ': "AE",
+	'œ': "oe", 'Œ': "OE",
+	'ø': "o", 'Ø': "O",
+	'ł': "l", 'Ł': "L",
+	'đ': "d", 'Đ': "D",
+	'ð': "d", 'Ð': "D",
+	'þ': "th", 'Þ': "TH",
+	'ı': "i",
+	'’': "'",
+}
+
+// validateNameCharacters allows letters in any script, spaces, hyphens, apostrophes and dots
+// in every name of the account holder.
+var validateNameCharacters = validation.By(
+	func(value interface{}) error {
+		name, _ := value.(string)
+		for _, r := range name {
+			if !unicode.IsLetter(r) && !unicode.Is(unicode.M, r) &&
+				!strings.ContainsRune(nameSpecialCharacters, r) {
+				return &InvalidNameCharacterError{string(r)}
+			}
+		}
+		return nil
+	},
+)
+
+// nameLength returns the number of characters in a name
+func nameLength(name string) int {
+	return utf8.RuneCountInString(name)
+}
+
+// normalizeNames converts the names of the account holder to Unicode Normalization Form C.
+func (a *Attributes) normalizeNames() {
+	a.mapNames(norm.NFC.String)
+}
+
+// transliterateNames converts the names of the account holder to the SWIFT character set.
+// It returns an UntransliterableCharacterError, by field, for the names that have a
+// character without transliteration and leaves the names unchanged.
+func (a *Attributes) transliterateNames() error {
+	errs := validation.Errors{}
+	check := func(field string, names ...string) {
+		for _, name := range names {
+			if _, missing := transliterateSWIFT(name); len(missing) > 0 {
+				errs[field] = &UntransliterableCharacterError{string(missing[0])}
+				return
+			}
+		}
+	}
+	check("first_name", a.FirstName)
+	check("bank_account_name", a.BankAccountName)
+	check("name", a.Name...)
+	check("alternative_names", a.AlternativeNames...)
+	check("alternative_bank_account_names", a.AlternativeBankAccountNames...)
+	if len(errs) > 0 {
+		return errs
+	}
+
+	a.mapNames(TransliterateSWIFT)
+	return nil
+}
+
+// mapNames applies f to every name of the account holder. The name slices are
+// copied, so the slices passed to the WithAttr functions are left untouched.
+func (a *Attributes) mapNames(f func(string) string) {
+	mapSlice := func(names []string) []string {
+		if names == nil {
+			return nil
+		}
+		mapped := make([]string, len(names))
+		for i := range names {
+			mapped[i] = f(names[i])
+		}
+		return mapped
+	}
+
+	a.FirstName = f(a.FirstName)
+	a.BankAccountName = f(a.BankAccountName)
+	a.Name = mapSlice(a.Name)
+	a.AlternativeNames = mapSlice(a.AlternativeNames)
+	a.AlternativeBankAccountNames = mapSlice(a.AlternativeBankAccountNames)
+}
+
+// TransliterateSWIFT converts s to the restricted SWIFT character set,
+// i.e. latin letters, digits and the characters / - ? : ( ) . , ' + and space.
+// Accents are removed, letters such as 'ß' or 'ł' are spelled in latin letters
+// and characters that cannot be represented are replaced by '?'. NewAccount
+// with TransliterateNames rejects such names instead.
+func TransliterateSWIFT(s string) string {
+	transliterated, _ := transliterateSWIFT(s)
+	return transliterated
+}
+
+// transliterateSWIFT returns s in the SWIFT character set and the characters that
+// cannot be represented, which are replaced by '?'
+func transliterateSWIFT(s string) (string, []rune) {
+	t := transform.Chain(norm.NFKD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	stripped, _, err := transform.String(t, s)
+	if err != nil {
+		stripped = s
+	}
+
+	var b strings.Builder
+	var missing []rune
+	for _, r := range stripped {
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)),
+			strings.ContainsRune(swiftSpecialCharacters, r):
+			b.WriteRune(r)
+		case swiftLetters[r] != "":
+			b.WriteString(swiftLetters[r])
+		default:
+			b.WriteString(swiftReplacementCharacter)
+			missing = append(missing, r)
+		}
+	}
+
+	return b.String(), missing
+}
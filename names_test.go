@@ -0,0 +1,147 @@
+package accountapi_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/alexdreptu/form3-accountapi-client"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newNamesTestOptions(firstName string, alternativeNames ...string) *Options {
+	return &Options{
+		Type:           accountType,
+		ID:             uuid.New().String(),
+		OrganisationID: uuid.New().String(),
+		Attributes: []Attribute{
+			WithAttrCountry(CountryUnitedKingdom),
+			WithAttrBIC(randomBIC()),
+			WithAttrBankID(randomBankIDUnitedKingdom()),
+			WithAttrBankIDCode(BankIDCodeUnitedKingdom),
+			WithAttrFirstName(firstName),
+			WithAttrAlternativeBankAccountNames(alternativeNames...),
+		},
+	}
+}
+
+func TestNewAccount_NameCharacters(t *testing.T) {
+	testCases := []struct {
+		name             string
+		shouldError      bool
+		firstName        string
+		alternativeNames []string
+		attributes       []Attribute
+	}{
+		{name: "apostrophe", firstName: "O'Brien"},
+		{name: "typographic apostrophe", firstName: "O’Brien"},
+		{name: "hyphen", firstName: "Anne-Marie"},
+		{name: "accents", firstName: "José"},
+		{name: "diaeresis", firstName: "Zoë"},
+		{name: "dot and space", firstName: "J. R. R."},
+		{name: "cyrillic", firstName: "Дмитрий"},
+		{name: "alternative bank account names", firstName: "Zoë", alternativeNames: []string{"Zoë O'Brien", "Anne-Marie Łukasz"}},
+		{name: "140 multibyte characters", firstName: strings.Repeat("é", 140)},
+		{name: "invalid 141 multibyte characters", shouldError: true, firstName: strings.Repeat("é", 141)},
+		{name: "invalid digits", shouldError: true, firstName: "Samantha2"},
+		{name: "invalid symbols", shouldError: true, firstName: "Sam@ntha"},
+		{name: "invalid alternative bank account names symbols", shouldError: true, firstName: "Zoë", alternativeNames: []string{"Zoë & Co"}},
+		{name: "name", attributes: []Attribute{WithAttrName("Zoë", "O’Brien")}},
+		{name: "invalid name symbols", shouldError: true, attributes: []Attribute{WithAttrName("Zoë", "O'Brien & Co")}},
+		{name: "bank account name", attributes: []Attribute{WithAttrBankAccountName("Anne-Marie Łukasz")}},
+		{name: "invalid bank account name digits", shouldError: true, attributes: []Attribute{WithAttrBankAccountName("Anne-Marie 2")}},
+		{name: "alternative names", attributes: []Attribute{WithAttrAlternativeNames("Дмитрий", "J. R. R.")}},
+		{name: "invalid alternative names", shouldError: true, attributes: []Attribute{WithAttrAlternativeNames("Дмитрий", "J@R")}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			options := newNamesTestOptions(tc.firstName, tc.alternativeNames...)
+			options.Attributes = append(options.Attributes, tc.attributes...)
+			_, err := NewAccount(options)
+			if tc.shouldError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewAccount_NameCharacterError(t *testing.T) {
+	_, err := NewAccount(newNamesTestOptions("Sam@ntha"))
+	require.Error(t, err)
+
+	var errs validation.Errors
+	require.True(t, errors.As(err, &errs))
+	var e *InvalidNameCharacterError
+	require.True(t, errors.As(errs["first_name"], &e))
+	assert.Equal(t, "@", e.Character)
+}
+
+func TestNewAccount_NamesNFC(t *testing.T) {
+	// 'Zoe' followed by a combining diaeresis
+	decomposed := "Zoe\u0308"
+	alternativeNames := []string{decomposed + " Holder"}
+
+	account, err := NewAccount(newNamesTestOptions(decomposed, alternativeNames...))
+	require.NoError(t, err)
+	assert.Equal(t, "Zoë", account.Data.Attributes.FirstName)
+	assert.Equal(t, []string{"Zoë Holder"}, account.Data.Attributes.AlternativeBankAccountNames)
+
+	// the slice passed to the WithAttr function is not modified
+	assert.Equal(t, decomposed+" Holder", alternativeNames[0])
+}
+
+func TestNewAccount_TransliterateNames(t *testing.T) {
+	options := newNamesTestOptions("Zoë", "Łukasz Müller-Groß")
+	options.TransliterateNames = true
+
+	account, err := NewAccount(options)
+	require.NoError(t, err)
+	assert.Equal(t, "Zoe", account.Data.Attributes.FirstName)
+	assert.Equal(t, []string{"Lukasz Muller-Gross"}, account.Data.Attributes.AlternativeBankAccountNames)
+}
+
+func TestNewAccount_TransliterateNames_CJK(t *testing.T) {
+	options := newNamesTestOptions("Zoë", "山田 太郎")
+	options.TransliterateNames = true
+
+	_, err := NewAccount(options)
+	require.Error(t, err)
+	var errs validation.Errors
+	require.True(t, errors.As(err, &errs))
+	assert.NotContains(t, errs, "first_name")
+	var e *UntransliterableCharacterError
+	require.True(t, errors.As(errs["alternative_bank_account_names"], &e))
+	assert.Equal(t, "山", e.Character)
+	assert.Equal(t, "cannot transliterate '山' to the SWIFT character set", e.Error())
+
+	// the name itself is valid
+	options.TransliterateNames = false
+	_, err = NewAccount(options)
+	assert.NoError(t, err)
+}
+
+func TestTransliterateSWIFT(t *testing.T) {
+	testCases := []struct {
+		value string
+		equal string
+	}{
+		{value: "Samantha Holder", equal: "Samantha Holder"},
+		{value: "José Núñez", equal: "Jose Nunez"},
+		{value: "Søren Ærø", equal: "Soren AEro"},
+		{value: "O’Brien", equal: "O'Brien"},
+		{value: "Acme (UK) Ltd.", equal: "Acme (UK) Ltd."},
+		{value: "Smith & Sons", equal: "Smith ? Sons"},
+		{value: "Иван", equal: "????"},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.equal, TransliterateSWIFT(tc.value))
+	}
+}
@@ -0,0 +1,144 @@
+package accountapi
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// characters stripped from bank IDs and account numbers, e.g. '40-03-00' or '4142 6815'
+const numberSeparators = " -./"
+
+// NormalizationChange describes a change made to an attribute by Normalize.
+type NormalizationChange struct {
+	// JSON name of the attribute, e.g. 'bank_id' or 'name[1]'.
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// Normalize returns a copy of the attributes with the common input mistakes corrected,
+// and the list of the changes it made:
+//
+//   - whitespace is trimmed from every attribute and collapsed in names
+//   - country, base currency, bank ID code and BIC are upper-cased
+//   - whitespace is removed from the BIC
+//   - spaces, hyphens, dots and slashes are removed from the bank ID and account number
+//   - the account number is zero padded for countries with fixed length account numbers
+//
+// The attributes are not validated, values that cannot be corrected are left as they are.
+func (a Attributes) Normalize() (Attributes, []NormalizationChange) {
+	changes := []NormalizationChange{}
+	set := func(field string, value *string, normalized string) {
+		if *value != normalized {
+			changes = append(changes, NormalizationChange{Field: field, From: *value, To: normalized})
+			*value = normalized
+		}
+	}
+	setSlice := func(field string, values []string, normalize func(string) string) []string {
+		if values == nil {
+			return nil
+		}
+		normalized := make([]string, len(values))
+		for i := range values {
+			normalized[i] = values[i]
+			set(field+"["+strconv.Itoa(i)+"]", &normalized[i], normalize(values[i]))
+		}
+		return normalized
+	}
+
+	set("country", &a.Country, normalizeCode(a.Country))
+	set("base_currency", &a.BaseCurrency, normalizeCode(a.BaseCurrency))
+	set("bank_id_code", &a.BankIDCode, normalizeCode(a.BankIDCode))
+	set("bic", &a.BIC, strings.ToUpper(removeCharacters(a.BIC, " \t\n")))
+	set("bank_id", &a.BankID, removeCharacters(strings.TrimSpace(a.BankID), numberSeparators))
+	set("account_number", &a.AccountNumber, normalizeAccountNumber(a.Country, a.AccountNumber))
+	set("customer_id", &a.CustomerID, strings.TrimSpace(a.CustomerID))
+	set("title", &a.Title, normalizeName(a.Title))
+	set("first_name", &a.FirstName, normalizeName(a.FirstName))
+	set("bank_account_name", &a.BankAccountName, normalizeName(a.BankAccountName))
+	set("account_classification", &a.AccountClassification, normalizeClassification(a.AccountClassification))
+	set("status", &a.Status, strings.ToLower(strings.TrimSpace(a.Status)))
+	set("secondary_identification", &a.SecondaryIdentification, strings.TrimSpace(a.SecondaryIdentification))
+	a.Name = setSlice("name", a.Name, normalizeName)
+	a.AlternativeNames = setSlice("alternative_names", a.AlternativeNames, normalizeName)
+	a.AlternativeBankAccountNames = setSlice("alternative_bank_account_names", a.AlternativeBankAccountNames, normalizeName)
+
+	if a.PrivateIdentification != nil {
+		id := *a.PrivateIdentification
+		set("private_identification.birth_country", &id.BirthCountry, normalizeCode(id.BirthCountry))
+		set("private_identification.country", &id.Country, normalizeCode(id.Country))
+		a.PrivateIdentification = &id
+	}
+
+	if a.OrganisationIdentification != nil {
+		id := *a.OrganisationIdentification
+		set("organisation_identification.country", &id.Country, normalizeCode(id.Country))
+		if id.Representative != nil {
+			representative := *id.Representative
+			set("organisation_identification.representative.residency",
+				&representative.Residency, normalizeCode(representative.Residency))
+			id.Representative = &representative
+		}
+		a.OrganisationIdentification = &id
+	}
+
+	return a, changes
+}
+
+// normalizeCode trims and upper-cases country, currency and bank ID codes
+func normalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// normalizeName trims a name and collapses the whitespace inside it
+func normalizeName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// normalizeClassification corrects the case of the account classification, e.g. 'business'
+func normalizeClassification(classification string) string {
+	classification = strings.TrimSpace(classification)
+	for _, c := range []string{AccountClassificationPersonal, AccountClassificationBusiness} {
+		if strings.EqualFold(classification, c) {
+			return c
+		}
+	}
+	return classification
+}
+
+// normalizeAccountNumber strips separators and zero pads the account number
+// if the country has fixed length account numbers
+func normalizeAccountNumber(country, number string) string {
+	number = removeCharacters(strings.TrimSpace(number), numberSeparators)
+	if number == "" || !isDigits(number) {
+		return number
+	}
+
+	// countries with a variable length account number are not padded
+	rules, ok := countries[normalizeCode(country)]
+	length := rules.accountNumberLengthTo
+	if ok && rules.accountNumberLengthFrom == length && len(number) < length {
+		number = strings.Repeat("0", length-len(number)) + number
+	}
+
+	return number
+}
+
+func removeCharacters(s, characters string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(characters, r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r > unicode.MaxASCII || !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,168 @@
+package accountapi_test
+
+import (
+	"testing"
+
+	. "github.com/alexdreptu/form3-accountapi-client"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttributesNormalize(t *testing.T) {
+	testCases := []struct {
+		name       string
+		attributes Attributes
+		equal      Attributes
+		changes    []NormalizationChange
+	}{
+		{
+			name:       "nothing to normalize",
+			attributes: Attributes{Country: CountryUnitedKingdom, BankID: "400300", AccountNumber: "41426819"},
+			equal:      Attributes{Country: CountryUnitedKingdom, BankID: "400300", AccountNumber: "41426819"},
+			changes:    []NormalizationChange{},
+		},
+		{
+			name:       "codes upper-cased",
+			attributes: Attributes{Country: " gb", BaseCurrency: "gbp ", BankIDCode: "gbdsc", BIC: "nwbk gb 22"},
+			equal:      Attributes{Country: "GB", BaseCurrency: "GBP", BankIDCode: "GBDSC", BIC: "NWBKGB22"},
+			changes: []NormalizationChange{
+				{Field: "country", From: " gb", To: "GB"},
+				{Field: "base_currency", From: "gbp ", To: "GBP"},
+				{Field: "bank_id_code", From: "gbdsc", To: "GBDSC"},
+				{Field: "bic", From: "nwbk gb 22", To: "NWBKGB22"},
+			},
+		},
+		{
+			name:       "separators removed",
+			attributes: Attributes{Country: CountryUnitedKingdom, BankID: "40-03-00", AccountNumber: "4142 6819"},
+			equal:      Attributes{Country: CountryUnitedKingdom, BankID: "400300", AccountNumber: "41426819"},
+			changes: []NormalizationChange{
+				{Field: "bank_id", From: "40-03-00", To: "400300"},
+				{Field: "account_number", From: "4142 6819", To: "41426819"},
+			},
+		},
+		{
+			name:       "account number zero padded",
+			attributes: Attributes{Country: "gb", AccountNumber: "1234567"},
+			equal:      Attributes{Country: CountryUnitedKingdom, AccountNumber: "01234567"},
+			changes: []NormalizationChange{
+				{Field: "country", From: "gb", To: "GB"},
+				{Field: "account_number", From: "1234567", To: "01234567"},
+			},
+		},
+		{
+			name:       "variable length account number not padded",
+			attributes: Attributes{Country: CountryAustralia, AccountNumber: "123-456"},
+			equal:      Attributes{Country: CountryAustralia, AccountNumber: "123456"},
+			changes: []NormalizationChange{
+				{Field: "account_number", From: "123-456", To: "123456"},
+			},
+		},
+		{
+			name:       "non numeric account number not padded",
+			attributes: Attributes{Country: CountryFrance, AccountNumber: "0500013M0"},
+			equal:      Attributes{Country: CountryFrance, AccountNumber: "0500013M0"},
+			changes:    []NormalizationChange{},
+		},
+		{
+			name: "names trimmed",
+			attributes: Attributes{
+				FirstName:                   " Samantha ",
+				Name:                        []string{"Samantha  Holder", "Flat 1"},
+				AlternativeBankAccountNames: []string{"Sam\tHolder"},
+				AccountClassification:       "business",
+				Status:                      "Confirmed",
+			},
+			equal: Attributes{
+				FirstName:                   "Samantha",
+				Name:                        []string{"Samantha Holder", "Flat 1"},
+				AlternativeBankAccountNames: []string{"Sam Holder"},
+				AccountClassification:       AccountClassificationBusiness,
+				Status:                      AccountStatusConfirmed,
+			},
+			changes: []NormalizationChange{
+				{Field: "first_name", From: " Samantha ", To: "Samantha"},
+				{Field: "account_classification", From: "business", To: AccountClassificationBusiness},
+				{Field: "status", From: "Confirmed", To: AccountStatusConfirmed},
+				{Field: "name[0]", From: "Samantha  Holder", To: "Samantha Holder"},
+				{Field: "alternative_bank_account_names[0]", From: "Sam\tHolder", To: "Sam Holder"},
+			},
+		},
+		{
+			name: "identification countries upper-cased",
+			attributes: Attributes{
+				PrivateIdentification:      &PrivateIdentification{BirthCountry: "gb", Country: "GB"},
+				OrganisationIdentification: &OrganisationIdentification{Representative: &Representative{Residency: "fr"}},
+			},
+			equal: Attributes{
+				PrivateIdentification:      &PrivateIdentification{BirthCountry: "GB", Country: "GB"},
+				OrganisationIdentification: &OrganisationIdentification{Representative: &Representative{Residency: "FR"}},
+			},
+			changes: []NormalizationChange{
+				{Field: "private_identification.birth_country", From: "gb", To: "GB"},
+				{Field: "organisation_identification.representative.residency", From: "fr", To: "FR"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			normalized, changes := tc.attributes.Normalize()
+			assert.Equal(t, tc.equal, normalized)
+			assert.Equal(t, tc.changes, changes)
+		})
+	}
+}
+
+func TestAttributesNormalize_Copy(t *testing.T) {
+	names := []string{" Samantha Holder "}
+	attributes := Attributes{
+		Name:                  names,
+		PrivateIdentification: &PrivateIdentification{Country: "gb"},
+	}
+
+	normalized, _ := attributes.Normalize()
+	assert.Equal(t, "Samantha Holder", normalized.Name[0])
+	assert.Equal(t, "GB", normalized.PrivateIdentification.Country)
+
+	// the original attributes are not modified
+	assert.Equal(t, " Samantha Holder ", names[0])
+	assert.Equal(t, "gb", attributes.PrivateIdentification.Country)
+}
+
+func TestNewAccount_Normalize(t *testing.T) {
+	newOptions := func() *Options {
+		return &Options{
+			Type:           accountType,
+			ID:             uuid.New().String(),
+			OrganisationID: uuid.New().String(),
+			Attributes: []Attribute{
+				WithAttrCountry("gb"),
+				WithAttrBIC("nwbk gb22"),
+				WithAttrBankID("40-03-00"),
+				WithAttrBankIDCode(BankIDCodeUnitedKingdom),
+				WithAttrAccountNumber("1234567"),
+			},
+		}
+	}
+
+	_, err := NewAccount(newOptions())
+	require.Error(t, err)
+
+	var changes []NormalizationChange
+	options := newOptions()
+	options.Normalize = true
+	options.OnNormalize = func(c []NormalizationChange) {
+		changes = c
+	}
+
+	account, err := NewAccount(options)
+	require.NoError(t, err)
+	assert.Equal(t, CountryUnitedKingdom, account.Data.Attributes.Country)
+	assert.Equal(t, "NWBKGB22", account.Data.Attributes.BIC)
+	assert.Equal(t, "400300", account.Data.Attributes.BankID)
+	assert.Equal(t, "01234567", account.Data.Attributes.AccountNumber)
+	assert.Len(t, changes, 4)
+}
@@ -0,0 +1,359 @@
+package accountapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Actions of a PlanChange
+const (
+	PlanCreate   = "create"
+	PlanUpdate   = "update"
+	PlanRecreate = "recreate"
+	PlanDelete   = "delete"
+)
+
+// reconcilePageSize is the page size Plan lists the accounts with
+const reconcilePageSize = 100
+
+// immutableFields are the JSON names of the members that can't be changed by an
+// update, a change to one of them recreates the account
+var immutableFields = map[string]bool{
+	"type":            true,
+	"organisation_id": true,
+	"country":         true,
+	"base_currency":   true,
+	"bank_id":         true,
+	"bank_id_code":    true,
+	"account_number":  true,
+	"bic":             true,
+}
+
+// ReconcileOptions configures Plan
+type ReconcileOptions struct {
+	// Prune deletes the accounts of the managed organisations that are not desired.
+	Prune bool
+
+	// OrganisationIDs are the organisations whose accounts are managed, they default
+	// to the organisations of the desired accounts. Other accounts are left as they are.
+	OrganisationIDs []string
+}
+
+// FieldChange is a member of an account that differs from the desired account,
+// with the values encoded as JSON.
+type FieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from,omitempty"`
+	To    string `json:"to,omitempty"`
+
+	// ForcesRecreate is set if the member can't be updated.
+	ForcesRecreate bool `json:"forces_recreate,omitempty"`
+}
+
+// PlanChange is what Apply does to make an account match its desired state.
+type PlanChange struct {
+	Action string `json:"action"`
+	ID     string `json:"id"`
+
+	// Current is the account as it was listed, nil for creates. Desired is nil for deletes.
+	Current *Data `json:"current,omitempty"`
+	Desired *Data `json:"desired,omitempty"`
+
+	// Fields that differ, for updates and recreates.
+	Fields []FieldChange `json:"fields,omitempty"`
+}
+
+// Plan lists the changes that make the accounts match their desired state, sorted by ID.
+type Plan struct {
+	Changes []PlanChange `json:"changes"`
+}
+
+// Empty returns true if the accounts already match their desired state.
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// Count returns the number of changes with the action.
+func (p *Plan) Count(action string) int {
+	n := 0
+	for _, change := range p.Changes {
+		if change.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// String returns the plan as a human-readable diff.
+func (p *Plan) String() string {
+	if p.Empty() {
+		return "No changes, the accounts match their desired state.\n"
+	}
+
+	var b strings.Builder
+	for _, change := range p.Changes {
+		switch change.Action {
+		case PlanCreate:
+			fmt.Fprintf(&b, "+ %s will be created in organisation %s\n", change.ID, change.Desired.OrganisationID)
+			fields, _ := jsonFields(change.Desired.Attributes)
+			for _, name := range sortedFieldNames(fields) {
+				fmt.Fprintf(&b, "    + %s: %s\n", name, fields[name])
+			}
+		case PlanUpdate:
+			fmt.Fprintf(&b, "~ %s will be updated in place\n", change.ID)
+		case PlanRecreate:
+			fmt.Fprintf(&b, "-/+ %s will be deleted and created again\n", change.ID)
+		case PlanDelete:
+			fmt.Fprintf(&b, "- %s will be deleted from organisation %s\n", change.ID, change.Current.OrganisationID)
+		}
+
+		for _, field := range change.Fields {
+			from, to := field.From, field.To
+			if from == "" {
+				from = "(none)"
+			}
+			if to == "" {
+				to = "(none)"
+			}
+			fmt.Fprintf(&b, "    ~ %s: %s => %s", field.Field, from, to)
+			if field.ForcesRecreate {
+				b.WriteString(" (forces recreate)")
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+	}
+
+	fmt.Fprintf(&b, "Plan: %d to create, %d to update, %d to recreate, %d to delete.\n",
+		p.Count(PlanCreate), p.Count(PlanUpdate), p.Count(PlanRecreate), p.Count(PlanDelete))
+	return b.String()
+}
+
+// Plan compares the desired accounts with the accounts listed by the client and returns
+// the changes that make them match. Only the attributes set in a desired account are
+// compared, so attributes set by the server are left as they are; an attribute removed
+// from a desired account is kept too, since an update can't remove it. An account whose type,
+// organisation or bank details changed is recreated, see Apply. The accounts are listed
+// until a page is empty or has no link to a next page. The desired accounts are
+// validated unless SkipValidation is set.
+func (c *Client) Plan(ctx context.Context, desired []Data, opt ReconcileOptions) (*Plan, error) {
+	wanted := map[string]*Data{}
+	organisations := map[string]bool{}
+	for _, id := range opt.OrganisationIDs {
+		organisations[id] = true
+	}
+
+	for i := range desired {
+		d := desired[i]
+		if d.Type == "" {
+			d.Type = accountType
+		}
+		if !c.SkipValidation {
+			if err := (&Account{Data: &d}).Validate(); err != nil {
+				return nil, fmt.Errorf("desired account '%s': %w", d.ID, err)
+			}
+		}
+		if _, ok := wanted[d.ID]; ok {
+			return nil, fmt.Errorf("desired account '%s' is listed more than once", d.ID)
+		}
+		wanted[d.ID] = &d
+		if len(opt.OrganisationIDs) == 0 {
+			organisations[d.OrganisationID] = true
+		}
+	}
+
+	plan := &Plan{Changes: []PlanChange{}}
+	for page := 0; ; page++ {
+		accounts, err := c.ListAccounts(ctx, page, reconcilePageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range accounts.Data {
+			current := accounts.Data[i]
+			d, ok := wanted[current.ID]
+			if !ok {
+				if opt.Prune && organisations[current.OrganisationID] {
+					plan.Changes = append(plan.Changes, PlanChange{Action: PlanDelete, ID: current.ID, Current: &current})
+				}
+				continue
+			}
+			delete(wanted, current.ID)
+
+			fields, err := diffAccount(&current, d)
+			if err != nil {
+				return nil, err
+			}
+			if len(fields) == 0 {
+				continue
+			}
+
+			change := PlanChange{Action: PlanUpdate, ID: current.ID, Current: &current, Desired: d, Fields: fields}
+			for _, field := range fields {
+				if field.ForcesRecreate {
+					change.Action = PlanRecreate
+				}
+			}
+			plan.Changes = append(plan.Changes, change)
+		}
+
+		if accounts.lastPage() {
+			break
+		}
+	}
+
+	for id, d := range wanted {
+		plan.Changes = append(plan.Changes, PlanChange{Action: PlanCreate, ID: id, Desired: d})
+	}
+	sort.Slice(plan.Changes, func(i, j int) bool { return plan.Changes[i].ID < plan.Changes[j].ID })
+	return plan, nil
+}
+
+// Apply makes the changes of the plan, the deletes first, then the recreates, the updates
+// and the creates, each in the order of the plan, so an account can be created with the
+// bank details of an account deleted or recreated by the same plan. Updates and deletes
+// are made with the version of the account when it was planned, a recreate deletes the
+// account and creates it again; if the desired account fails to be created, the current
+// account is created again, with a new version and timestamps. It stops at the first change that fails, e.g. with a
+// VersionConflictError if an account changed since it was planned, and returns the number
+// of changes made.
+func (c *Client) Apply(ctx context.Context, plan *Plan) (int, error) {
+	changes := make([]PlanChange, len(plan.Changes))
+	copy(changes, plan.Changes)
+	sort.SliceStable(changes, func(i, j int) bool {
+		return applyRank(changes[i].Action) < applyRank(changes[j].Action)
+	})
+
+	for i, change := range changes {
+		if err := ctx.Err(); err != nil {
+			return i, err
+		}
+		if err := c.applyChange(ctx, &change); err != nil {
+			return i, fmt.Errorf("%s of account '%s': %w", change.Action, change.ID, err)
+		}
+	}
+	return len(changes), nil
+}
+
+// applyOrder are the actions in the order Apply makes them
+var applyOrder = []string{PlanDelete, PlanRecreate, PlanUpdate, PlanCreate}
+
+// applyRank returns the position of the action in applyOrder, unknown actions come last
+func applyRank(action string) int {
+	for i, a := range applyOrder {
+		if a == action {
+			return i
+		}
+	}
+	return len(applyOrder)
+}
+
+func (c *Client) applyChange(ctx context.Context, change *PlanChange) error {
+	switch change.Action {
+	case PlanCreate:
+		_, err := c.CreateAccount(ctx, &Account{Data: change.Desired})
+		return err
+
+	case PlanUpdate:
+		data := *change.Desired
+		data.Version = change.Current.Version
+		_, err := c.UpdateAccount(ctx, &Account{Data: &data})
+		return err
+
+	case PlanRecreate:
+		ctx := WithAuditOrganisation(ctx, change.Current.OrganisationID)
+		if err := c.DeleteAccount(ctx, change.ID, change.Current.Version); err != nil {
+			return err
+		}
+		if _, err := c.CreateAccount(ctx, &Account{Data: change.Desired}); err != nil {
+			return c.restoreExisting(ctx, change.Current, err)
+		}
+		return nil
+
+	case PlanDelete:
+		ctx := WithAuditOrganisation(ctx, change.Current.OrganisationID)
+		return c.DeleteAccount(ctx, change.ID, change.Current.Version)
+	}
+	return fmt.Errorf("unknown plan action '%s'", change.Action)
+}
+
+// diffAccount returns the members set in the desired account that differ in the current one,
+// members that are only set in the current one are not compared
+func diffAccount(current, desired *Data) ([]FieldChange, error) {
+	fields := []FieldChange{}
+	if current.Type != desired.Type {
+		fields = append(fields, newFieldChange("type", current.Type, desired.Type))
+	}
+	if current.OrganisationID != desired.OrganisationID {
+		fields = append(fields, newFieldChange("organisation_id", current.OrganisationID, desired.OrganisationID))
+	}
+
+	from, err := jsonFields(current.Attributes)
+	if err != nil {
+		return nil, err
+	}
+	to, err := jsonFields(desired.Attributes)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range sortedFieldNames(to) {
+		if from[name] != to[name] {
+			fields = append(fields, FieldChange{
+				Field:          name,
+				From:           from[name],
+				To:             to[name],
+				ForcesRecreate: immutableFields[name],
+			})
+		}
+	}
+	return fields, nil
+}
+
+func newFieldChange(name, from, to string) FieldChange {
+	encode := func(s string) string {
+		if s == "" {
+			return ""
+		}
+		data, _ := json.Marshal(s)
+		return string(data)
+	}
+	return FieldChange{Field: name, From: encode(from), To: encode(to), ForcesRecreate: immutableFields[name]}
+}
+
+// jsonFields returns the attributes that are set, as compact JSON by JSON name
+func jsonFields(a *Attributes) (map[string]string, error) {
+	fields := map[string]string{}
+	if a == nil {
+		return fields, nil
+	}
+
+	data, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil {
+		return nil, err
+	}
+	for name, value := range members {
+		var b bytes.Buffer
+		if err := json.Compact(&b, value); err != nil {
+			return nil, err
+		}
+		fields[name] = b.String()
+	}
+	return fields, nil
+}
+
+func sortedFieldNames(fields map[string]string) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,221 @@
+package accountapi_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	. "github.com/alexdreptu/form3-accountapi-client"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newReconcileTestAccounts returns the current accounts of an organisation, with
+// an account of another organisation last
+func newReconcileTestAccounts(t *testing.T) []Data {
+	organisationID := uuid.New().String()
+	accounts := []Data{}
+	for i := 0; i < 5; i++ {
+		d := *newValidateTestAccount(t).Data
+		d.OrganisationID = organisationID
+		d.Attributes.Name = []string{"Samantha Holder"}
+		d.Version = 2
+		accounts = append(accounts, d)
+	}
+	accounts[4].OrganisationID = uuid.New().String()
+	return accounts
+}
+
+// desiredAccount returns a copy of the account as it's kept in a desired state file
+func desiredAccount(d Data) Data {
+	attributes := *d.Attributes
+	return Data{
+		Details:    Details{ID: d.ID, OrganisationID: d.OrganisationID},
+		Attributes: &attributes,
+	}
+}
+
+func TestPlan(t *testing.T) {
+	accounts := newReconcileTestAccounts(t)
+	server := newTestAccountServer(t, accounts...)
+	client := NewClient(&http.Client{}, server.URL)
+
+	// the current state is the desired state
+	desired := []Data{}
+	for _, d := range accounts[:4] {
+		desired = append(desired, desiredAccount(d))
+	}
+	plan, err := client.Plan(snapshotTestContext(t), desired, ReconcileOptions{Prune: true})
+	require.NoError(t, err)
+	assert.True(t, plan.Empty())
+	assert.Contains(t, plan.String(), "No changes")
+
+	// a name changed, a bank ID changed, an account is gone and another is new
+	desired[0].Attributes.Name = []string{"Sam Holder"}
+	desired[1].Attributes.BankID = "400302"
+	desired[1].Attributes.Name = []string{"Samantha H"}
+	created := desiredAccount(*newValidateTestAccount(t).Data)
+	created.OrganisationID = accounts[0].OrganisationID
+	desired = append(desired[:3], created)
+
+	plan, err = client.Plan(snapshotTestContext(t), desired, ReconcileOptions{Prune: true})
+	require.NoError(t, err)
+	actions := map[string]PlanChange{}
+	for _, change := range plan.Changes {
+		actions[change.ID] = change
+	}
+	require.Len(t, actions, 4)
+	assert.Equal(t, PlanUpdate, actions[accounts[0].ID].Action)
+	assert.Equal(t, []FieldChange{{Field: "name", From: `["Samantha Holder"]`, To: `["Sam Holder"]`}},
+		actions[accounts[0].ID].Fields)
+	assert.Equal(t, PlanRecreate, actions[accounts[1].ID].Action)
+	assert.Equal(t, FieldChange{Field: "bank_id", From: `"` + accounts[1].Attributes.BankID + `"`, To: `"400302"`, ForcesRecreate: true},
+		actions[accounts[1].ID].Fields[0])
+	assert.Equal(t, PlanDelete, actions[accounts[3].ID].Action)
+	assert.Equal(t, PlanCreate, actions[created.ID].Action)
+	// accounts of other organisations are not managed
+	assert.NotContains(t, actions, accounts[4].ID)
+
+	assert.Equal(t, 1, plan.Count(PlanRecreate))
+	diff := plan.String()
+	assert.Contains(t, diff, "~ "+accounts[0].ID+" will be updated in place\n"+
+		`    ~ name: ["Samantha Holder"] => ["Sam Holder"]`)
+	assert.Contains(t, diff, `    ~ bank_id: "`+accounts[1].Attributes.BankID+`" => "400302" (forces recreate)`)
+	assert.Contains(t, diff, "- "+accounts[3].ID+" will be deleted")
+	assert.Contains(t, diff, "+ "+created.ID+" will be created")
+	assert.True(t, strings.HasSuffix(diff, "Plan: 1 to create, 1 to update, 1 to recreate, 1 to delete.\n"))
+
+	// attributes removed from a desired account are not compared
+	removed := desiredAccount(accounts[2])
+	removed.Attributes.Name = nil
+	removed.Attributes.AccountClassification = ""
+	plan, err = client.Plan(snapshotTestContext(t), []Data{removed}, ReconcileOptions{})
+	require.NoError(t, err)
+	assert.True(t, plan.Empty(), plan.String())
+
+	// the accounts of every page are compared if the server serves smaller pages
+	server.maxPageSize = 2
+	plan, err = client.Plan(snapshotTestContext(t), desired, ReconcileOptions{Prune: true})
+	require.NoError(t, err)
+	assert.True(t, strings.HasSuffix(plan.String(), "Plan: 1 to create, 1 to update, 1 to recreate, 1 to delete.\n"))
+	server.maxPageSize = 0
+
+	// without pruning, accounts that are not desired are kept
+	plan, err = client.Plan(snapshotTestContext(t), desired, ReconcileOptions{})
+	require.NoError(t, err)
+	assert.Zero(t, plan.Count(PlanDelete))
+
+	// the desired accounts are validated
+	desired[0].Attributes.BankID = "1"
+	_, err = client.Plan(snapshotTestContext(t), desired, ReconcileOptions{})
+	assert.Error(t, err)
+	_, err = client.Plan(snapshotTestContext(t), append(desired[1:], desired[1]), ReconcileOptions{})
+	assert.EqualError(t, err, "desired account '"+desired[1].ID+"' is listed more than once")
+}
+
+func TestApply(t *testing.T) {
+	accounts := newReconcileTestAccounts(t)
+	server := newTestAccountServer(t, accounts...)
+	client := NewClient(&http.Client{}, server.URL)
+
+	// a new account takes the bank details of the pruned account
+	desired := []Data{desiredAccount(accounts[0]), desiredAccount(accounts[1]), desiredAccount(accounts[2])}
+	desired[0].Attributes.Name = []string{"Sam Holder"}
+	desired[1].Attributes.BankID = "400302"
+	created := desiredAccount(accounts[3])
+	created.ID = uuid.New().String()
+	desired = append(desired, created)
+	plan, err := client.Plan(snapshotTestContext(t), desired, ReconcileOptions{Prune: true})
+	require.NoError(t, err)
+	server.requests = nil
+	var log bytes.Buffer
+	client.Audit = NewAuditLog(&log)
+
+	n, err := client.Apply(snapshotTestContext(t), plan)
+	require.NoError(t, err)
+	assert.Equal(t, 4, n)
+	// the deletes are audited with the organisation of the planned accounts
+	for _, record := range readAuditRecords(t, log.Bytes()) {
+		assert.Equal(t, accounts[0].OrganisationID, record.OrganisationID, record.Operation)
+	}
+	// deletes and recreates are made first
+	assert.Equal(t, []string{
+		"DELETE " + accounts[3].ID,
+		"DELETE " + accounts[1].ID, "POST",
+		"PATCH " + accounts[0].ID,
+		"POST",
+	}, server.requests)
+	assert.Equal(t, accounts[3].Attributes.AccountNumber, server.accounts[created.ID].Attributes.AccountNumber)
+	assert.NotContains(t, server.accounts, accounts[3].ID)
+	assert.Equal(t, []string{"Sam Holder"}, server.accounts[accounts[0].ID].Attributes.Name)
+	assert.Equal(t, 3, server.accounts[accounts[0].ID].Version)
+	assert.Equal(t, "400302", server.accounts[accounts[1].ID].Attributes.BankID)
+	assert.Zero(t, server.accounts[accounts[1].ID].Version)
+
+	// nothing is left to do
+	plan, err = client.Plan(snapshotTestContext(t), desired, ReconcileOptions{Prune: true})
+	require.NoError(t, err)
+	assert.True(t, plan.Empty(), plan.String())
+}
+
+func TestApply_RecreateRejected(t *testing.T) {
+	accounts := newReconcileTestAccounts(t)
+	server := newTestAccountServer(t, accounts...)
+	client := NewClient(&http.Client{}, server.URL)
+
+	desired := []Data{desiredAccount(accounts[0])}
+	desired[0].Attributes.BankID = "400302"
+	plan, err := client.Plan(snapshotTestContext(t), desired, ReconcileOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 1, plan.Count(PlanRecreate))
+
+	server.reject = func(d *Data) string {
+		if d.Attributes.BankID == "400302" {
+			return "validation failure list:\nbank_id in body is not allowed"
+		}
+		return ""
+	}
+	server.requests = nil
+
+	n, err := client.Apply(snapshotTestContext(t), plan)
+	assert.Zero(t, n)
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr), err)
+	assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+	assert.Contains(t, err.Error(), "the existing account was created again")
+	// the current account is created again
+	assert.Equal(t, []string{"DELETE " + accounts[0].ID, "POST", "POST"}, server.requests)
+	assert.Equal(t, accounts[0].Attributes.BankID, server.accounts[accounts[0].ID].Attributes.BankID)
+}
+
+func TestApply_Conflict(t *testing.T) {
+	accounts := newReconcileTestAccounts(t)
+	server := newTestAccountServer(t, accounts...)
+	client := NewClient(&http.Client{}, server.URL)
+
+	desired := []Data{desiredAccount(accounts[0]), desiredAccount(accounts[1])}
+	for i := range desired {
+		desired[i].Attributes.Name = []string{"Sam Holder"}
+	}
+	plan, err := client.Plan(snapshotTestContext(t), desired, ReconcileOptions{})
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 2)
+
+	// the first account of the plan changed since it was planned
+	first := server.accounts[plan.Changes[0].ID]
+	first.Version++
+	server.accounts[first.ID] = first
+	server.requests = nil
+
+	n, err := client.Apply(snapshotTestContext(t), plan)
+	require.Error(t, err)
+	assert.Zero(t, n)
+	var conflict *VersionConflictError
+	require.True(t, errors.As(err, &conflict))
+	assert.Equal(t, VersionConflictError{ID: first.ID, Version: 2}, *conflict)
+	// it stopped at the conflict
+	assert.Equal(t, []string{"PATCH " + first.ID}, server.requests)
+}
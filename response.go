@@ -0,0 +1,102 @@
+package accountapi
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// header of the ID the server assigned to the request
+const headerRequestID = "X-Request-Id"
+
+// Response holds the details of a response of the server, see Client.OnResponse and WithResponse.
+type Response struct {
+	// Method and URL of the request.
+	Method string
+	URL    string
+
+	StatusCode int
+
+	// Header of the response, it includes the rate limit headers.
+	Header http.Header
+
+	// ID the server assigned to the request, needed for support tickets.
+	// Blank if the server didn't send one.
+	RequestID string
+
+	// Duration is the time from sending the request to reading the whole body.
+	Duration time.Duration
+
+	// DryRun is true if the request was recorded instead of sent, see Client.DryRun.
+	// The response is then the one the server would send if the request succeeded.
+	DryRun bool
+
+	body []byte
+}
+
+// Body returns the raw body of the response.
+func (r *Response) Body() []byte {
+	return append([]byte(nil), r.body...)
+}
+
+type responseKey struct{}
+
+// WithResponse returns a context that makes the calls of a Client store their response
+// in resp, so concurrent callers each get the response of their own call. Calls that send
+// several requests, like Plan or Export, store the last response.
+func WithResponse(ctx context.Context, resp *Response) context.Context {
+	return context.WithValue(ctx, responseKey{}, resp)
+}
+
+// respond passes the response to OnResponse, if it's set, and stores it in the
+// response of the context, if it has one
+func (c *Client) respond(ctx context.Context, response *Response) {
+	if resp, ok := ctx.Value(responseKey{}).(*Response); ok && resp != nil {
+		*resp = *response
+	}
+	if c.OnResponse != nil {
+		c.OnResponse(response)
+	}
+}
+
+// do sends the request and reads the response, which is passed to OnResponse and stored
+// in the response of the context. In dry-run mode requests that change accounts are
+// recorded instead.
+func (c *Client) do(ctx context.Context, req *http.Request) (*Response, error) {
+	if c.DryRun != nil && !isReadMethod(req.Method) {
+		response, err := c.DryRun.record(req)
+		if err != nil {
+			return nil, err
+		}
+		c.respond(ctx, response)
+		return response, nil
+	}
+
+	start := time.Now()
+
+	resp, err := c.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &Response{
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		RequestID:  resp.Header.Get(headerRequestID),
+		Duration:   time.Since(start),
+		body:       body,
+	}
+
+	c.respond(ctx, response)
+
+	return response, nil
+}
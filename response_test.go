@@ -0,0 +1,136 @@
+package accountapi_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/alexdreptu/form3-accountapi-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_OnResponse(t *testing.T) {
+	var stored []byte
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Request-Id", "req-"+r.Method)
+			w.Header().Set("X-RateLimit-Remaining", "99")
+			switch r.Method {
+			case http.MethodPost:
+				stored, _ = ioutil.ReadAll(r.Body)
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write(stored)
+			case http.MethodGet:
+				if r.URL.Query().Get("page[size]") != "" {
+					_, _ = w.Write([]byte(`{"data": [], "links": {}}`))
+					return
+				}
+				_, _ = w.Write(stored)
+			case http.MethodDelete:
+				w.WriteHeader(http.StatusNoContent)
+			}
+		},
+	))
+	defer server.Close()
+
+	responses := []*Response{}
+	client := NewClient(&http.Client{}, server.URL)
+	client.OnResponse = func(resp *Response) {
+		responses = append(responses, resp)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	account := newValidateTestAccount(t)
+	_, err := client.CreateAccount(ctx, account)
+	require.NoError(t, err)
+	_, err = client.FetchAccount(ctx, account.Data.ID)
+	require.NoError(t, err)
+	_, err = client.ListAccounts(ctx, 0, 10)
+	require.NoError(t, err)
+	require.NoError(t, client.DeleteAccount(ctx, account.Data.ID, 0))
+
+	require.Len(t, responses, 4)
+
+	methods := []string{http.MethodPost, http.MethodGet, http.MethodGet, http.MethodDelete}
+	statuses := []int{http.StatusCreated, http.StatusOK, http.StatusOK, http.StatusNoContent}
+	for i, resp := range responses {
+		assert.Equal(t, methods[i], resp.Method)
+		assert.Equal(t, statuses[i], resp.StatusCode)
+		assert.Equal(t, "req-"+methods[i], resp.RequestID)
+		assert.Equal(t, "99", resp.Header.Get("X-RateLimit-Remaining"))
+		assert.True(t, resp.Duration > 0)
+	}
+
+	assert.Equal(t, server.URL, responses[0].URL)
+	assert.Equal(t, server.URL+"/"+account.Data.ID, responses[1].URL)
+	assert.Equal(t, stored, responses[1].Body())
+	assert.JSONEq(t, `{"data": [], "links": {}}`, string(responses[2].Body()))
+	assert.Empty(t, responses[3].Body())
+
+	// the body is copied
+	responses[1].Body()[0] = 'X'
+	assert.Equal(t, stored, responses[1].Body())
+}
+
+func TestClient_OnResponse_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	var response *Response
+	client := NewClient(&http.Client{}, server.URL)
+	client.OnResponse = func(resp *Response) {
+		response = resp
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	_, err := client.FetchAccount(ctx, newValidateTestAccount(t).Data.ID)
+	require.Error(t, err)
+	require.NotNil(t, response)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+	assert.Empty(t, response.RequestID)
+}
+
+func TestWithResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Request-Id", "req-"+r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		},
+	))
+	defer server.Close()
+
+	client := NewClient(&http.Client{}, server.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// every call gets its own response, even when they run concurrently
+	ids := []string{}
+	responses := make([]Response, 10)
+	var wg sync.WaitGroup
+	for i := range responses {
+		id := newValidateTestAccount(t).Data.ID
+		ids = append(ids, id)
+		wg.Add(1)
+		go func(resp *Response) {
+			defer wg.Done()
+			_, _ = client.FetchAccount(WithResponse(ctx, resp), id)
+		}(&responses[i])
+	}
+	wg.Wait()
+
+	for i, resp := range responses {
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		assert.Equal(t, server.URL+"/"+ids[i], resp.URL)
+		assert.Equal(t, "req-/"+ids[i], resp.RequestID)
+	}
+}
@@ -0,0 +1,269 @@
+package accountapi
+
+import "encoding/json"
+
+//go:generate go run ./internal/genschema -o account.schema.json
+
+// JSON Schema dialect of AccountJSONSchema
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// identifier of the schema, the location of the generated file in the repository
+const jsonSchemaID = "https://github.com/alexdreptu/form3-accountapi-client/account.schema.json"
+
+// schema is a JSON Schema object, encoding/json sorts its keys so the output is stable
+type schema map[string]interface{}
+
+// AccountJSONSchema returns a JSON Schema (draft 2020-12) of the create account payload.
+// The per-country rules are generated from the table NewAccount validates the country
+// specific attributes with, they are in if/then branches on the country attribute.
+//
+// Blank attributes must be omitted, as they are when an Account is encoded. Rules that
+// can't be expressed in JSON Schema, like check digits and the characters allowed in
+// names, are only checked by NewAccount.
+func AccountJSONSchema() ([]byte, error) {
+	return json.MarshalIndent(accountSchema(), "", "  ")
+}
+
+func accountSchema() schema {
+	defs := schema{
+		"data":                        dataSchema(),
+		"attributes":                  attributesSchema(),
+		"private_identification":      privateIdentificationSchema(),
+		"organisation_identification": organisationIdentificationSchema(),
+		"iso_country":                 isoCountrySchema(),
+	}
+
+	for _, country := range SupportedCountries() {
+		defs["country_"+country] = countrySchema(country)
+	}
+
+	return schema{
+		"$schema":    jsonSchemaDialect,
+		"$id":        jsonSchemaID,
+		"title":      "Create account",
+		"type":       "object",
+		"required":   []string{"data"},
+		"properties": schema{"data": ref("data")},
+		"$defs":      defs,
+	}
+}
+
+func dataSchema() schema {
+	return schema{
+		"type":     "object",
+		"required": []string{"type", "id", "organisation_id", "attributes"},
+		"properties": schema{
+			"type":            schema{"const": accountType},
+			"id":              schema{"type": "string", "format": "uuid"},
+			"organisation_id": schema{"type": "string", "format": "uuid"},
+			"version":         schema{"type": "integer", "minimum": 0},
+			"attributes":      ref("attributes"),
+		},
+	}
+}
+
+func attributesSchema() schema {
+	branches := []schema{}
+	for _, country := range SupportedCountries() {
+		branches = append(branches, schema{
+			"if":   schema{"properties": schema{"country": schema{"const": country}}},
+			"then": ref("country_" + country),
+		})
+	}
+
+	// business accounts must not have a first name
+	branches = append(branches, schema{
+		"if": schema{
+			"required":   []string{"account_classification"},
+			"properties": schema{"account_classification": schema{"const": AccountClassificationBusiness}},
+		},
+		"then": schema{"properties": schema{"first_name": blankSchema()}},
+	})
+
+	return schema{
+		"type":     "object",
+		"required": []string{"country"},
+		"properties": schema{
+			"country": schema{
+				"type":      "string",
+				"minLength": countryLength,
+				"maxLength": countryLength,
+				"enum":      SupportedCountries(),
+			},
+			"base_currency": schema{
+				"type":      "string",
+				"minLength": baseCurrencyLength,
+				"maxLength": baseCurrencyLength,
+				"pattern":   "^[A-Z]+$",
+			},
+			"bank_id":      schema{"type": "string", "pattern": "^[0-9]*$"},
+			"bank_id_code": schema{"type": "string", "pattern": "^[A-Z]*$"},
+			"bic": schema{
+				"type":    "string",
+				"pattern": bicPattern,
+			},
+			"account_number": schema{"type": "string", "pattern": "^[0-9]*$"},
+			"customer_id":    lengthSchema(customerIDLengthStart, customerIDLengthStop),
+			"title":          lengthSchema(0, titleLengthStop),
+			"first_name":     lengthSchema(firstNameLengthStart, firstNameLengthStop),
+			"name": arraySchema(nameArrayLengthStart, nameArrayLengthStop,
+				lengthSchema(nameElemLengthStart, nameElemLengthStop)),
+			"bank_account_name": lengthSchema(0, bankAccountNameLengthStop),
+			"alternative_names": arraySchema(alternativeNamesArrayLengthStart, alternativeNamesArrayLengthStop,
+				lengthSchema(alternativeNamesElemLengthStart, alternativeNamesElemLengthStop)),
+			"alternative_bank_account_names": arraySchema(
+				alternativeBankAccountNamesArrayLengthStart, alternativeBankAccountNamesArrayLengthStop,
+				lengthSchema(alternativeBankAccountNamesElemLengthStart, alternativeBankAccountNamesElemLengthStop)),
+			"joint_account": schema{"type": "boolean"},
+			"account_classification": schema{
+				"enum": []string{AccountClassificationPersonal, AccountClassificationBusiness},
+			},
+			"status": schema{
+				"enum": []string{AccountStatusPending, AccountStatusConfirmed, AccountStatusClosed},
+			},
+			"switched":                    schema{"type": "boolean"},
+			"secondary_identification":    lengthSchema(0, secondaryIdentificationLengthStop),
+			"private_identification":      ref("private_identification"),
+			"organisation_identification": ref("organisation_identification"),
+			"account_matching_opt_out":    schema{"type": "boolean"},
+		},
+		"allOf": branches,
+	}
+}
+
+// countrySchema returns the rules of a supported country, built from its CountryInfo
+func countrySchema(country string) schema {
+	info, _ := CountryInfo(country)
+	rules := countries[country]
+
+	properties := schema{
+		"base_currency": schema{"const": info.Currency},
+		"account_number": schema{
+			"minLength": info.AccountNumberLengthFrom,
+			"maxLength": info.AccountNumberLengthTo,
+		},
+	}
+
+	if rules.accountNumberFirstCharNonZero {
+		properties["account_number"].(schema)["pattern"] = "^[1-9]"
+	}
+
+	branch := schema{
+		"required":   info.RequiredFields,
+		"properties": properties,
+	}
+
+	switch {
+	case info.BankIDLength == 0:
+		properties["bank_id"] = blankSchema()
+	case info.BankIDLength != info.BankIDLengthWithAccountNumber:
+		// the bank ID length depends on the account number
+		branch["if"] = schema{
+			"required":   []string{"account_number"},
+			"properties": schema{"account_number": schema{"minLength": 1}},
+		}
+		branch["then"] = schema{"properties": schema{
+			"bank_id": lengthSchema(info.BankIDLengthWithAccountNumber, info.BankIDLengthWithAccountNumber),
+		}}
+		branch["else"] = schema{"properties": schema{
+			"bank_id": lengthSchema(info.BankIDLength, info.BankIDLength),
+		}}
+	default:
+		properties["bank_id"] = lengthSchema(info.BankIDLength, info.BankIDLength)
+	}
+
+	if rules.bankIDFirstCharZero {
+		properties["bank_id"].(schema)["pattern"] = "^0"
+	}
+
+	if len(info.BankIDCodes) == 0 {
+		properties["bank_id_code"] = blankSchema()
+	} else {
+		properties["bank_id_code"] = schema{"enum": info.BankIDCodes}
+	}
+
+	if country != CountryUnitedKingdom {
+		properties["switched"] = schema{"const": false}
+	}
+
+	return branch
+}
+
+func privateIdentificationSchema() schema {
+	return schema{
+		"type": "object",
+		"properties": schema{
+			"birth_date":     schema{"type": "string", "format": "date"},
+			"birth_country":  ref("iso_country"),
+			"identification": lengthSchema(0, identificationLengthStop),
+			"address":        addressSchema(),
+			"city":           lengthSchema(0, cityLengthStop),
+			"country":        ref("iso_country"),
+		},
+	}
+}
+
+func organisationIdentificationSchema() schema {
+	return schema{
+		"type": "object",
+		"properties": schema{
+			"identification":      lengthSchema(0, identificationLengthStop),
+			"registration_number": lengthSchema(0, identificationLengthStop),
+			"representative": schema{
+				"type": "object",
+				"properties": schema{
+					"name":       lengthSchema(0, representativeNameLengthStop),
+					"birth_date": schema{"type": "string", "format": "date"},
+					"residency":  ref("iso_country"),
+				},
+			},
+			"address": addressSchema(),
+			"city":    lengthSchema(0, cityLengthStop),
+			"country": ref("iso_country"),
+		},
+	}
+}
+
+func addressSchema() schema {
+	return arraySchema(addressArrayLengthStart, addressArrayLengthStop,
+		lengthSchema(addressElemLengthStart, addressElemLengthStop))
+}
+
+// isoCountrySchema accepts the ISO 3166-1 alpha-2 codes, or a blank country
+func isoCountrySchema() schema {
+	codes := []string{""}
+	for _, c := range iso3166Countries {
+		codes = append(codes, c.Alpha2)
+	}
+	return schema{"type": "string", "enum": codes}
+}
+
+func ref(name string) schema {
+	return schema{"$ref": "#/$defs/" + name}
+}
+
+// lengthSchema returns a string schema, maxLength is only set if it's not 0
+func lengthSchema(minLength, maxLength int) schema {
+	s := schema{"type": "string"}
+	if minLength != 0 {
+		s["minLength"] = minLength
+	}
+	if maxLength != 0 {
+		s["maxLength"] = maxLength
+	}
+	return s
+}
+
+func arraySchema(minItems, maxItems int, items schema) schema {
+	return schema{
+		"type":     "array",
+		"minItems": minItems,
+		"maxItems": maxItems,
+		"items":    items,
+	}
+}
+
+// blankSchema accepts only a blank string, attributes that must be blank can also be omitted
+func blankSchema() schema {
+	return schema{"const": ""}
+}
@@ -0,0 +1,78 @@
+package accountapi_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	. "github.com/alexdreptu/form3-accountapi-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAccountJSONSchema_Generated checks the generated file is up to date
+func TestAccountJSONSchema_Generated(t *testing.T) {
+	data, err := AccountJSONSchema()
+	require.NoError(t, err)
+
+	generated, err := ioutil.ReadFile("account.schema.json")
+	require.NoError(t, err)
+	assert.Equal(t, string(data)+"\n", string(generated), "run go generate")
+}
+
+func TestAccountJSONSchema(t *testing.T) {
+	data, err := AccountJSONSchema()
+	require.NoError(t, err)
+
+	var s struct {
+		Schema string `json:"$schema"`
+		Defs   map[string]struct {
+			Required   []string                          `json:"required"`
+			Properties map[string]map[string]interface{} `json:"properties"`
+			AllOf      []struct {
+				If struct {
+					Properties map[string]map[string]interface{} `json:"properties"`
+				} `json:"if"`
+				Then map[string]interface{} `json:"then"`
+			} `json:"allOf"`
+		} `json:"$defs"`
+	}
+	require.NoError(t, json.Unmarshal(data, &s))
+	assert.Equal(t, "https://json-schema.org/draft/2020-12/schema", s.Schema)
+
+	attributes := s.Defs["attributes"]
+	assert.Equal(t, []string{"country"}, attributes.Required)
+	assert.Len(t, attributes.Properties["country"]["enum"], len(SupportedCountries()))
+
+	branches := map[string]string{}
+	for _, branch := range attributes.AllOf {
+		if country, ok := branch.If.Properties["country"]["const"].(string); ok {
+			branches[country], _ = branch.Then["$ref"].(string)
+		}
+	}
+
+	for _, country := range SupportedCountries() {
+		info, err := CountryInfo(country)
+		require.NoError(t, err)
+
+		assert.Equal(t, "#/$defs/country_"+country, branches[country])
+		def := s.Defs["country_"+country]
+		assert.Equal(t, info.RequiredFields, def.Required, country)
+		assert.Equal(t, info.Currency, def.Properties["base_currency"]["const"], country)
+		assert.EqualValues(t, info.AccountNumberLengthFrom, def.Properties["account_number"]["minLength"], country)
+		assert.EqualValues(t, info.AccountNumberLengthTo, def.Properties["account_number"]["maxLength"], country)
+
+		switch {
+		case info.BankIDLength == 0:
+			assert.Equal(t, "", def.Properties["bank_id"]["const"], country)
+		case info.BankIDLength == info.BankIDLengthWithAccountNumber:
+			assert.EqualValues(t, info.BankIDLength, def.Properties["bank_id"]["minLength"], country)
+			assert.EqualValues(t, info.BankIDLength, def.Properties["bank_id"]["maxLength"], country)
+		}
+	}
+
+	assert.Equal(t, "^0", s.Defs["country_"+CountryCanada].Properties["bank_id"]["pattern"])
+	assert.Equal(t, "^[1-9]", s.Defs["country_"+CountryAustralia].Properties["account_number"]["pattern"])
+	assert.NotContains(t, s.Defs["country_"+CountryUnitedKingdom].Properties, "switched")
+	assert.Equal(t, false, s.Defs["country_"+CountryFrance].Properties["switched"]["const"])
+}
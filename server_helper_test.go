@@ -0,0 +1,169 @@
+package accountapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/alexdreptu/form3-accountapi-client"
+)
+
+// testAccountServer keeps accounts in memory and answers create, fetch, list, update
+// and delete like the account API, with 409s for duplicate IDs and wrong versions
+type testAccountServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	accounts map[string]Data
+
+	// requests made, e.g. "POST", "PATCH <id>", "DELETE <id>"
+	requests []string
+
+	// reject, if set, returns the error message the creation of an account
+	// is rejected with, with a 400, or blank to create it
+	reject func(d *Data) string
+
+	// maxPageSize, if set, caps the page size of lists
+	maxPageSize int
+}
+
+func newTestAccountServer(t *testing.T, accounts ...Data) *testAccountServer {
+	s := &testAccountServer{accounts: map[string]Data{}}
+	for _, d := range accounts {
+		s.accounts[d.ID] = d
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// sorted returns the accounts sorted by ID
+func (s *testAccountServer) sorted() []Data {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	accounts := make([]Data, 0, len(s.accounts))
+	for _, d := range s.accounts {
+		accounts = append(accounts, d)
+	}
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].ID < accounts[j].ID })
+	return accounts
+}
+
+func (s *testAccountServer) handle(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/")
+	if r.Method != http.MethodGet {
+		s.mu.Lock()
+		s.requests = append(s.requests, strings.TrimSpace(r.Method+" "+id))
+		s.mu.Unlock()
+	}
+
+	switch {
+	case r.Method == http.MethodPost:
+		var account Account
+		if err := json.NewDecoder(r.Body).Decode(&account); err != nil || account.Data == nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.mu.Lock()
+		_, exists := s.accounts[account.Data.ID]
+		rejected := ""
+		if !exists && s.reject != nil {
+			rejected = s.reject(account.Data)
+		}
+		if !exists && rejected == "" {
+			account.Data.Version = 0
+			account.Data.CreatedOn = time.Now().UTC().Format(time.RFC3339)
+			account.Data.ModifiedOn = account.Data.CreatedOn
+			s.accounts[account.Data.ID] = *account.Data
+		}
+		s.mu.Unlock()
+		if exists {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error_message": "Account cannot be created as it violates a duplicate constraint"}`))
+			return
+		}
+		if rejected != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error_message": rejected})
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(account)
+
+	case r.Method == http.MethodGet && id == "":
+		page, _ := strconv.Atoi(r.URL.Query().Get("page[number]"))
+		size, _ := strconv.Atoi(r.URL.Query().Get("page[size]"))
+		if s.maxPageSize > 0 && size > s.maxPageSize {
+			size = s.maxPageSize
+		}
+		all := s.sorted()
+		accounts := Accounts{Data: []Data{}}
+		for i := page * size; i < len(all) && i < (page+1)*size; i++ {
+			accounts.Data = append(accounts.Data, all[i])
+		}
+		if (page+1)*size < len(all) {
+			accounts.Links.Next = "/?page[number]=" + strconv.Itoa(page+1) + "&page[size]=" + strconv.Itoa(size)
+		}
+		_ = json.NewEncoder(w).Encode(accounts)
+
+	case r.Method == http.MethodGet:
+		s.mu.Lock()
+		d, ok := s.accounts[id]
+		s.mu.Unlock()
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(Account{Data: &d})
+
+	case r.Method == http.MethodPatch:
+		var account Account
+		if err := json.NewDecoder(r.Body).Decode(&account); err != nil || account.Data == nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		d, ok := s.accounts[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if account.Data.Version != d.Version {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error_message": "invalid version"}`))
+			return
+		}
+		d.Attributes = account.Data.Attributes
+		d.Version++
+		d.ModifiedOn = time.Now().UTC().Format(time.RFC3339)
+		s.accounts[id] = d
+		_ = json.NewEncoder(w).Encode(Account{Data: &d})
+
+	case r.Method == http.MethodDelete:
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		d, ok := s.accounts[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Query().Get("version") != strconv.Itoa(d.Version) {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error_message": "invalid version"}`))
+			return
+		}
+		delete(s.accounts, id)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
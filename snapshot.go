@@ -0,0 +1,284 @@
+package accountapi
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// snapshotFormat identifies snapshot archives
+	snapshotFormat = "accountapi-snapshot"
+
+	// SnapshotVersion is the version of the snapshot format written by Snapshot.
+	// Restore reads snapshots up to this version.
+	SnapshotVersion = 1
+
+	// snapshotPageSize is the page size Snapshot lists the accounts with
+	snapshotPageSize = 100
+)
+
+// snapshotHeader is the first line of a snapshot
+type snapshotHeader struct {
+	Format    string    `json:"format"`
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// snapshotTrailer is the last line of a snapshot, it tells complete snapshots from cut ones
+type snapshotTrailer struct {
+	Accounts int `json:"accounts"`
+}
+
+// snapshotLine is a line of a snapshot, one of the members is set
+type snapshotLine struct {
+	Header  *snapshotHeader  `json:"header,omitempty"`
+	Account *Data            `json:"account,omitempty"`
+	Trailer *snapshotTrailer `json:"trailer,omitempty"`
+}
+
+// Snapshot writes every account, attributes and details, to w as a gzip compressed
+// JSON Lines archive: a header with the format version, an account per line and a
+// trailer with the number of accounts. Attributes the client doesn't model are kept.
+// The pages are read until one is empty or has no link to a next page. It returns the
+// number of accounts written, see Restore.
+func (c *Client) Snapshot(ctx context.Context, w io.Writer) (int, error) {
+	zw := gzip.NewWriter(w)
+	encoder := json.NewEncoder(zw)
+
+	header := &snapshotHeader{Format: snapshotFormat, Version: SnapshotVersion, CreatedAt: time.Now().UTC()}
+	if err := encoder.Encode(snapshotLine{Header: header}); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for page := 0; ; page++ {
+		accounts, err := c.ListAccounts(ctx, page, snapshotPageSize)
+		if err != nil {
+			return count, err
+		}
+		for i := range accounts.Data {
+			if err := encoder.Encode(snapshotLine{Account: &accounts.Data[i]}); err != nil {
+				return count, err
+			}
+			count++
+		}
+		if accounts.lastPage() {
+			break
+		}
+	}
+
+	if err := encoder.Encode(snapshotLine{Trailer: &snapshotTrailer{Accounts: count}}); err != nil {
+		return count, err
+	}
+	return count, zw.Close()
+}
+
+// readSnapshot reads and checks a whole snapshot, it returns its accounts
+func readSnapshot(r io.Reader) ([]Data, error) {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSnapshot, err)
+	}
+	decoder := json.NewDecoder(zr)
+
+	var line snapshotLine
+	if err := decoder.Decode(&line); err != nil || line.Header == nil || line.Header.Format != snapshotFormat {
+		return nil, ErrInvalidSnapshot
+	}
+	if line.Header.Version < 1 || line.Header.Version > SnapshotVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidSnapshot, line.Header.Version)
+	}
+
+	accounts := []Data{}
+	for {
+		var line snapshotLine
+		err := decoder.Decode(&line)
+		if err == io.EOF || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, ErrSnapshotTruncated
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidSnapshot, err)
+		}
+
+		switch {
+		case line.Account != nil:
+			accounts = append(accounts, *line.Account)
+		case line.Trailer != nil:
+			if line.Trailer.Accounts != len(accounts) {
+				return nil, ErrSnapshotTruncated
+			}
+			return accounts, nil
+		default:
+			return nil, ErrInvalidSnapshot
+		}
+	}
+}
+
+// What to do with the accounts of a snapshot whose ID already exists
+const (
+	// RestoreSkip leaves the existing account as it is.
+	RestoreSkip = "skip"
+
+	// RestoreOverwrite deletes the existing account and creates it from the snapshot.
+	RestoreOverwrite = "overwrite"
+)
+
+// Actions of a RestoreChange
+const (
+	RestoreCreated     = "created"
+	RestoreOverwritten = "overwritten"
+	RestoreSkipped     = "skipped"
+)
+
+// RestoreOptions configures Restore
+type RestoreOptions struct {
+	// IDs maps account IDs of the snapshot to the IDs they are restored with.
+	IDs map[string]string
+
+	// NewIDs restores the accounts that are not in IDs with new random IDs,
+	// e.g. to copy accounts next to the ones they were taken from.
+	NewIDs bool
+
+	// Organisations maps organisation IDs of the snapshot to the organisation IDs
+	// they are restored in. OrganisationID, if set, is used for the other accounts.
+	Organisations  map[string]string
+	OrganisationID string
+
+	// Existing is RestoreSkip, the default, or RestoreOverwrite.
+	Existing string
+}
+
+// RestoreChange is what Restore did with an account of the snapshot.
+type RestoreChange struct {
+	// ID in the snapshot and ID it was restored with.
+	SnapshotID string `json:"snapshot_id"`
+	ID         string `json:"id"`
+
+	OrganisationID string `json:"organisation_id"`
+	Action         string `json:"action"`
+}
+
+// RestoreSummary lists the changes made by Restore, in the order of the snapshot.
+type RestoreSummary struct {
+	Created     int             `json:"created"`
+	Overwritten int             `json:"overwritten"`
+	Skipped     int             `json:"skipped"`
+	Changes     []RestoreChange `json:"changes"`
+}
+
+// Restore recreates the accounts of a snapshot written by Snapshot, with their IDs and
+// organisations remapped by the options. The whole snapshot is read and checked before
+// any account is created, so a cut or corrupted snapshot changes nothing. Accounts are
+// created with their attributes and details; the version and timestamps are set by the
+// server. It stops at the first account that fails and returns the changes made so far.
+// If an overwritten account fails to be created, e.g. because the server rejects it, the
+// existing account it replaces is created again, with a new version and timestamps.
+func (c *Client) Restore(ctx context.Context, r io.Reader, opt RestoreOptions) (RestoreSummary, error) {
+	summary := RestoreSummary{Changes: []RestoreChange{}}
+
+	if opt.Existing == "" {
+		opt.Existing = RestoreSkip
+	}
+	if opt.Existing != RestoreSkip && opt.Existing != RestoreOverwrite {
+		return summary, fmt.Errorf("unknown restore mode '%s'", opt.Existing)
+	}
+
+	accounts, err := readSnapshot(r)
+	if err != nil {
+		return summary, err
+	}
+
+	for i := range accounts {
+		if err := ctx.Err(); err != nil {
+			return summary, err
+		}
+
+		change, err := c.restoreAccount(ctx, &accounts[i], &opt)
+		if err != nil {
+			return summary, fmt.Errorf("restoring account '%s': %w", accounts[i].ID, err)
+		}
+
+		switch change.Action {
+		case RestoreCreated:
+			summary.Created++
+		case RestoreOverwritten:
+			summary.Overwritten++
+		case RestoreSkipped:
+			summary.Skipped++
+		}
+		summary.Changes = append(summary.Changes, change)
+	}
+	return summary, nil
+}
+
+func (c *Client) restoreAccount(ctx context.Context, d *Data, opt *RestoreOptions) (RestoreChange, error) {
+	change := RestoreChange{SnapshotID: d.ID, ID: d.ID, OrganisationID: d.OrganisationID}
+	if id, ok := opt.IDs[d.ID]; ok {
+		change.ID = id
+	} else if opt.NewIDs {
+		change.ID = uuid.New().String()
+	}
+	if id, ok := opt.Organisations[d.OrganisationID]; ok {
+		change.OrganisationID = id
+	} else if opt.OrganisationID != "" {
+		change.OrganisationID = opt.OrganisationID
+	}
+
+	data := *d
+	data.Details = Details{Type: d.Type, ID: change.ID, OrganisationID: change.OrganisationID}
+	account := &Account{Data: &data}
+
+	_, err := c.CreateAccount(ctx, account)
+	var duplicate *DuplicateAccountError
+	switch {
+	case err == nil:
+		change.Action = RestoreCreated
+		return change, nil
+	case !errors.As(err, &duplicate):
+		return change, err
+	case opt.Existing == RestoreSkip:
+		change.Action = RestoreSkipped
+		return change, nil
+	}
+
+	existing, err := c.FetchAccount(ctx, change.ID)
+	if err != nil {
+		return change, err
+	}
+	version := 0
+	if existing.Data != nil {
+		version = existing.Data.Version
+		ctx = WithAuditOrganisation(ctx, existing.Data.OrganisationID)
+	}
+	if err := c.DeleteAccount(ctx, change.ID, version); err != nil {
+		return change, err
+	}
+	if _, err := c.CreateAccount(ctx, account); err != nil {
+		return change, c.restoreExisting(ctx, existing.Data, err)
+	}
+	change.Action = RestoreOverwritten
+	return change, nil
+}
+
+// restoreExisting creates the existing account deleted to be replaced again, after the
+// account replacing it failed to be created with err, so the account isn't lost. It's
+// used by overwrites of Restore and recreates of Apply.
+func (c *Client) restoreExisting(ctx context.Context, existing *Data, err error) error {
+	if existing == nil {
+		return fmt.Errorf("%w; the existing account was deleted", err)
+	}
+
+	data := *existing
+	data.Details = Details{Type: existing.Type, ID: existing.ID, OrganisationID: existing.OrganisationID}
+	if _, createErr := c.CreateAccount(ctx, &Account{Data: &data}); createErr != nil {
+		return fmt.Errorf("%w; the existing account was deleted and failed to be created again: %v", err, createErr)
+	}
+	return fmt.Errorf("%w; the existing account was created again", err)
+}
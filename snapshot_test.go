@@ -0,0 +1,246 @@
+package accountapi_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/alexdreptu/form3-accountapi-client"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSnapshotTestAccounts returns n valid accounts, with an attribute the client doesn't model
+func newSnapshotTestAccounts(t *testing.T, n int) []Data {
+	accounts := []Data{}
+	for i := 0; i < n; i++ {
+		account := newValidateTestAccount(t)
+		data, err := json.Marshal(account.Data.Attributes)
+		require.NoError(t, err)
+		data = append(data[:len(data)-1], `,"iban": "GB11NWBK40030041426819"}`...)
+		require.NoError(t, json.Unmarshal(data, account.Data.Attributes))
+		account.Data.Version = i % 3
+		account.Data.CreatedOn = "2020-06-01T10:00:00Z"
+		accounts = append(accounts, *account.Data)
+	}
+	return accounts
+}
+
+func snapshotTestContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func TestSnapshot(t *testing.T) {
+	source := newTestAccountServer(t, newSnapshotTestAccounts(t, 150)...)
+	client := NewClient(&http.Client{}, source.URL)
+
+	var snapshot bytes.Buffer
+	count, err := client.Snapshot(snapshotTestContext(t), &snapshot)
+	require.NoError(t, err)
+	assert.Equal(t, 150, count)
+
+	// every account is written if the server serves smaller pages
+	source.maxPageSize = 40
+	count, err = client.Snapshot(snapshotTestContext(t), ioutil.Discard)
+	require.NoError(t, err)
+	assert.Equal(t, 150, count)
+	source.maxPageSize = 0
+
+	// a gzip compressed file of JSON lines, with a versioned header
+	zr, err := gzip.NewReader(bytes.NewReader(snapshot.Bytes()))
+	require.NoError(t, err)
+	decoder := json.NewDecoder(zr)
+	var header struct {
+		Header struct {
+			Format  string `json:"format"`
+			Version int    `json:"version"`
+		} `json:"header"`
+	}
+	require.NoError(t, decoder.Decode(&header))
+	assert.Equal(t, "accountapi-snapshot", header.Header.Format)
+	assert.Equal(t, SnapshotVersion, header.Header.Version)
+
+	// restored into an empty environment
+	target := newTestAccountServer(t)
+	client.BaseURL = target.URL
+	summary, err := client.Restore(snapshotTestContext(t), bytes.NewReader(snapshot.Bytes()), RestoreOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, 150, summary.Created)
+	require.Len(t, summary.Changes, 150)
+	assert.Equal(t, RestoreChange{
+		SnapshotID:     summary.Changes[0].SnapshotID,
+		ID:             summary.Changes[0].SnapshotID,
+		OrganisationID: summary.Changes[0].OrganisationID,
+		Action:         RestoreCreated,
+	}, summary.Changes[0])
+
+	sourceAccounts, targetAccounts := source.sorted(), target.sorted()
+	require.Len(t, targetAccounts, 150)
+	for i := range sourceAccounts {
+		assert.Equal(t, sourceAccounts[i].ID, targetAccounts[i].ID)
+		assert.Equal(t, sourceAccounts[i].OrganisationID, targetAccounts[i].OrganisationID)
+		assert.Equal(t, sourceAccounts[i].Attributes.BankID, targetAccounts[i].Attributes.BankID)
+		assert.Equal(t, sourceAccounts[i].Attributes.UnknownFields(), targetAccounts[i].Attributes.UnknownFields())
+		assert.Zero(t, targetAccounts[i].Version)
+	}
+}
+
+func TestRestore_Remap(t *testing.T) {
+	accounts := newSnapshotTestAccounts(t, 3)
+	organisation := uuid.New().String()
+	accounts[1].OrganisationID = organisation
+
+	source := newTestAccountServer(t, accounts...)
+	client := NewClient(&http.Client{}, source.URL)
+	var snapshot bytes.Buffer
+	_, err := client.Snapshot(snapshotTestContext(t), &snapshot)
+	require.NoError(t, err)
+
+	newID, newOrganisation, defaultOrganisation := uuid.New().String(), uuid.New().String(), uuid.New().String()
+	summary, err := client.Restore(snapshotTestContext(t), bytes.NewReader(snapshot.Bytes()), RestoreOptions{
+		IDs:            map[string]string{accounts[0].ID: newID},
+		NewIDs:         true,
+		Organisations:  map[string]string{organisation: newOrganisation},
+		OrganisationID: defaultOrganisation,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, summary.Created)
+	assert.Len(t, source.sorted(), 6)
+
+	for _, change := range summary.Changes {
+		assert.NotEqual(t, change.SnapshotID, change.ID)
+		switch change.SnapshotID {
+		case accounts[0].ID:
+			assert.Equal(t, newID, change.ID)
+			assert.Equal(t, defaultOrganisation, change.OrganisationID)
+		case accounts[1].ID:
+			assert.Equal(t, newOrganisation, change.OrganisationID)
+		}
+	}
+}
+
+func TestRestore_Existing(t *testing.T) {
+	accounts := newSnapshotTestAccounts(t, 3)
+	server := newTestAccountServer(t, accounts...)
+	client := NewClient(&http.Client{}, server.URL)
+
+	var snapshot bytes.Buffer
+	_, err := client.Snapshot(snapshotTestContext(t), &snapshot)
+	require.NoError(t, err)
+
+	// one account was deleted and one was changed since the snapshot
+	sorted := server.sorted()
+	delete(server.accounts, sorted[0].ID)
+	changed := server.accounts[sorted[1].ID]
+	changed.Version = 4
+	changed.Attributes = &Attributes{Country: CountryUnitedKingdom, BankID: "000000"}
+	server.accounts[sorted[1].ID] = changed
+	server.requests = nil
+
+	summary, err := client.Restore(snapshotTestContext(t), bytes.NewReader(snapshot.Bytes()), RestoreOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, RestoreSummary{Created: 1, Skipped: 2, Changes: summary.Changes}, summary)
+	assert.Equal(t, "000000", server.accounts[sorted[1].ID].Attributes.BankID)
+
+	server.requests = nil
+	summary, err = client.Restore(snapshotTestContext(t), bytes.NewReader(snapshot.Bytes()), RestoreOptions{
+		Existing: RestoreOverwrite,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, summary.Overwritten)
+	assert.Equal(t, sorted[1].Attributes.BankID, server.accounts[sorted[1].ID].Attributes.BankID)
+	assert.Contains(t, server.requests, "DELETE "+sorted[1].ID)
+
+	// an account the server rejects doesn't replace the existing one, which is created again
+	existing := server.accounts[sorted[0].ID]
+	attributes := *existing.Attributes
+	attributes.BankID = "000001"
+	existing.Attributes = &attributes
+	server.accounts[sorted[0].ID] = existing
+	server.reject = func(d *Data) string {
+		if d.ID == sorted[0].ID && d.Attributes.BankID != "000001" {
+			return "validation failure list:\nbank_id in body is not allowed"
+		}
+		return ""
+	}
+	server.requests = nil
+	summary, err = client.Restore(snapshotTestContext(t), bytes.NewReader(snapshot.Bytes()), RestoreOptions{
+		Existing: RestoreOverwrite,
+	})
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr), err)
+	assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+	assert.Contains(t, err.Error(), "the existing account was created again")
+	assert.Equal(t, 0, summary.Overwritten)
+	assert.Empty(t, summary.Changes)
+	assert.Equal(t, []string{"POST", "DELETE " + sorted[0].ID, "POST", "POST"}, server.requests)
+	assert.Equal(t, "000001", server.accounts[sorted[0].ID].Attributes.BankID)
+	server.reject = nil
+
+	_, err = client.Restore(snapshotTestContext(t), bytes.NewReader(snapshot.Bytes()), RestoreOptions{Existing: "merge"})
+	assert.Error(t, err)
+}
+
+func TestRestore_InvalidSnapshot(t *testing.T) {
+	server := newTestAccountServer(t, newSnapshotTestAccounts(t, 5)...)
+	client := NewClient(&http.Client{}, server.URL)
+
+	var snapshot bytes.Buffer
+	_, err := client.Snapshot(snapshotTestContext(t), &snapshot)
+	require.NoError(t, err)
+
+	target := newTestAccountServer(t)
+	client.BaseURL = target.URL
+	restore := func(data []byte) error {
+		_, err := client.Restore(snapshotTestContext(t), bytes.NewReader(data), RestoreOptions{})
+		return err
+	}
+
+	// nothing is restored from a cut snapshot
+	err = restore(snapshot.Bytes()[:snapshot.Len()-20])
+	assert.True(t, errors.Is(err, ErrSnapshotTruncated), err)
+	assert.Empty(t, target.sorted())
+
+	// the lines are complete but the trailer is missing
+	lines := decompressSnapshot(t, snapshot.Bytes())
+	assert.True(t, errors.Is(restore(compressSnapshot(t, lines[:len(lines)-1])), ErrSnapshotTruncated))
+	assert.True(t, errors.Is(restore(compressSnapshot(t, append(lines[:2:2], lines[len(lines)-1]))), ErrSnapshotTruncated))
+
+	newer := strings.Replace(lines[0], `"version":1`, `"version":2`, 1)
+	require.NotEqual(t, lines[0], newer)
+	err = restore(compressSnapshot(t, append([]string{newer}, lines[1:]...)))
+	assert.True(t, errors.Is(err, ErrInvalidSnapshot))
+	assert.Contains(t, err.Error(), "unsupported version 2")
+
+	assert.True(t, errors.Is(restore([]byte("not gzip")), ErrInvalidSnapshot))
+	assert.True(t, errors.Is(restore(compressSnapshot(t, []string{`{"data": []}`})), ErrInvalidSnapshot))
+	assert.Empty(t, target.sorted())
+}
+
+func decompressSnapshot(t *testing.T, data []byte) []string {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	var b bytes.Buffer
+	_, err = b.ReadFrom(zr)
+	require.NoError(t, err)
+	return strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+}
+
+func compressSnapshot(t *testing.T, lines []string) []byte {
+	var b bytes.Buffer
+	zw := gzip.NewWriter(&b)
+	_, err := zw.Write([]byte(strings.Join(lines, "\n") + "\n"))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	return b.Bytes()
+}
@@ -0,0 +1,228 @@
+package accountapi
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync/atomic"
+	"time"
+)
+
+// TLSOptions configures the TLS connections of a Client, see NewTLSClient.
+type TLSOptions struct {
+	// PEM encoded client certificate and key files, for mutual TLS.
+	// Either both or none must be set.
+	CertFile string
+	KeyFile  string
+
+	// PEM encoded bundle of the CAs the server certificate is verified with.
+	// It replaces the system CAs, which are used if it's not set.
+	CAFile string
+
+	// Minimum TLS version, e.g. tls.VersionTLS13. Defaults to TLS 1.2.
+	MinVersion uint16
+
+	// ServerName overrides the name the server certificate is verified against,
+	// e.g. when the API is reached through an IP address or a proxy.
+	ServerName string
+
+	// ReloadInterval is how often, at most, the files are checked for changes.
+	// Defaults to 10 seconds.
+	ReloadInterval time.Duration
+}
+
+// how often the TLS files are checked for changes by default
+const defaultTLSReloadInterval = 10 * time.Second
+
+// NewTLSClient returns a Client whose connections are configured by the options.
+// The certificate, key and CA files are checked for changes at most every ReloadInterval
+// and reloaded when they change, so certificates can be rotated without restarting the process.
+func NewTLSClient(opt TLSOptions, baseURL ...string) (*Client, error) {
+	transport, err := NewTLSTransport(opt)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(&http.Client{Transport: transport}, baseURL...), nil
+}
+
+// NewTLSTransport returns an http.RoundTripper whose connections are configured by the options,
+// for callers that build their own http.Client. See NewTLSClient.
+func NewTLSTransport(opt TLSOptions) (http.RoundTripper, error) {
+	if (opt.CertFile == "") != (opt.KeyFile == "") {
+		return nil, ErrIncompleteKeyPair
+	}
+	if opt.ReloadInterval == 0 {
+		opt.ReloadInterval = defaultTLSReloadInterval
+	}
+
+	t := &tlsTransport{opt: opt, checked: time.Now().UnixNano()}
+	if err := t.reload(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// tlsFileStamp identifies a version of a file
+type tlsFileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// tlsTransport is an http.Transport whose client certificate is replaced when its files
+// change, and that is rebuilt when the CA file changes. Requests only load the current
+// transport and certificate; the request that finds the reload interval elapsed checks
+// the files, the others don't wait for it.
+type tlsTransport struct {
+	// time of the last check of the files in Unix nanoseconds, first for its
+	// 64-bit alignment
+	checked  int64
+	checking int32 // 1 while a request checks the files
+
+	opt TLSOptions
+
+	transport atomic.Value // *http.Transport
+	cert      atomic.Value // *tls.Certificate
+
+	// only used by the request that checks the files
+	stamps map[string]tlsFileStamp
+	ca     []byte
+}
+
+func (t *tlsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.check()
+	return t.transport.Load().(*http.Transport).RoundTrip(req)
+}
+
+// CloseIdleConnections closes the idle connections of the current transport, see
+// http.Client.CloseIdleConnections
+func (t *tlsTransport) CloseIdleConnections() {
+	t.transport.Load().(*http.Transport).CloseIdleConnections()
+}
+
+// check reloads the files if they changed, unless they were checked less than the reload
+// interval ago or are being checked by another request
+func (t *tlsTransport) check() {
+	now := time.Now().UnixNano()
+	if now-atomic.LoadInt64(&t.checked) < int64(t.opt.ReloadInterval) {
+		return
+	}
+	if !atomic.CompareAndSwapInt32(&t.checking, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&t.checking, 0)
+	atomic.StoreInt64(&t.checked, now)
+
+	if t.modified() {
+		// a failed reload keeps the current files, e.g. while the certificate
+		// has been replaced but the key not yet, and is tried again next time
+		_ = t.reload()
+	}
+}
+
+// files returns the TLS files that are set
+func (t *tlsTransport) files() []string {
+	files := []string{}
+	for _, file := range []string{t.opt.CertFile, t.opt.KeyFile, t.opt.CAFile} {
+		if file != "" {
+			files = append(files, file)
+		}
+	}
+	return files
+}
+
+// modified reports if any of the files changed since they were loaded
+func (t *tlsTransport) modified() bool {
+	for _, file := range t.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			continue
+		}
+		stamp := t.stamps[file]
+		if !stamp.modTime.Equal(info.ModTime()) || stamp.size != info.Size() {
+			return true
+		}
+	}
+	return false
+}
+
+// reload loads the files, it replaces the certificate and rebuilds the transport if the CAs changed.
+// The idle connections are closed, so the next requests are made with the new files.
+func (t *tlsTransport) reload() error {
+	stamps := map[string]tlsFileStamp{}
+	for _, file := range t.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return err
+		}
+		stamps[file] = tlsFileStamp{info.ModTime(), info.Size()}
+	}
+
+	cert := &tls.Certificate{}
+	if t.opt.CertFile != "" {
+		loaded, err := tls.LoadX509KeyPair(t.opt.CertFile, t.opt.KeyFile)
+		if err != nil {
+			return err
+		}
+		cert = &loaded
+	}
+
+	var ca []byte
+	if t.opt.CAFile != "" {
+		var err error
+		if ca, err = ioutil.ReadFile(t.opt.CAFile); err != nil {
+			return err
+		}
+	}
+
+	current, _ := t.transport.Load().(*http.Transport)
+	if current == nil || !bytes.Equal(ca, t.ca) {
+		config, err := newTLSConfig(t.opt, ca)
+		if err != nil {
+			return err
+		}
+		config.GetClientCertificate = t.clientCertificate
+
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = config
+		t.transport.Store(transport)
+	}
+
+	t.cert.Store(cert)
+	if current != nil {
+		current.CloseIdleConnections()
+	}
+	t.stamps, t.ca = stamps, ca
+	return nil
+}
+
+// clientCertificate returns the current client certificate, an empty one if the
+// options have none, so no certificate is sent
+func (t *tlsTransport) clientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return t.cert.Load().(*tls.Certificate), nil
+}
+
+// newTLSConfig returns the configuration of the options, with the CAs of the bundle if it's set
+func newTLSConfig(opt TLSOptions, bundle []byte) (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: opt.ServerName,
+	}
+
+	if opt.MinVersion != 0 {
+		config.MinVersion = opt.MinVersion
+	}
+
+	if opt.CAFile != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(bundle) {
+			return nil, fmt.Errorf("%s: %w", opt.CAFile, ErrNoCertificates)
+		}
+		config.RootCAs = pool
+	}
+
+	return config, nil
+}
@@ -0,0 +1,317 @@
+package accountapi_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"log"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/alexdreptu/form3-accountapi-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCert is a certificate and its key, signed by a CA or self-signed
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	der  []byte
+}
+
+func newTestCert(t *testing.T, template *x509.Certificate, parent *testCert) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+	template.SerialNumber = serial
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+
+	signer, signerKey := template, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCert{cert: cert, key: key, der: der}
+}
+
+func newTestCA(t *testing.T, name string) *testCert {
+	return newTestCert(t, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: name},
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}, nil)
+}
+
+func newTestServerCert(t *testing.T, ca *testCert, dnsName string) *testCert {
+	return newTestCert(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: dnsName},
+		DNSNames:    []string{dnsName},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, ca)
+}
+
+func newTestClientCert(t *testing.T, ca *testCert, name string) *testCert {
+	return newTestCert(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: name},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, ca)
+}
+
+func (c *testCert) tlsCertificate() tls.Certificate {
+	return tls.Certificate{Certificate: [][]byte{c.der}, PrivateKey: c.key}
+}
+
+// writeFiles writes the certificate and the key in PEM files, with a new modification time
+func (c *testCert) writeFiles(t *testing.T, certFile, keyFile string) {
+	writePEM(t, certFile, "CERTIFICATE", c.der)
+	if keyFile != "" {
+		key, err := x509.MarshalECPrivateKey(c.key)
+		require.NoError(t, err)
+		writePEM(t, keyFile, "EC PRIVATE KEY", key)
+	}
+}
+
+func writePEM(t *testing.T, file, blockType string, der []byte) {
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	require.NoError(t, ioutil.WriteFile(file, data, 0600))
+
+	// make sure the change is seen even on file systems with coarse timestamps
+	info, err := os.Stat(file)
+	require.NoError(t, err)
+	modTime := info.ModTime().Add(time.Second)
+	require.NoError(t, os.Chtimes(file, modTime, modTime))
+}
+
+// newTestTLSServer starts a server with the certificate that answers with the common name
+// of the client certificate. Client certificates are required if clientCA is set.
+func newTestTLSServer(t *testing.T, cert *testCert, clientCA *testCert) *httptest.Server {
+	return newTestTLSServerVersion(t, cert, clientCA, 0)
+}
+
+// newTestTLSServerVersion is newTestTLSServer with a maximum TLS version
+func newTestTLSServerVersion(t *testing.T, cert *testCert, clientCA *testCert, maxVersion uint16) *httptest.Server {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			name := ""
+			if len(r.TLS.PeerCertificates) != 0 {
+				name = r.TLS.PeerCertificates[0].Subject.CommonName
+			}
+			_, _ = w.Write([]byte(`{"data": {"attributes": {"customer_id": "` + name + `"}}}`))
+		},
+	))
+	// the handshake errors the tests cause are expected
+	server.Config.ErrorLog = log.New(ioutil.Discard, "", 0)
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{cert.tlsCertificate()},
+		MaxVersion:   maxVersion,
+	}
+	if clientCA != nil {
+		pool := x509.NewCertPool()
+		pool.AddCert(clientCA.cert)
+		server.TLS.ClientCAs = pool
+		server.TLS.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	return server
+}
+
+func tempTLSDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "tls")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
+
+// fetchClientName fetches an account and returns the client certificate name the server saw
+func fetchClientName(client *Client) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	account, err := client.FetchAccount(ctx, "ad27e265-9605-4b4b-a0e5-3003ea9cc4dc")
+	if err != nil {
+		return "", err
+	}
+	return account.Data.Attributes.CustomerID, nil
+}
+
+func TestNewTLSClient_MutualTLS(t *testing.T) {
+	dir := tempTLSDir(t)
+	ca := newTestCA(t, "Test CA")
+	server := newTestTLSServer(t, newTestServerCert(t, ca, "accountapi.test"), ca)
+
+	opt := TLSOptions{
+		CertFile:   filepath.Join(dir, "client.pem"),
+		KeyFile:    filepath.Join(dir, "client-key.pem"),
+		CAFile:     filepath.Join(dir, "ca.pem"),
+		ServerName: "accountapi.test",
+
+		// the files are checked before every request
+		ReloadInterval: time.Nanosecond,
+	}
+	ca.writeFiles(t, opt.CAFile, "")
+	newTestClientCert(t, ca, "client-1").writeFiles(t, opt.CertFile, opt.KeyFile)
+
+	client, err := NewTLSClient(opt, server.URL)
+	require.NoError(t, err)
+	name, err := fetchClientName(client)
+	require.NoError(t, err)
+	assert.Equal(t, "client-1", name)
+
+	// the rotated certificate is used without a new client
+	newTestClientCert(t, ca, "client-2").writeFiles(t, opt.CertFile, opt.KeyFile)
+	name, err = fetchClientName(client)
+	require.NoError(t, err)
+	assert.Equal(t, "client-2", name)
+
+	// a certificate that doesn't match the key is not loaded
+	newTestClientCert(t, ca, "client-3").writeFiles(t, opt.CertFile, "")
+	name, err = fetchClientName(client)
+	require.NoError(t, err)
+	assert.Equal(t, "client-2", name)
+
+	// without a client certificate the server rejects the connection
+	client, err = NewTLSClient(TLSOptions{CAFile: opt.CAFile, ServerName: opt.ServerName}, server.URL)
+	require.NoError(t, err)
+	_, err = fetchClientName(client)
+	assert.Error(t, err)
+}
+
+func TestNewTLSClient_CA(t *testing.T) {
+	dir := tempTLSDir(t)
+	ca1, ca2 := newTestCA(t, "Test CA 1"), newTestCA(t, "Test CA 2")
+	server1 := newTestTLSServer(t, newTestServerCert(t, ca1, "accountapi.test"), nil)
+	server2 := newTestTLSServer(t, newTestServerCert(t, ca2, "accountapi.test"), nil)
+
+	opt := TLSOptions{
+		CAFile:         filepath.Join(dir, "ca.pem"),
+		ServerName:     "accountapi.test",
+		ReloadInterval: time.Nanosecond,
+	}
+	ca1.writeFiles(t, opt.CAFile, "")
+
+	client, err := NewTLSClient(opt, server1.URL)
+	require.NoError(t, err)
+	_, err = fetchClientName(client)
+	require.NoError(t, err)
+
+	client.BaseURL = server2.URL
+	_, err = fetchClientName(client)
+	var unknownAuthority x509.UnknownAuthorityError
+	assert.True(t, errors.As(err, &unknownAuthority), err)
+
+	// the rotated CA bundle is used without a new client
+	ca2.writeFiles(t, opt.CAFile, "")
+	_, err = fetchClientName(client)
+	require.NoError(t, err)
+}
+
+func TestNewTLSClient_ReloadInterval(t *testing.T) {
+	dir := tempTLSDir(t)
+	ca := newTestCA(t, "Test CA")
+	server := newTestTLSServer(t, newTestServerCert(t, ca, "accountapi.test"), ca)
+
+	opt := TLSOptions{
+		CertFile:       filepath.Join(dir, "client.pem"),
+		KeyFile:        filepath.Join(dir, "client-key.pem"),
+		CAFile:         filepath.Join(dir, "ca.pem"),
+		ServerName:     "accountapi.test",
+		ReloadInterval: time.Hour,
+	}
+	ca.writeFiles(t, opt.CAFile, "")
+	newTestClientCert(t, ca, "client-1").writeFiles(t, opt.CertFile, opt.KeyFile)
+
+	client, err := NewTLSClient(opt, server.URL)
+	require.NoError(t, err)
+
+	// the files are not checked again before the interval elapsed
+	newTestClientCert(t, ca, "client-2").writeFiles(t, opt.CertFile, opt.KeyFile)
+	client.Client.CloseIdleConnections()
+	name, err := fetchClientName(client)
+	require.NoError(t, err)
+	assert.Equal(t, "client-1", name)
+}
+
+func TestNewTLSClient_ServerName(t *testing.T) {
+	dir := tempTLSDir(t)
+	ca := newTestCA(t, "Test CA")
+	server := newTestTLSServer(t, newTestServerCert(t, ca, "accountapi.test"), nil)
+
+	caFile := filepath.Join(dir, "ca.pem")
+	ca.writeFiles(t, caFile, "")
+
+	// the certificate is not valid for the IP address of the server
+	client, err := NewTLSClient(TLSOptions{CAFile: caFile}, server.URL)
+	require.NoError(t, err)
+	_, err = fetchClientName(client)
+	var hostnameErr x509.HostnameError
+	assert.True(t, errors.As(err, &hostnameErr), err)
+
+	client, err = NewTLSClient(TLSOptions{CAFile: caFile, ServerName: "accountapi.test"}, server.URL)
+	require.NoError(t, err)
+	_, err = fetchClientName(client)
+	assert.NoError(t, err)
+}
+
+func TestNewTLSClient_MinVersion(t *testing.T) {
+	dir := tempTLSDir(t)
+	ca := newTestCA(t, "Test CA")
+	server := newTestTLSServerVersion(t, newTestServerCert(t, ca, "accountapi.test"), nil, tls.VersionTLS12)
+
+	caFile := filepath.Join(dir, "ca.pem")
+	ca.writeFiles(t, caFile, "")
+
+	client, err := NewTLSClient(TLSOptions{CAFile: caFile, ServerName: "accountapi.test"}, server.URL)
+	require.NoError(t, err)
+	_, err = fetchClientName(client)
+	require.NoError(t, err)
+
+	client, err = NewTLSClient(TLSOptions{
+		CAFile:     caFile,
+		ServerName: "accountapi.test",
+		MinVersion: tls.VersionTLS13,
+	}, server.URL)
+	require.NoError(t, err)
+	_, err = fetchClientName(client)
+	assert.Error(t, err)
+}
+
+func TestNewTLSClient_InvalidOptions(t *testing.T) {
+	dir := tempTLSDir(t)
+
+	_, err := NewTLSClient(TLSOptions{CertFile: filepath.Join(dir, "client.pem")})
+	assert.True(t, errors.Is(err, ErrIncompleteKeyPair))
+
+	_, err = NewTLSClient(TLSOptions{CAFile: filepath.Join(dir, "missing.pem")})
+	assert.Error(t, err)
+
+	caFile := filepath.Join(dir, "ca.pem")
+	require.NoError(t, ioutil.WriteFile(caFile, []byte("not a certificate"), 0600))
+	_, err = NewTLSClient(TLSOptions{CAFile: caFile})
+	assert.True(t, errors.Is(err, ErrNoCertificates))
+
+	_, err = NewTLSClient(TLSOptions{})
+	assert.NoError(t, err)
+}
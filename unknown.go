@@ -0,0 +1,234 @@
+package accountapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// JSON names of the modelled members, by type
+var (
+	attributesFields = jsonFieldNames(reflect.TypeOf(Attributes{}))
+	dataFields       = jsonFieldNames(reflect.TypeOf(Data{}))
+	accountFields    = jsonFieldNames(reflect.TypeOf(Account{}))
+	accountsFields   = jsonFieldNames(reflect.TypeOf(Accounts{}))
+)
+
+// UnmarshalJSON decodes the attributes and keeps the attributes that are not
+// modelled, e.g. 'iban', see UnknownFields.
+func (a *Attributes) UnmarshalJSON(data []byte) error {
+	type attributes Attributes
+	var decoded attributes
+	unknown, err := unmarshalUnknown(data, &decoded, attributesFields)
+	if err != nil {
+		return err
+	}
+	*a = Attributes(decoded)
+	a.unknown = unknown
+	return nil
+}
+
+// MarshalJSON encodes the attributes, with the unknown attributes unchanged.
+func (a Attributes) MarshalJSON() ([]byte, error) {
+	type attributes Attributes
+	return marshalUnknown(attributes(a), a.unknown)
+}
+
+// UnknownFields returns the attributes that are not modelled by Attributes, as they were received.
+func (a *Attributes) UnknownFields() map[string]json.RawMessage {
+	return copyUnknown(a.unknown)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON:API members that are not
+// modelled, e.g. 'relationships' and 'meta', see UnknownFields.
+func (d *Data) UnmarshalJSON(data []byte) error {
+	type resource Data
+	var decoded resource
+	unknown, err := unmarshalUnknown(data, &decoded, dataFields)
+	if err != nil {
+		return err
+	}
+	*d = Data(decoded)
+	d.unknown = unknown
+	return nil
+}
+
+// MarshalJSON encodes the resource, with the unknown members unchanged.
+func (d Data) MarshalJSON() ([]byte, error) {
+	type resource Data
+	return marshalUnknown(resource(d), d.unknown)
+}
+
+// UnknownFields returns the members of the resource that are not modelled by Data, as they were received.
+func (d *Data) UnknownFields() map[string]json.RawMessage {
+	return copyUnknown(d.unknown)
+}
+
+// Relationships returns the 'relationships' member of the resource, nil if there is none.
+func (d *Data) Relationships() json.RawMessage {
+	return d.unknown["relationships"]
+}
+
+// Meta returns the 'meta' member of the resource, nil if there is none.
+func (d *Data) Meta() json.RawMessage {
+	return d.unknown["meta"]
+}
+
+// UnmarshalJSON decodes the document and keeps the JSON:API members that are not
+// modelled, e.g. 'meta' and 'included', see UnknownFields.
+func (a *Account) UnmarshalJSON(data []byte) error {
+	type document Account
+	var decoded document
+	unknown, err := unmarshalUnknown(data, &decoded, accountFields)
+	if err != nil {
+		return err
+	}
+	*a = Account(decoded)
+	a.unknown = unknown
+	return nil
+}
+
+// MarshalJSON encodes the document, with the unknown members unchanged.
+func (a Account) MarshalJSON() ([]byte, error) {
+	type document Account
+	return marshalUnknown(document(a), a.unknown)
+}
+
+// UnknownFields returns the members of the document that are not modelled by Account, as they were received.
+func (a *Account) UnknownFields() map[string]json.RawMessage {
+	return copyUnknown(a.unknown)
+}
+
+// Meta returns the top-level 'meta' member, nil if there is none.
+func (a *Account) Meta() json.RawMessage {
+	return a.unknown["meta"]
+}
+
+// Included returns the top-level 'included' member, nil if there is none.
+func (a *Account) Included() json.RawMessage {
+	return a.unknown["included"]
+}
+
+// UnmarshalJSON decodes the document and keeps the JSON:API members that are not
+// modelled, e.g. 'meta', see UnknownFields.
+func (a *Accounts) UnmarshalJSON(data []byte) error {
+	type document Accounts
+	var decoded document
+	unknown, err := unmarshalUnknown(data, &decoded, accountsFields)
+	if err != nil {
+		return err
+	}
+	*a = Accounts(decoded)
+	a.unknown = unknown
+	return nil
+}
+
+// MarshalJSON encodes the document, with the unknown members unchanged.
+func (a Accounts) MarshalJSON() ([]byte, error) {
+	type document Accounts
+	return marshalUnknown(document(a), a.unknown)
+}
+
+// UnknownFields returns the members of the document that are not modelled by Accounts, as they were received.
+func (a *Accounts) UnknownFields() map[string]json.RawMessage {
+	return copyUnknown(a.unknown)
+}
+
+// Meta returns the top-level 'meta' member, nil if there is none.
+func (a *Accounts) Meta() json.RawMessage {
+	return a.unknown["meta"]
+}
+
+// unmarshalUnknown decodes data into v and returns the members of the object v doesn't model,
+// nil if there are none. encoding/json matches the names case insensitively, so does the lookup.
+func unmarshalUnknown(data []byte, v interface{}, known map[string]bool) (map[string]json.RawMessage, error) {
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil {
+		return nil, err
+	}
+
+	var unknown map[string]json.RawMessage
+	for name, value := range members {
+		if known[strings.ToLower(name)] {
+			continue
+		}
+		if unknown == nil {
+			unknown = map[string]json.RawMessage{}
+		}
+		unknown[name] = value
+	}
+	return unknown, nil
+}
+
+// marshalUnknown encodes v and appends the unknown members to the object, sorted by name
+func marshalUnknown(v interface{}, unknown map[string]json.RawMessage) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(unknown) == 0 {
+		return data, err
+	}
+
+	names := make([]string, 0, len(unknown))
+	for name := range unknown {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b bytes.Buffer
+	b.Write(data[:len(data)-1])
+	for i, name := range names {
+		if i != 0 || len(data) > len("{}") {
+			b.WriteByte(',')
+		}
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		b.Write(key)
+		b.WriteByte(':')
+		b.Write(unknown[name])
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
+
+func copyUnknown(unknown map[string]json.RawMessage) map[string]json.RawMessage {
+	copied := make(map[string]json.RawMessage, len(unknown))
+	for name, value := range unknown {
+		copied[name] = append(json.RawMessage(nil), value...)
+	}
+	return copied
+}
+
+// jsonFieldNames returns the lower case JSON names of the exported fields of a struct type,
+// including the fields of embedded structs
+func jsonFieldNames(t reflect.Type) map[string]bool {
+	names := map[string]bool{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			for name := range jsonFieldNames(field.Type) {
+				names[name] = true
+			}
+			continue
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		names[strings.ToLower(name)] = true
+	}
+	return names
+}
@@ -0,0 +1,143 @@
+package accountapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/alexdreptu/form3-accountapi-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testUnknownAccount = `{
+  "data": {
+    "type": "accounts",
+    "id": "ad27e265-9605-4b4b-a0e5-3003ea9cc4dc",
+    "organisation_id": "eb0bd6f5-c3f5-44b2-b677-acd23cdde73c",
+    "attributes": {
+      "country": "GB",
+      "bank_id": "400300",
+      "bank_id_code": "GBDSC",
+      "bic": "NWBKGB22",
+      "iban": "GB11NWBK40030041426819",
+      "user_defined_data": [{"key": "Some account related key", "value": "Some account related value"}],
+      "validation_type": "card"
+    },
+    "relationships": {"master_account": {"data": [{"type": "accounts", "id": "a52d13a4-f435-4c00-cfad-f5e7ac5972df"}]}},
+    "meta": {"source": "import"}
+  },
+  "links": {"self": "/v1/organisation/accounts/ad27e265-9605-4b4b-a0e5-3003ea9cc4dc"},
+  "meta": {"request_id": "abc"},
+  "included": [{"type": "organisations", "id": "eb0bd6f5-c3f5-44b2-b677-acd23cdde73c"}]
+}`
+
+func TestAccount_UnknownFields(t *testing.T) {
+	var account Account
+	require.NoError(t, json.Unmarshal([]byte(testUnknownAccount), &account))
+
+	attributes := account.Data.Attributes
+	assert.Equal(t, CountryUnitedKingdom, attributes.Country)
+	assert.Equal(t, "NWBKGB22", attributes.BIC)
+	unknown := attributes.UnknownFields()
+	assert.Len(t, unknown, 3)
+	assert.JSONEq(t, `"GB11NWBK40030041426819"`, string(unknown["iban"]))
+	assert.JSONEq(t, `"card"`, string(unknown["validation_type"]))
+
+	assert.JSONEq(t, `{"master_account": {"data": [{"type": "accounts", "id": "a52d13a4-f435-4c00-cfad-f5e7ac5972df"}]}}`,
+		string(account.Data.Relationships()))
+	assert.JSONEq(t, `{"source": "import"}`, string(account.Data.Meta()))
+	assert.Len(t, account.Data.UnknownFields(), 2)
+
+	assert.JSONEq(t, `{"request_id": "abc"}`, string(account.Meta()))
+	assert.JSONEq(t, `[{"type": "organisations", "id": "eb0bd6f5-c3f5-44b2-b677-acd23cdde73c"}]`,
+		string(account.Included()))
+	assert.Len(t, account.UnknownFields(), 2)
+
+	// the accessors return copies
+	unknown["iban"][1] = 'X'
+	assert.JSONEq(t, `"GB11NWBK40030041426819"`, string(attributes.UnknownFields()["iban"]))
+
+	data, err := json.Marshal(&account)
+	require.NoError(t, err)
+	assert.JSONEq(t, testUnknownAccount, string(data))
+
+	// changes to the modelled attributes are kept with the unknown ones
+	attributes.BIC = "HBUKGB4B"
+	data, err = json.Marshal(account)
+	require.NoError(t, err)
+	var roundTrip Account
+	require.NoError(t, json.Unmarshal(data, &roundTrip))
+	assert.Equal(t, "HBUKGB4B", roundTrip.Data.Attributes.BIC)
+	for name, value := range attributes.UnknownFields() {
+		assert.JSONEq(t, string(value), string(roundTrip.Data.Attributes.UnknownFields()[name]), name)
+	}
+}
+
+func TestAccount_NoUnknownFields(t *testing.T) {
+	var attributes Attributes
+	require.NoError(t, json.Unmarshal([]byte(`{"Country": "GB", "bank_id": "400300"}`), &attributes))
+	assert.Equal(t, CountryUnitedKingdom, attributes.Country)
+	assert.Empty(t, attributes.UnknownFields())
+
+	data, err := json.Marshal(attributes)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"country": "GB", "bank_id": "400300"}`, string(data))
+
+	data, err = json.Marshal(Attributes{})
+	require.NoError(t, err)
+	assert.Equal(t, `{}`, string(data))
+
+	var account Account
+	require.NoError(t, json.Unmarshal([]byte(`{"data": {"attributes": {"iban": "X"}}}`), &account))
+	data, err = json.Marshal(account)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"data": {"attributes": {"iban": "X"}}}`, string(data))
+}
+
+func TestAccounts_UnknownFields(t *testing.T) {
+	list := `{"data": [{"id": "ad27e265-9605-4b4b-a0e5-3003ea9cc4dc", "attributes": {"iban": "X"}, "meta": {}}],
+		"links": {"self": "/v1/organisation/accounts"}, "meta": {"total": 1}}`
+
+	var accounts Accounts
+	require.NoError(t, json.Unmarshal([]byte(list), &accounts))
+	assert.JSONEq(t, `{"total": 1}`, string(accounts.Meta()))
+	assert.JSONEq(t, `"X"`, string(accounts.Data[0].Attributes.UnknownFields()["iban"]))
+	assert.JSONEq(t, `{}`, string(accounts.Data[0].Meta()))
+
+	data, err := json.Marshal(accounts)
+	require.NoError(t, err)
+	assert.JSONEq(t, list, string(data))
+}
+
+// TestAccount_UnknownFieldsRoundTrip checks a fetched account is created again without losing data
+func TestAccount_UnknownFieldsRoundTrip(t *testing.T) {
+	var created []byte
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost {
+				created, _ = ioutil.ReadAll(r.Body)
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write(created)
+				return
+			}
+			_, _ = w.Write([]byte(testUnknownAccount))
+		},
+	))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	client := NewClient(&http.Client{}, server.URL)
+	account, err := client.FetchAccount(ctx, "ad27e265-9605-4b4b-a0e5-3003ea9cc4dc")
+	require.NoError(t, err)
+
+	_, err = client.CreateAccount(ctx, &account)
+	require.NoError(t, err)
+	assert.JSONEq(t, testUnknownAccount, string(created))
+}
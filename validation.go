@@ -3,6 +3,7 @@ package accountapi
 import (
 	"regexp"
 	"strconv"
+	"unicode/utf8"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
@@ -34,16 +35,42 @@ const (
 	alternativeBankAccountNamesElemLengthStop   = 140
 )
 
+// Title attribute length
+const titleLengthStop = 40
+
+// Name attribute lengths
+const (
+	nameArrayLengthStart = 1
+	nameArrayLengthStop  = 4
+	nameElemLengthStart  = 1
+	nameElemLengthStop   = 140
+)
+
+// BankAccountName attribute length
+const bankAccountNameLengthStop = 140
+
+// AlternativeNames attribute lengths
+const (
+	alternativeNamesArrayLengthStart = 1
+	alternativeNamesArrayLengthStop  = 3
+	alternativeNamesElemLengthStart  = 1
+	alternativeNamesElemLengthStop   = 140
+)
+
+// SecondaryIdentification attribute length
+const secondaryIdentificationLengthStop = 140
+
 // BIC length range
 const (
 	BICLength8  = 8
 	BICLength11 = 11
 )
 
+// format of a BIC, in either 8 or 11 character format
+const bicPattern = "^([A-Z]{6}[A-Z0-9]{2}|[A-Z]{6}[A-Z0-9]{5})$"
+
 // general validation rules
-var validateBICMatch = validation.Match(
-	regexp.MustCompile("^([A-Z]{6}[A-Z0-9]{2}|[A-Z]{6}[A-Z0-9]{5})$"),
-)
+var validateBICMatch = validation.Match(regexp.MustCompile(bicPattern))
 
 var validateBICLength = validation.By(
 	func(value interface{}) error {
@@ -69,1400 +96,37 @@ var validateBaseCurrencyLength = validation.By(
 				MustLength: baseCurrencyLength,
 				Length:     length,
 			}
-		}
-		return nil
-	},
-)
-
-var validateStringNumber = validation.By(
-	func(value interface{}) error {
-		number, _ := value.(string)
-		if number != "" {
-			_, err := strconv.ParseInt(number, 10, 64)
-			if err != nil {
-				return &InvalidAccountNumberError{number}
-			}
-		}
-		return nil
-	},
-)
-
-func (a *Attributes) validateUnitedKingdom() error {
-	validateBankIDLength := validation.By(
-		func(value interface{}) error {
-			id, _ := value.(string)
-			length := len(id)
-			if length != BankIDLengthUnitedKingdom {
-				return &InvalidBankIDLengthError{
-					MustLength: BankIDLengthUnitedKingdom,
-					Length:     length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankID := []validation.Rule{
-		validation.Required,
-		validateBankIDLength,
-		validateStringNumber,
-	}
-
-	validateBIC := []validation.Rule{
-		validation.Required,
-		validateBICLength,
-		validateBICMatch,
-	}
-
-	validateBankIDCodeMatch := validation.By(
-		func(value interface{}) error {
-			code, _ := value.(string)
-			if code != BankIDCodeUnitedKingdom {
-				return &InvalidBankIDCodeError{
-					MustCode: BankIDCodeUnitedKingdom,
-					Code:     code,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankIDCode := []validation.Rule{
-		validation.Required,
-		is.Alpha,
-		validateBankIDCodeMatch,
-	}
-
-	validateAccountNumberLength := validation.By(
-		func(value interface{}) error {
-			number, _ := value.(string)
-			length := len(number)
-			if number != "" && length != AccountNumberLengthUnitedKingdom {
-				return &InvalidAccountNumberLengthError{
-					MustLength: AccountNumberLengthUnitedKingdom,
-					Length:     length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateAccountNumber := []validation.Rule{
-		validateAccountNumberLength,
-		validateStringNumber,
-	}
-
-	validateBaseCurrencyMatch := validation.By(
-		func(value interface{}) error {
-			currency, _ := value.(string)
-			if currency != "" && currency != CurrencyUnitedKingdom {
-				return &InvalidBaseCurrencyError{
-					MustCurrency: CurrencyUnitedKingdom,
-					Currency:     currency,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBaseCurrency := []validation.Rule{
-		validateBaseCurrencyLength,
-		is.Alpha,
-		validateBaseCurrencyMatch,
-	}
-
-	return validation.ValidateStruct(a,
-		validation.Field(&a.BankID, validateBankID...),
-		validation.Field(&a.BIC, validateBIC...),
-		validation.Field(&a.BankIDCode, validateBankIDCode...),
-		validation.Field(&a.AccountNumber, validateAccountNumber...),
-		validation.Field(&a.BaseCurrency, validateBaseCurrency...),
-	)
-}
-
-func (a *Attributes) validateAustralia() error {
-	validateBankIDLength := validation.By(
-		func(value interface{}) error {
-			id, _ := value.(string)
-			length := len(id)
-			if id != "" && length != BankIDLengthAustralia {
-				return &InvalidBankIDLengthError{
-					MustLength: BankIDLengthAustralia,
-					Length:     length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankID := []validation.Rule{
-		validateBankIDLength,
-		validateStringNumber,
-	}
-
-	validateBIC := []validation.Rule{
-		validation.Required,
-		validateBICLength,
-		validateBICMatch,
-	}
-
-	validateBankIDCodeMatch := validation.By(
-		func(value interface{}) error {
-			code, _ := value.(string)
-			if code != BankIDCodeAustralia {
-				return &InvalidBankIDCodeError{
-					MustCode: BankIDCodeAustralia,
-					Code:     code,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankIDCode := []validation.Rule{
-		validation.Required,
-		is.Alpha,
-		validateBankIDCodeMatch,
-	}
-
-	validateAccountNumberLength := validation.By(
-		func(value interface{}) error {
-			number, _ := value.(string)
-			length := len(number)
-			if number != "" &&
-				!(length >= AccountNumberLengthAustraliaStart &&
-					length <= AccountNumberLengthAustraliaStop) {
-				return &InvalidAccountNumberLengthError{
-					MustLengthFrom: AccountNumberLengthAustraliaStart,
-					MustLengthTo:   AccountNumberLengthAustraliaStop,
-					Length:         length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateAccoountNumberFirstCharacter := validation.By(
-		func(value interface{}) error {
-			number, _ := value.(string)
-			if number != "" && number[0] == '0' {
-				return ErrAccountNumberFirstCharZero
-			}
-			return nil
-		},
-	)
-
-	validateAccountNumber := []validation.Rule{
-		validateAccountNumberLength,
-		validateAccoountNumberFirstCharacter,
-		validateStringNumber,
-	}
-
-	validateBaseCurrencyMatch := validation.By(
-		func(value interface{}) error {
-			currency, _ := value.(string)
-			if currency != "" && currency != CurrencyAustralia {
-				return &InvalidBaseCurrencyError{
-					MustCurrency: CurrencyAustralia,
-					Currency:     currency,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBaseCurrency := []validation.Rule{
-		validateBaseCurrencyLength,
-		is.Alpha,
-		validateBaseCurrencyMatch,
-	}
-
-	return validation.ValidateStruct(a,
-		validation.Field(&a.BankID, validateBankID...),
-		validation.Field(&a.BIC, validateBIC...),
-		validation.Field(&a.BankIDCode, validateBankIDCode...),
-		validation.Field(&a.AccountNumber, validateAccountNumber...),
-		validation.Field(&a.BaseCurrency, validateBaseCurrency...),
-	)
-}
-
-func (a *Attributes) validateBelgium() error {
-	validateBankIDLength := validation.By(
-		func(value interface{}) error {
-			id, _ := value.(string)
-			length := len(id)
-			if length != BankIDLengthBelgium {
-				return &InvalidBankIDLengthError{
-					MustLength: BankIDLengthBelgium,
-					Length:     length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankID := []validation.Rule{
-		validation.Required,
-		validateBankIDLength,
-		validateStringNumber,
-	}
-
-	validateBIC := []validation.Rule{
-		validateBICLength,
-		validateBICMatch,
-	}
-
-	validateBankIDCodeMatch := validation.By(
-		func(value interface{}) error {
-			code, _ := value.(string)
-			if code != BankIDCodeBelgium {
-				return &InvalidBankIDCodeError{
-					MustCode: BankIDCodeBelgium,
-					Code:     code,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankIDCode := []validation.Rule{
-		validation.Required,
-		is.Alpha,
-		validateBankIDCodeMatch,
-	}
-
-	validateAccountNumberLength := validation.By(
-		func(value interface{}) error {
-			number, _ := value.(string)
-			length := len(number)
-			if number != "" && length != AccountNumberLengthBelgium {
-				return &InvalidAccountNumberLengthError{
-					MustLength: AccountNumberLengthBelgium,
-					Length:     length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateAccountNumber := []validation.Rule{
-		validateAccountNumberLength,
-		validateStringNumber,
-	}
-
-	validateBaseCurrencyMatch := validation.By(
-		func(value interface{}) error {
-			currency, _ := value.(string)
-			if currency != "" && currency != CurrencyBelgium {
-				return &InvalidBaseCurrencyError{
-					MustCurrency: CurrencyBelgium,
-					Currency:     currency,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBaseCurrency := []validation.Rule{
-		validateBaseCurrencyLength,
-		validateBaseCurrencyMatch,
-	}
-
-	return validation.ValidateStruct(a,
-		validation.Field(&a.BankID, validateBankID...),
-		validation.Field(&a.BIC, validateBIC...),
-		validation.Field(&a.BankIDCode, validateBankIDCode...),
-		validation.Field(&a.AccountNumber, validateAccountNumber...),
-		validation.Field(&a.BaseCurrency, validateBaseCurrency...),
-	)
-}
-
-func (a *Attributes) validateCanada() error {
-	validateBankIDLength := validation.By(
-		func(value interface{}) error {
-			id, _ := value.(string)
-			length := len(id)
-			if id != "" && length != BankIDLengthCanada {
-				return &InvalidBankIDLengthError{
-					MustLength: BankIDLengthCanada,
-					Length:     length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankIDFirstCharacter := validation.By(
-		func(value interface{}) error {
-			id, _ := value.(string)
-			if id != "" && id[0] != '0' {
-				return ErrBankIDCodeFirstCharNonZero
-			}
-			return nil
-		},
-	)
-
-	validateBankID := []validation.Rule{
-		validateBankIDLength,
-		validateBankIDFirstCharacter,
-		validateStringNumber,
-	}
-
-	validateBIC := []validation.Rule{
-		validation.Required,
-		validateBICLength,
-		validateBICMatch,
-	}
-
-	validateBankIDCodeMatch := validation.By(
-		func(value interface{}) error {
-			code, _ := value.(string)
-			if code != "" && code != BankIDCodeCanada {
-				return &InvalidBankIDCodeError{
-					MustCode: BankIDCodeCanada,
-					Code:     code,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankIDCode := []validation.Rule{
-		is.Alpha,
-		validateBankIDCodeMatch,
-	}
-
-	validateAccountNumberLength := validation.By(
-		func(value interface{}) error {
-			number, _ := value.(string)
-			length := len(number)
-			if number != "" &&
-				!(length >= AccountNumberLengthCanadaStart &&
-					length <= AccountNumberLengthCanadaStop) {
-				return &InvalidAccountNumberLengthError{
-					MustLengthFrom: AccountNumberLengthCanadaStart,
-					MustLengthTo:   AccountNumberLengthCanadaStop,
-					Length:         length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateAccountNumber := []validation.Rule{
-		validateAccountNumberLength,
-		validateStringNumber,
-	}
-
-	validateBaseCurrencyMatch := validation.By(
-		func(value interface{}) error {
-			currency, _ := value.(string)
-			if currency != "" && currency != CurrencyCanada {
-				return &InvalidBaseCurrencyError{
-					MustCurrency: CurrencyCanada,
-					Currency:     currency,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBaseCurrency := []validation.Rule{
-		validateBaseCurrencyLength,
-		is.Alpha,
-		validateBaseCurrencyMatch,
-	}
-
-	return validation.ValidateStruct(a,
-		validation.Field(&a.BankID, validateBankID...),
-		validation.Field(&a.BIC, validateBIC...),
-		validation.Field(&a.BankIDCode, validateBankIDCode...),
-		validation.Field(&a.AccountNumber, validateAccountNumber...),
-		validation.Field(&a.BaseCurrency, validateBaseCurrency...),
-	)
-}
-
-func (a *Attributes) validateFrance() error {
-	validateBankIDLength := validation.By(
-		func(value interface{}) error {
-			id, _ := value.(string)
-			length := len(id)
-			if length != BankIDLengthFrance {
-				return &InvalidBankIDLengthError{
-					MustLength: BankIDLengthFrance,
-					Length:     length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankID := []validation.Rule{
-		validation.Required,
-		validateBankIDLength,
-		validateStringNumber,
-	}
-
-	validateBIC := []validation.Rule{
-		validateBICLength,
-		validateBICMatch,
-	}
-
-	validateBankIDCodeMatch := validation.By(
-		func(value interface{}) error {
-			code, _ := value.(string)
-			if code != BankIDCodeFrance {
-				return &InvalidBankIDCodeError{
-					MustCode: BankIDCodeFrance,
-					Code:     code,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankIDCode := []validation.Rule{
-		validation.Required,
-		is.Alpha,
-		validateBankIDCodeMatch,
-	}
-
-	validateAccountNumberLength := validation.By(
-		func(value interface{}) error {
-			number, _ := value.(string)
-			length := len(number)
-			if number != "" && length != AccountNumberLengthFrance {
-				return &InvalidAccountNumberLengthError{
-					MustLength: AccountNumberLengthFrance,
-					Length:     length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateAccountNumber := []validation.Rule{
-		validateAccountNumberLength,
-		validateStringNumber,
-	}
-
-	validateBaseCurrencyMatch := validation.By(
-		func(value interface{}) error {
-			currency, _ := value.(string)
-			if currency != "" && currency != CurrencyFrance {
-				return &InvalidBaseCurrencyError{
-					MustCurrency: CurrencyFrance,
-					Currency:     currency,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBaseCurrency := []validation.Rule{
-		validateBaseCurrencyLength,
-		is.Alpha,
-		validateBaseCurrencyMatch,
-	}
-
-	return validation.ValidateStruct(a,
-		validation.Field(&a.BankID, validateBankID...),
-		validation.Field(&a.BIC, validateBIC...),
-		validation.Field(&a.BankIDCode, validateBankIDCode...),
-		validation.Field(&a.AccountNumber, validateAccountNumber...),
-		validation.Field(&a.BaseCurrency, validateBaseCurrency...),
-	)
-}
-
-func (a *Attributes) validateGermany() error {
-	validateBankIDLength := validation.By(
-		func(value interface{}) error {
-			id, _ := value.(string)
-			length := len(id)
-			if length != BankIDLengthGermany {
-				return &InvalidBankIDLengthError{
-					MustLength: BankIDLengthGermany,
-					Length:     length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankID := []validation.Rule{
-		validation.Required,
-		validateBankIDLength,
-		validateStringNumber,
-	}
-
-	validateBIC := []validation.Rule{
-		validateBICLength,
-		validateBICMatch,
-	}
-
-	validateBankIDCodeMatch := validation.By(
-		func(value interface{}) error {
-			code, _ := value.(string)
-			if code != BankIDCodeGermany {
-				return &InvalidBankIDCodeError{
-					MustCode: BankIDCodeGermany,
-					Code:     code,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankIDCode := []validation.Rule{
-		validation.Required,
-		is.Alpha,
-		validateBankIDCodeMatch,
-	}
-
-	validateAccountNumberLength := validation.By(
-		func(value interface{}) error {
-			number, _ := value.(string)
-			length := len(number)
-			if number != "" && length != AccountNumberLengthGermany {
-				return &InvalidAccountNumberLengthError{
-					MustLength: AccountNumberLengthGermany,
-					Length:     length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateAccountNumber := []validation.Rule{
-		validateAccountNumberLength,
-		validateStringNumber,
-	}
-
-	validateBaseCurrencyMatch := validation.By(
-		func(value interface{}) error {
-			currency, _ := value.(string)
-			if currency != "" && currency != CurrencyGermany {
-				return &InvalidBaseCurrencyError{
-					MustCurrency: CurrencyGermany,
-					Currency:     currency,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBaseCurrency := []validation.Rule{
-		validateBaseCurrencyLength,
-		is.Alpha,
-		validateBaseCurrencyMatch,
-	}
-
-	return validation.ValidateStruct(a,
-		validation.Field(&a.BankID, validateBankID...),
-		validation.Field(&a.BIC, validateBIC...),
-		validation.Field(&a.BankIDCode, validateBankIDCode...),
-		validation.Field(&a.AccountNumber, validateAccountNumber...),
-		validation.Field(&a.BaseCurrency, validateBaseCurrency...),
-	)
-}
-
-func (a *Attributes) validateGreece() error {
-	validateBankIDLength := validation.By(
-		func(value interface{}) error {
-			id, _ := value.(string)
-			length := len(id)
-			if length != BankIDLengthGreece {
-				return &InvalidBankIDLengthError{
-					MustLength: BankIDLengthGreece,
-					Length:     length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankID := []validation.Rule{
-		validation.Required,
-		validateBankIDLength,
-		validateStringNumber,
-	}
-
-	validateBIC := []validation.Rule{
-		validateBICLength,
-		validateBICMatch,
-	}
-
-	validateBankIDCodeMatch := validation.By(
-		func(value interface{}) error {
-			code, _ := value.(string)
-			if code != BankIDCodeGreece {
-				return &InvalidBankIDCodeError{
-					MustCode: BankIDCodeGreece,
-					Code:     code,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankIDCode := []validation.Rule{
-		validation.Required,
-		is.Alpha,
-		validateBankIDCodeMatch,
-	}
-
-	validateAccountNumberLength := validation.By(
-		func(value interface{}) error {
-			number, _ := value.(string)
-			length := len(number)
-			if number != "" && length != AccountNumberLengthGreece {
-				return &InvalidAccountNumberLengthError{
-					MustLength: AccountNumberLengthGreece,
-					Length:     length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateAccountNumber := []validation.Rule{
-		validateAccountNumberLength,
-		validateStringNumber,
-	}
-
-	validateBaseCurrencyMatch := validation.By(
-		func(value interface{}) error {
-			currency, _ := value.(string)
-			if currency != "" && currency != CurrencyGreecee {
-				return &InvalidBaseCurrencyError{
-					MustCurrency: CurrencyGreecee,
-					Currency:     currency,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBaseCurrency := []validation.Rule{
-		validateBaseCurrencyLength,
-		is.Alpha,
-		validateBaseCurrencyMatch,
-	}
-
-	return validation.ValidateStruct(a,
-		validation.Field(&a.BankID, validateBankID...),
-		validation.Field(&a.BIC, validateBIC...),
-		validation.Field(&a.BankIDCode, validateBankIDCode...),
-		validation.Field(&a.AccountNumber, validateAccountNumber...),
-		validation.Field(&a.BaseCurrency, validateBaseCurrency...),
-	)
-}
-
-func (a *Attributes) validateHongKong() error {
-	validateBankIDLength := validation.By(
-		func(value interface{}) error {
-			id, _ := value.(string)
-			length := len(id)
-			if id != "" && length != BankIDLengthHongKong {
-				return &InvalidBankIDLengthError{
-					MustLength: BankIDLengthHongKong,
-					Length:     length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankID := []validation.Rule{
-		validateBankIDLength,
-		validateStringNumber,
-	}
-
-	validateBIC := []validation.Rule{
-		validation.Required,
-		validateBICLength,
-		validateBICMatch,
-	}
-
-	validateBankIDCodeMatch := validation.By(
-		func(value interface{}) error {
-			code, _ := value.(string)
-			if code != "" && code != BankIDCodeHongKong {
-				return &InvalidBankIDCodeError{
-					MustCode: BankIDCodeHongKong,
-					Code:     code,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankIDCode := []validation.Rule{
-		is.Alpha,
-		validateBankIDCodeMatch,
-	}
-
-	validateAccountNumberLength := validation.By(
-		func(value interface{}) error {
-			number, _ := value.(string)
-			length := len(number)
-			if number != "" &&
-				!(length >= AccountNumberLengthHongKongStart &&
-					length <= AccountNumberLengthHongKongStop) {
-				return &InvalidAccountNumberLengthError{
-					MustLengthFrom: AccountNumberLengthHongKongStart,
-					MustLengthTo:   AccountNumberLengthHongKongStop,
-					Length:         length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateAccountNumber := []validation.Rule{
-		validateAccountNumberLength,
-		validateStringNumber,
-	}
-
-	validateBaseCurrencyMatch := validation.By(
-		func(value interface{}) error {
-			currency, _ := value.(string)
-			if currency != "" && currency != CurrencyHongKong {
-				return &InvalidBaseCurrencyError{
-					MustCurrency: CurrencyHongKong,
-					Currency:     currency,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBaseCurrency := []validation.Rule{
-		validateBaseCurrencyLength,
-		is.Alpha,
-		validateBaseCurrencyMatch,
-	}
-
-	return validation.ValidateStruct(a,
-		validation.Field(&a.BankID, validateBankID...),
-		validation.Field(&a.BIC, validateBIC...),
-		validation.Field(&a.BankIDCode, validateBankIDCode...),
-		validation.Field(&a.AccountNumber, validateAccountNumber...),
-		validation.Field(&a.BaseCurrency, validateBaseCurrency...),
-	)
-}
-
-func (a *Attributes) validateItaly() error {
-	const (
-		accountNumberNotPresent = BankIDLengthItalyAccountNumberNotPresent
-		accountNumberPresent    = BankIDLengthItalyAccountNumberPresent
-	)
-
-	validateBankIDLength := func(mustLength int) validation.Rule {
-		return validation.By(
-			func(value interface{}) error {
-				id, _ := value.(string)
-				length := len(id)
-				if length != mustLength {
-					return &InvalidBankIDLengthError{
-						MustLength: mustLength,
-						Length:     length,
-					}
-				}
-				return nil
-			},
-		)
-	}
-
-	validateBankID := []validation.Rule{
-		validation.Required,
-		validation.When(
-			validation.IsEmpty(a.AccountNumber),
-			validateBankIDLength(accountNumberNotPresent),
-			validation.Skip,
-		),
-		validation.When(
-			!validation.IsEmpty(a.AccountNumber),
-			validateBankIDLength(accountNumberPresent),
-		),
-		validateStringNumber,
-	}
-
-	validateBIC := []validation.Rule{
-		validateBICLength,
-		validateBICMatch,
-	}
-
-	validateBankIDCodeMatch := validation.By(
-		func(value interface{}) error {
-			code, _ := value.(string)
-			if code != BankIDCodeItaly {
-				return &InvalidBankIDCodeError{
-					MustCode: BankIDCodeItaly,
-					Code:     code,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankIDCode := []validation.Rule{
-		validation.Required,
-		is.Alpha,
-		validateBankIDCodeMatch,
-	}
-
-	validateAccountNumberLength := validation.By(
-		func(value interface{}) error {
-			number, _ := value.(string)
-			length := len(number)
-			if number != "" && length != AccountNumberLengthItaly {
-				return &InvalidAccountNumberLengthError{
-					MustLength: AccountNumberLengthItaly,
-					Length:     length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateAccountNumber := []validation.Rule{
-		validateAccountNumberLength,
-		validateStringNumber,
-	}
-
-	validateBaseCurrencyMatch := validation.By(
-		func(value interface{}) error {
-			currency, _ := value.(string)
-			if currency != "" && currency != CurrencyItaly {
-				return &InvalidBaseCurrencyError{
-					MustCurrency: CurrencyItaly,
-					Currency:     currency,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBaseCurrency := []validation.Rule{
-		validateBaseCurrencyLength,
-		is.Alpha,
-		validateBaseCurrencyMatch,
-	}
-
-	return validation.ValidateStruct(a,
-		validation.Field(&a.BankID, validateBankID...),
-		validation.Field(&a.BIC, validateBIC...),
-		validation.Field(&a.BankIDCode, validateBankIDCode...),
-		validation.Field(&a.AccountNumber, validateAccountNumber...),
-		validation.Field(&a.BaseCurrency, validateBaseCurrency...),
-	)
-}
-
-func (a *Attributes) validateLuxembourg() error {
-	validateBankIDLength := validation.By(
-		func(value interface{}) error {
-			id, _ := value.(string)
-			length := len(id)
-			if length != BankIDLengthLuxembourg {
-				return &InvalidBankIDLengthError{
-					MustLength: BankIDLengthLuxembourg,
-					Length:     length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankID := []validation.Rule{
-		validation.Required,
-		validateBankIDLength,
-		validateStringNumber,
-	}
-
-	validateBIC := []validation.Rule{
-		validateBICLength,
-		validateBICMatch,
-	}
-
-	validateBankIDCodeMatch := validation.By(
-		func(value interface{}) error {
-			code, _ := value.(string)
-			if code != BankIDCodeLuxembourg {
-				return &InvalidBankIDCodeError{
-					MustCode: BankIDCodeLuxembourg,
-					Code:     code,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankIDCode := []validation.Rule{
-		validation.Required,
-		is.Alpha,
-		validateBankIDCodeMatch,
-	}
-
-	validateAccountNumberLength := validation.By(
-		func(value interface{}) error {
-			number, _ := value.(string)
-			length := len(number)
-			if number != "" && length != AccountNumberLengthLuxembourg {
-				return &InvalidAccountNumberLengthError{
-					MustLength: AccountNumberLengthLuxembourg,
-					Length:     length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateAccountNumber := []validation.Rule{
-		validateAccountNumberLength,
-		validateStringNumber,
-	}
-
-	validateBaseCurrencyMatch := validation.By(
-		func(value interface{}) error {
-			currency, _ := value.(string)
-			if currency != "" && currency != CurrencyLuxembourg {
-				return &InvalidBaseCurrencyError{
-					MustCurrency: CurrencyLuxembourg,
-					Currency:     currency,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBaseCurrency := []validation.Rule{
-		validateBaseCurrencyLength,
-		is.Alpha,
-		validateBaseCurrencyMatch,
-	}
-
-	return validation.ValidateStruct(a,
-		validation.Field(&a.BankID, validateBankID...),
-		validation.Field(&a.BIC, validateBIC...),
-		validation.Field(&a.BankIDCode, validateBankIDCode...),
-		validation.Field(&a.AccountNumber, validateAccountNumber...),
-		validation.Field(&a.BaseCurrency, validateBaseCurrency...),
-	)
-}
-
-func (a *Attributes) validateNetherlands() error {
-	validateBankIDMatch := validation.By(
-		func(value interface{}) error {
-			id, _ := value.(string)
-			if id != "" {
-				return ErrBankIDNotBlank
-			}
-			return nil
-		},
-	)
-
-	validateBankID := []validation.Rule{
-		validateBankIDMatch,
-		validateStringNumber,
-	}
-
-	validateBIC := []validation.Rule{
-		validation.Required,
-		validateBICLength,
-		validateBICMatch,
-	}
-
-	validateBankIDCodeMatch := validation.By(
-		func(value interface{}) error {
-			code, _ := value.(string)
-			if code != "" {
-				return ErrBankIDCodeNotBlank
-			}
-			return nil
-		},
-	)
-
-	validateBankIDCode := []validation.Rule{
-		is.Alpha,
-		validateBankIDCodeMatch,
-	}
-
-	validateAccountNumberLength := validation.By(
-		func(value interface{}) error {
-			number, _ := value.(string)
-			length := len(number)
-			if number != "" && length != AccountNumberLengthNetherlands {
-				return &InvalidAccountNumberLengthError{
-					MustLength: AccountNumberLengthNetherlands,
-					Length:     length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateAccountNumber := []validation.Rule{
-		validateAccountNumberLength,
-		validateStringNumber,
-	}
-
-	validateBaseCurrencyMatch := validation.By(
-		func(value interface{}) error {
-			currency, _ := value.(string)
-			if currency != "" && currency != CurrencyNetherlands {
-				return &InvalidBaseCurrencyError{
-					MustCurrency: CurrencyNetherlands,
-					Currency:     currency,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBaseCurrency := []validation.Rule{
-		validateBaseCurrencyLength,
-		is.Alpha,
-		validateBaseCurrencyMatch,
-	}
-
-	return validation.ValidateStruct(a,
-		validation.Field(&a.BankID, validateBankID...),
-		validation.Field(&a.BIC, validateBIC...),
-		validation.Field(&a.BankIDCode, validateBankIDCode...),
-		validation.Field(&a.AccountNumber, validateAccountNumber...),
-		validation.Field(&a.BaseCurrency, validateBaseCurrency...),
-	)
-}
-
-func (a *Attributes) validatePoland() error {
-	validateBankIDLength := validation.By(
-		func(value interface{}) error {
-			id, _ := value.(string)
-			length := len(id)
-			if length != BankIDLengthPoland {
-				return &InvalidBankIDLengthError{
-					MustLength: BankIDLengthPoland,
-					Length:     length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankID := []validation.Rule{
-		validation.Required,
-		validateBankIDLength,
-		validateStringNumber,
-	}
-
-	validateBIC := []validation.Rule{
-		validateBICLength,
-		validateBICMatch,
-	}
-
-	validateBankIDCodeMatch := validation.By(
-		func(value interface{}) error {
-			code, _ := value.(string)
-			if code != BankIDCodePoland {
-				return &InvalidBankIDCodeError{
-					MustCode: BankIDCodePoland,
-					Code:     code,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankIDCode := []validation.Rule{
-		validation.Required,
-		is.Alpha,
-		validateBankIDCodeMatch,
-	}
-
-	validateAccountNumberLength := validation.By(
-		func(value interface{}) error {
-			number, _ := value.(string)
-			length := len(number)
-			if number != "" && length != AccountNumberLengthPoland {
-				return &InvalidAccountNumberLengthError{
-					MustLength: AccountNumberLengthPoland,
-					Length:     length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateAccountNumber := []validation.Rule{
-		validateAccountNumberLength,
-		validateStringNumber,
-	}
-
-	validateBaseCurrencyMatch := validation.By(
-		func(value interface{}) error {
-			currency, _ := value.(string)
-			if currency != "" && currency != CurrencyPoland {
-				return &InvalidBaseCurrencyError{
-					MustCurrency: CurrencyPoland,
-					Currency:     currency,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBaseCurrency := []validation.Rule{
-		validateBaseCurrencyLength,
-		is.Alpha,
-		validateBaseCurrencyMatch,
-	}
-
-	return validation.ValidateStruct(a,
-		validation.Field(&a.BankID, validateBankID...),
-		validation.Field(&a.BIC, validateBIC...),
-		validation.Field(&a.BankIDCode, validateBankIDCode...),
-		validation.Field(&a.AccountNumber, validateAccountNumber...),
-		validation.Field(&a.BaseCurrency, validateBaseCurrency...),
-	)
-}
-
-func (a *Attributes) validatePortugal() error {
-	validateBankIDLength := validation.By(
-		func(value interface{}) error {
-			id, _ := value.(string)
-			length := len(id)
-			if length != BankIDLengthPortugal {
-				return &InvalidBankIDLengthError{
-					MustLength: BankIDLengthPortugal,
-					Length:     length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankID := []validation.Rule{
-		validation.Required,
-		validateBankIDLength,
-		validateStringNumber,
-	}
-
-	validateBIC := []validation.Rule{
-		validateBICLength,
-		validateBICMatch,
-	}
-
-	validateBankIDCodeMatch := validation.By(
-		func(value interface{}) error {
-			code, _ := value.(string)
-			if code != BankIDCodePortugal {
-				return &InvalidBankIDCodeError{
-					MustCode: BankIDCodePortugal,
-					Code:     code,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankIDCode := []validation.Rule{
-		validation.Required,
-		is.Alpha,
-		validateBankIDCodeMatch,
-	}
-
-	validateAccountNumberLength := validation.By(
-		func(value interface{}) error {
-			number, _ := value.(string)
-			length := len(number)
-			if number != "" && length != AccountNumberLengthPortugal {
-				return &InvalidAccountNumberLengthError{
-					MustLength: AccountNumberLengthPortugal,
-					Length:     length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateAccountNumber := []validation.Rule{
-		validateAccountNumberLength,
-		validateStringNumber,
-	}
-
-	validateBaseCurrencyMatch := validation.By(
-		func(value interface{}) error {
-			currency, _ := value.(string)
-			if currency != "" && currency != CurrencyPortugal {
-				return &InvalidBaseCurrencyError{
-					MustCurrency: CurrencyPortugal,
-					Currency:     currency,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBaseCurrency := []validation.Rule{
-		validateBaseCurrencyLength,
-		is.Alpha,
-		validateBaseCurrencyMatch,
-	}
-
-	return validation.ValidateStruct(a,
-		validation.Field(&a.BankID, validateBankID...),
-		validation.Field(&a.BIC, validateBIC...),
-		validation.Field(&a.BankIDCode, validateBankIDCode...),
-		validation.Field(&a.AccountNumber, validateAccountNumber...),
-		validation.Field(&a.BaseCurrency, validateBaseCurrency...),
-	)
-}
-
-func (a *Attributes) validateSpain() error {
-	validateBankIDLength := validation.By(
-		func(value interface{}) error {
-			id, _ := value.(string)
-			length := len(id)
-			if length != BankIDLengthSpain {
-				return &InvalidBankIDLengthError{
-					MustLength: BankIDLengthSpain,
-					Length:     length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankID := []validation.Rule{
-		validation.Required,
-		validateBankIDLength,
-		validateStringNumber,
-	}
-
-	validateBIC := []validation.Rule{
-		validateBICLength,
-		validateBICMatch,
-	}
-
-	validateBankIDCodeMatch := validation.By(
-		func(value interface{}) error {
-			code, _ := value.(string)
-			if code != BankIDCodeSpain {
-				return &InvalidBankIDCodeError{
-					MustCode: BankIDCodeSpain,
-					Code:     code,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankIDCode := []validation.Rule{
-		validation.Required,
-		is.Alpha,
-		validateBankIDCodeMatch,
-	}
-
-	validateAccountNumberLength := validation.By(
-		func(value interface{}) error {
-			number, _ := value.(string)
-			length := len(number)
-			if number != "" && length != AccountNumberLengthSpain {
-				return &InvalidAccountNumberLengthError{
-					MustLength: AccountNumberLengthSpain,
-					Length:     length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateAccountNumber := []validation.Rule{
-		validateAccountNumberLength,
-		validateStringNumber,
-	}
-
-	validateBaseCurrencyMatch := validation.By(
-		func(value interface{}) error {
-			currency, _ := value.(string)
-			if currency != "" && currency != CurrencySpain {
-				return &InvalidBaseCurrencyError{
-					MustCurrency: CurrencySpain,
-					Currency:     currency,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBaseCurrency := []validation.Rule{
-		validateBaseCurrencyLength,
-		is.Alpha,
-		validateBaseCurrencyMatch,
-	}
-
-	return validation.ValidateStruct(a,
-		validation.Field(&a.BankID, validateBankID...),
-		validation.Field(&a.BIC, validateBIC...),
-		validation.Field(&a.BankIDCode, validateBankIDCode...),
-		validation.Field(&a.AccountNumber, validateAccountNumber...),
-		validation.Field(&a.BaseCurrency, validateBaseCurrency...),
-	)
-}
-
-func (a *Attributes) validateSwitzerland() error {
-	validateBankIDLength := validation.By(
-		func(value interface{}) error {
-			id, _ := value.(string)
-			length := len(id)
-			if length != BankIDLengthSwitzerland {
-				return &InvalidBankIDLengthError{
-					MustLength: BankIDLengthSwitzerland,
-					Length:     length,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateBankID := []validation.Rule{
-		validation.Required,
-		validateBankIDLength,
-		validateStringNumber,
-	}
-
-	validateBIC := []validation.Rule{
-		validateBICLength,
-		validateBICMatch,
-	}
-
-	validateBankIDCodeMatch := validation.By(
-		func(value interface{}) error {
-			code, _ := value.(string)
-			if code != BankIDCodeSwitzerland {
-				return &InvalidBankIDCodeError{
-					MustCode: BankIDCodeSwitzerland,
-					Code:     code,
-				}
-			}
-			return nil
-		},
-	)
+		}
+		return nil
+	},
+)
 
-	validateBankIDCode := []validation.Rule{
-		validation.Required,
-		is.Alpha,
-		validateBankIDCodeMatch,
-	}
+var validateStringNumber = validation.By(
+	func(value interface{}) error {
+		number, _ := value.(string)
+		if number != "" {
+			_, err := strconv.ParseInt(number, 10, 64)
+			if err != nil {
+				return &InvalidAccountNumberError{number}
+			}
+		}
+		return nil
+	},
+)
 
-	validateAccountNumberLength := validation.By(
+// validateCountry checks the country specific attributes with the rules of the country
+func (a *Attributes) validateCountry(rules countryRules) error {
+	validateBankIDLength := validation.By(
 		func(value interface{}) error {
-			number, _ := value.(string)
-			length := len(number)
-			if number != "" && length != AccountNumberLengthSwitzerland {
-				return &InvalidAccountNumberLengthError{
-					MustLength: AccountNumberLengthSwitzerland,
+			id, _ := value.(string)
+			mustLength := rules.bankIDLength
+			if rules.bankIDLengthWithAccountNumber != 0 && a.AccountNumber != "" {
+				mustLength = rules.bankIDLengthWithAccountNumber
+			}
+			length := len(id)
+			if id != "" && length != mustLength {
+				return &InvalidBankIDLengthError{
+					MustLength: mustLength,
 					Length:     length,
 				}
 			}
@@ -1470,62 +134,42 @@ func (a *Attributes) validateSwitzerland() error {
 		},
 	)
 
-	validateAccountNumber := []validation.Rule{
-		validateAccountNumberLength,
-		validateStringNumber,
-	}
-
-	validateBaseCurrencyMatch := validation.By(
+	validateBankIDBlank := validation.By(
 		func(value interface{}) error {
-			currency, _ := value.(string)
-			if currency != "" && currency != CurrencySwitzerland {
-				return &InvalidBaseCurrencyError{
-					MustCurrency: CurrencySwitzerland,
-					Currency:     currency,
-				}
+			id, _ := value.(string)
+			if id != "" {
+				return ErrBankIDNotBlank
 			}
 			return nil
 		},
 	)
 
-	validateBaseCurrency := []validation.Rule{
-		validateBaseCurrencyLength,
-		is.Alpha,
-		validateBaseCurrencyMatch,
-	}
-
-	return validation.ValidateStruct(a,
-		validation.Field(&a.BankID, validateBankID...),
-		validation.Field(&a.BIC, validateBIC...),
-		validation.Field(&a.BankIDCode, validateBankIDCode...),
-		validation.Field(&a.AccountNumber, validateAccountNumber...),
-		validation.Field(&a.BaseCurrency, validateBaseCurrency...),
-	)
-}
-
-func (a *Attributes) validateUnitedStates() error {
-	validateBankIDLength := validation.By(
+	validateBankIDFirstCharacter := validation.By(
 		func(value interface{}) error {
 			id, _ := value.(string)
-			length := len(id)
-			if length != BankIDLengthUnitedStates {
-				return &InvalidBankIDLengthError{
-					MustLength: BankIDLengthUnitedStates,
-					Length:     length,
-				}
+			if id != "" && id[0] != '0' {
+				return ErrBankIDCodeFirstCharNonZero
 			}
 			return nil
 		},
 	)
 
-	validateBankID := []validation.Rule{
-		validation.Required,
-		validateBankIDLength,
-		validateStringNumber,
+	validateBankID := []validation.Rule{}
+	switch {
+	case rules.bankIDLength == 0:
+		validateBankID = append(validateBankID, validateBankIDBlank)
+	case rules.bankIDRequired:
+		validateBankID = append(validateBankID, validation.Required, validateBankIDLength)
+	default:
+		validateBankID = append(validateBankID, validateBankIDLength)
 	}
+	if rules.bankIDFirstCharZero {
+		validateBankID = append(validateBankID, validateBankIDFirstCharacter)
+	}
+	validateBankID = append(validateBankID, validateStringNumber)
 
 	validateBIC := []validation.Rule{
-		validation.Required,
+		validation.When(rules.bicRequired, validation.Required),
 		validateBICLength,
 		validateBICMatch,
 	}
@@ -1533,18 +177,22 @@ func (a *Attributes) validateUnitedStates() error {
 	validateBankIDCodeMatch := validation.By(
 		func(value interface{}) error {
 			code, _ := value.(string)
-			if code != BankIDCodeUnitedStates {
+			switch {
+			case code == "" || code == rules.bankIDCode:
+				return nil
+			case rules.bankIDCode == "":
+				return ErrBankIDCodeNotBlank
+			default:
 				return &InvalidBankIDCodeError{
-					MustCode: BankIDCodeUnitedStates,
+					MustCode: rules.bankIDCode,
 					Code:     code,
 				}
 			}
-			return nil
 		},
 	)
 
 	validateBankIDCode := []validation.Rule{
-		validation.Required,
+		validation.When(rules.bankIDCodeRequired, validation.Required),
 		is.Alpha,
 		validateBankIDCodeMatch,
 	}
@@ -1553,30 +201,60 @@ func (a *Attributes) validateUnitedStates() error {
 		func(value interface{}) error {
 			number, _ := value.(string)
 			length := len(number)
-			if number != "" &&
-				!(length >= AccountNumberLengthUnitedStatesStart &&
-					length <= AccountNumberLengthUnitedStatesStop) {
+			from, to := rules.accountNumberLengthFrom, rules.accountNumberLengthTo
+			switch {
+			case number == "" || (length >= from && length <= to):
+				return nil
+			case from == to:
+				return &InvalidAccountNumberLengthError{
+					MustLength: from,
+					Length:     length,
+				}
+			default:
 				return &InvalidAccountNumberLengthError{
-					MustLengthFrom: AccountNumberLengthUnitedStatesStart,
-					MustLengthTo:   AccountNumberLengthUnitedStatesStop,
+					MustLengthFrom: from,
+					MustLengthTo:   to,
 					Length:         length,
 				}
 			}
+		},
+	)
+
+	validateAccountNumberFirstCharacter := validation.By(
+		func(value interface{}) error {
+			number, _ := value.(string)
+			if number != "" && number[0] == '0' {
+				return ErrAccountNumberFirstCharZero
+			}
+			return nil
+		},
+	)
+
+	validateAccountNumberCheckDigit := validation.By(
+		func(value interface{}) error {
+			number, _ := value.(string)
+			if number != "" && !validAccountNumberCheckDigits(a.Country, a.BankID, number) {
+				return ErrAccountNumberCheckDigit
+			}
 			return nil
 		},
 	)
 
-	validateAccountNumber := []validation.Rule{
-		validateAccountNumberLength,
-		validateStringNumber,
+	validateAccountNumber := []validation.Rule{validateAccountNumberLength}
+	if rules.accountNumberFirstCharNonZero {
+		validateAccountNumber = append(validateAccountNumber, validateAccountNumberFirstCharacter)
+	}
+	validateAccountNumber = append(validateAccountNumber, validateStringNumber)
+	if rules.accountNumberCheckDigits {
+		validateAccountNumber = append(validateAccountNumber, validateAccountNumberCheckDigit)
 	}
 
 	validateBaseCurrencyMatch := validation.By(
 		func(value interface{}) error {
 			currency, _ := value.(string)
-			if currency != "" && currency != CurrencyUnitedStates {
+			if currency != "" && currency != rules.currency {
 				return &InvalidBaseCurrencyError{
-					MustCurrency: CurrencyUnitedKingdom,
+					MustCurrency: rules.currency,
 					Currency:     currency,
 				}
 			}
@@ -1599,35 +277,36 @@ func (a *Attributes) validateUnitedStates() error {
 	)
 }
 
-func (o *Options) validate() error {
-	validateTypeMatch := validation.By(
-		func(value interface{}) error {
-			match, _ := value.(string)
-			if match != accountType {
-				return &InvalidAccountTypeError{
-					MustType: accountType,
-					Type:     match,
-				}
-			}
-			return nil
-		},
-	)
-
-	validateType := []validation.Rule{
+// rules of the account details, shared by Options and Account
+var (
+	validateType = []validation.Rule{
 		validation.Required,
-		validateTypeMatch,
+		validation.By(
+			func(value interface{}) error {
+				match, _ := value.(string)
+				if match != accountType {
+					return &InvalidAccountTypeError{
+						MustType: accountType,
+						Type:     match,
+					}
+				}
+				return nil
+			},
+		),
 	}
 
-	validateID := []validation.Rule{
+	validateID = []validation.Rule{
 		validation.Required,
 		is.UUID,
 	}
 
-	validateOrganisationID := []validation.Rule{
+	validateOrganisationID = []validation.Rule{
 		validation.Required,
 		is.UUID,
 	}
+)
 
+func (o *Options) validate() error {
 	return validation.ValidateStruct(o,
 		validation.Field(&o.Type, validateType...),
 		validation.Field(&o.ID, validateID...),
@@ -1635,6 +314,32 @@ func (o *Options) validate() error {
 	)
 }
 
+func (d *Details) validate() error {
+	return validation.ValidateStruct(d,
+		validation.Field(&d.Type, validateType...),
+		validation.Field(&d.ID, validateID...),
+		validation.Field(&d.OrganisationID, validateOrganisationID...),
+	)
+}
+
+// Validate checks the account with the same rules as NewAccount, e.g. before creating
+// an account that was built by hand or unmarshalled. Names are checked in Unicode
+// Normalization Form C, the account itself is not modified. It returns ErrNoAttributes
+// if the account has no data or no attributes.
+func (a *Account) Validate() error {
+	if a == nil || a.Data == nil || a.Data.Attributes == nil {
+		return ErrNoAttributes
+	}
+
+	if err := a.Data.Details.validate(); err != nil {
+		return err
+	}
+
+	attributes := *a.Data.Attributes
+	attributes.normalizeNames()
+	return attributes.validate()
+}
+
 func (a *Attributes) validate() error {
 	const countryLength = 2
 
@@ -1657,6 +362,17 @@ func (a *Attributes) validate() error {
 		validateCountryLength,
 	}
 
+	// the length and the currency of the country are checked by the country rules
+	validateBaseCurrencyISO := validation.By(
+		func(value interface{}) error {
+			currency, _ := value.(string)
+			if len(currency) != baseCurrencyLength {
+				return nil
+			}
+			return checkCurrency(currency)
+		},
+	)
+
 	validateAlternativeBankAccountNamesArrayLength := validation.By(
 		func(value interface{}) error {
 			array, _ := value.([]string)
@@ -1677,7 +393,7 @@ func (a *Attributes) validate() error {
 	validateAlternativeBankAccountNamesElemLength := validation.By(
 		func(value interface{}) error {
 			name, _ := value.(string)
-			length := len(name)
+			length := nameLength(name)
 			if length != 0 &&
 				!(length >= alternativeBankAccountNamesElemLengthStart &&
 					length <= alternativeBankAccountNamesElemLengthStop) {
@@ -1691,15 +407,39 @@ func (a *Attributes) validate() error {
 		},
 	)
 
+	// AlternativeBankAccountNames is the CoP predecessor of AlternativeNames,
+	// when both are set they must hold the same names
+	validateAlternativeBankAccountNamesMatch := validation.By(
+		func(value interface{}) error {
+			names, _ := value.([]string)
+			if len(names) == 0 || len(a.AlternativeNames) == 0 {
+				return nil
+			}
+			if len(names) != len(a.AlternativeNames) {
+				return ErrAlternativeNamesMismatch
+			}
+			for i := range names {
+				if names[i] != a.AlternativeNames[i] {
+					return ErrAlternativeNamesMismatch
+				}
+			}
+			return nil
+		},
+	)
+
 	validateAlternativeBankAccountNames := []validation.Rule{
 		validateAlternativeBankAccountNamesArrayLength,
-		validation.Each(validateAlternativeBankAccountNamesElemLength),
+		validation.Each(
+			validateAlternativeBankAccountNamesElemLength,
+			validateNameCharacters,
+		),
+		validateAlternativeBankAccountNamesMatch,
 	}
 
 	validateFirstNameLength := validation.By(
 		func(value interface{}) error {
 			name, _ := value.(string)
-			length := len(name)
+			length := nameLength(name)
 			if name != "" &&
 				!(length >= firstNameLengthStart &&
 					length <= firstNameLengthStop) {
@@ -1713,15 +453,26 @@ func (a *Attributes) validate() error {
 		},
 	)
 
+	validateFirstNameBusinessAccount := validation.By(
+		func(value interface{}) error {
+			name, _ := value.(string)
+			if name != "" && a.AccountClassification == AccountClassificationBusiness {
+				return ErrFirstNameBusinessAccount
+			}
+			return nil
+		},
+	)
+
 	validateFirstName := []validation.Rule{
 		validateFirstNameLength,
-		is.Alpha,
+		validateNameCharacters,
+		validateFirstNameBusinessAccount,
 	}
 
 	validateCustomerIDLength := validation.By(
 		func(value interface{}) error {
 			id, _ := value.(string)
-			length := len(id)
+			length := utf8.RuneCountInString(id)
 			if id != "" &&
 				!(length >= customerIDLengthStart &&
 					length <= customerIDLengthStop) {
@@ -1739,68 +490,223 @@ func (a *Attributes) validate() error {
 		validateCustomerIDLength,
 	}
 
-	if err := validation.ValidateStruct(a,
-		validation.Field(&a.Country, validateCountry...),
-		validation.Field(
-			&a.AlternativeBankAccountNames,
-			validateAlternativeBankAccountNames...,
-		),
-		validation.Field(&a.FirstName, validateFirstName...),
-		validation.Field(&a.CustomerID, validateCustomerID...),
-	); err != nil {
-		return err
+	validateTitleLength := validation.By(
+		func(value interface{}) error {
+			title, _ := value.(string)
+			length := utf8.RuneCountInString(title)
+			if length > titleLengthStop {
+				return &InvalidTitleLengthError{
+					MustLengthTo: titleLengthStop,
+					Length:       length,
+				}
+			}
+			return nil
+		},
+	)
+
+	validateTitle := []validation.Rule{
+		validateTitleLength,
+	}
+
+	validateNameArrayLength := validation.By(
+		func(value interface{}) error {
+			array, _ := value.([]string)
+			length := len(array)
+			if length != 0 &&
+				!(length >= nameArrayLengthStart &&
+					length <= nameArrayLengthStop) {
+				return &InvalidNameArrayLengthError{
+					MustLengthFrom: nameArrayLengthStart,
+					MustLengthTo:   nameArrayLengthStop,
+					Length:         length,
+				}
+			}
+			return nil
+		},
+	)
+
+	validateNameElemLength := validation.By(
+		func(value interface{}) error {
+			line, _ := value.(string)
+			length := nameLength(line)
+			if !(length >= nameElemLengthStart &&
+				length <= nameElemLengthStop) {
+				return &InvalidNameElemLengthError{
+					MustLengthFrom: nameElemLengthStart,
+					MustLengthTo:   nameElemLengthStop,
+					Length:         length,
+				}
+			}
+			return nil
+		},
+	)
+
+	validateName := []validation.Rule{
+		validateNameArrayLength,
+		validation.Each(validateNameElemLength, validateNameCharacters),
 	}
 
-	switch a.Country {
-	case CountryUnitedKingdom:
-		return a.validateUnitedKingdom()
+	validateBankAccountNameLength := validation.By(
+		func(value interface{}) error {
+			name, _ := value.(string)
+			length := nameLength(name)
+			if length > bankAccountNameLengthStop {
+				return &InvalidBankAccountNameLengthError{
+					MustLengthTo: bankAccountNameLengthStop,
+					Length:       length,
+				}
+			}
+			return nil
+		},
+	)
 
-	case CountryAustralia:
-		return a.validateAustralia()
+	validateBankAccountName := []validation.Rule{
+		validateBankAccountNameLength,
+		validateNameCharacters,
+	}
 
-	case CountryBelgium:
-		return a.validateBelgium()
+	validateAlternativeNamesArrayLength := validation.By(
+		func(value interface{}) error {
+			array, _ := value.([]string)
+			length := len(array)
+			if length != 0 &&
+				!(length >= alternativeNamesArrayLengthStart &&
+					length <= alternativeNamesArrayLengthStop) {
+				return &InvalidAlternativeNamesArrayLengthError{
+					MustLengthFrom: alternativeNamesArrayLengthStart,
+					MustLengthTo:   alternativeNamesArrayLengthStop,
+					Length:         length,
+				}
+			}
+			return nil
+		},
+	)
 
-	case CountryCanada:
-		return a.validateCanada()
+	validateAlternativeNamesElemLength := validation.By(
+		func(value interface{}) error {
+			name, _ := value.(string)
+			length := nameLength(name)
+			if !(length >= alternativeNamesElemLengthStart &&
+				length <= alternativeNamesElemLengthStop) {
+				return &InvalidAlternativeNamesElemLengthError{
+					MustLengthFrom: alternativeNamesElemLengthStart,
+					MustLengthTo:   alternativeNamesElemLengthStop,
+					Length:         length,
+				}
+			}
+			return nil
+		},
+	)
 
-	case CountryFrance:
-		return a.validateFrance()
+	validateAlternativeNames := []validation.Rule{
+		validateAlternativeNamesArrayLength,
+		validation.Each(validateAlternativeNamesElemLength, validateNameCharacters),
+	}
 
-	case CountryGermany:
-		return a.validateGermany()
+	validateAccountClassificationMatch := validation.By(
+		func(value interface{}) error {
+			classification, _ := value.(string)
+			if classification != "" &&
+				classification != AccountClassificationPersonal &&
+				classification != AccountClassificationBusiness {
+				return &InvalidAccountClassificationError{classification}
+			}
+			return nil
+		},
+	)
 
-	case CountryGreece:
-		return a.validateGreece()
+	validateAccountClassification := []validation.Rule{
+		validateAccountClassificationMatch,
+	}
 
-	case CountryHongKong:
-		return a.validateHongKong()
+	validateStatusMatch := validation.By(
+		func(value interface{}) error {
+			status, _ := value.(string)
+			switch status {
+			case "", AccountStatusPending, AccountStatusConfirmed, AccountStatusClosed:
+				return nil
+			default:
+				return &InvalidStatusError{status}
+			}
+		},
+	)
 
-	case CountryItaly:
-		return a.validateItaly()
+	validateStatus := []validation.Rule{
+		validateStatusMatch,
+	}
 
-	case CountryLuxembourg:
-		return a.validateLuxembourg()
+	validateSwitchedCountry := validation.By(
+		func(value interface{}) error {
+			switched, _ := value.(bool)
+			if switched && a.Country != CountryUnitedKingdom {
+				return ErrSwitchedNotSupported
+			}
+			return nil
+		},
+	)
 
-	case CountryNetherlands:
-		return a.validateNetherlands()
+	validateSwitched := []validation.Rule{
+		validateSwitchedCountry,
+	}
 
-	case CountryPoland:
-		return a.validatePoland()
+	validateSecondaryIdentificationLength := validation.By(
+		func(value interface{}) error {
+			id, _ := value.(string)
+			length := utf8.RuneCountInString(id)
+			if length > secondaryIdentificationLengthStop {
+				return &InvalidSecondaryIdentificationLengthError{
+					MustLengthTo: secondaryIdentificationLengthStop,
+					Length:       length,
+				}
+			}
+			return nil
+		},
+	)
 
-	case CountryPortugal:
-		return a.validatePortugal()
+	validateSecondaryIdentification := []validation.Rule{
+		validateSecondaryIdentificationLength,
+	}
 
-	case CountrySpain:
-		return a.validateSpain()
+	validatePrivateIdentification := validation.By(
+		func(value interface{}) error {
+			id, _ := value.(*PrivateIdentification)
+			return id.validate()
+		},
+	)
 
-	case CountrySwitzerland:
-		return a.validateSwitzerland()
+	validateOrganisationIdentification := validation.By(
+		func(value interface{}) error {
+			id, _ := value.(*OrganisationIdentification)
+			return id.validate()
+		},
+	)
 
-	case CountryUnitedStates:
-		return a.validateUnitedStates()
+	if err := validation.ValidateStruct(a,
+		validation.Field(&a.Country, validateCountry...),
+		validation.Field(&a.BaseCurrency, validateBaseCurrencyISO),
+		validation.Field(
+			&a.AlternativeBankAccountNames,
+			validateAlternativeBankAccountNames...,
+		),
+		validation.Field(&a.FirstName, validateFirstName...),
+		validation.Field(&a.CustomerID, validateCustomerID...),
+		validation.Field(&a.Title, validateTitle...),
+		validation.Field(&a.Name, validateName...),
+		validation.Field(&a.BankAccountName, validateBankAccountName...),
+		validation.Field(&a.AlternativeNames, validateAlternativeNames...),
+		validation.Field(&a.AccountClassification, validateAccountClassification...),
+		validation.Field(&a.Status, validateStatus...),
+		validation.Field(&a.Switched, validateSwitched...),
+		validation.Field(&a.SecondaryIdentification, validateSecondaryIdentification...),
+		validation.Field(&a.PrivateIdentification, validatePrivateIdentification),
+		validation.Field(&a.OrganisationIdentification, validateOrganisationIdentification),
+	); err != nil {
+		return err
+	}
 
-	default:
-		return &InvalidCountryError{a.Country}
+	rules, ok := countries[a.Country]
+	if !ok {
+		return checkCountry(a.Country)
 	}
+	return a.validateCountry(rules)
 }
@@ -11,6 +11,61 @@ const (
 	errMsgNotBlank         = "must be blank"
 	errMsgFirstCharZero    = "first character cannot be '0'"
 	errMsgFirstCharNotZero = "first character must be '0'"
+	errMsgNamesMismatch    = "must be the same as alternative_names"
+	errMsgBusinessAccount  = "must be blank for business accounts"
+	errMsgSwitchedCountry  = "can only be set for 'GB' accounts"
+	errMsgDateInFuture     = "cannot be in the future"
+	errMsgCheckDigit       = "check digit is invalid"
+)
+
+// message codes, used as keys in a Catalogue
+const (
+	CodeBlank                                    = "blank"
+	CodeNotBlank                                 = "not_blank"
+	CodeFirstCharZero                            = "first_char_zero"
+	CodeFirstCharNotZero                         = "first_char_not_zero"
+	CodeInvalidAccountType                       = "invalid_account_type"
+	CodeInvalidCountry                           = "invalid_country"
+	CodeInvalidCountryLength                     = "invalid_country_length"
+	CodeInvalidBankIDLength                      = "invalid_bank_id_length"
+	CodeInvalidBankIDCode                        = "invalid_bank_id_code"
+	CodeInvalidBICLength                         = "invalid_bic_length"
+	CodeInvalidAccountNumberLength               = "invalid_account_number_length"
+	CodeInvalidAccountNumberLengthRange          = "invalid_account_number_length_range"
+	CodeInvalidAccountNumber                     = "invalid_account_number"
+	CodeInvalidBaseCurrencyLength                = "invalid_base_currency_length"
+	CodeInvalidBaseCurrency                      = "invalid_base_currency"
+	CodeInvalidFirstNameLength                   = "invalid_first_name_length"
+	CodeInvalidCustomerIDLength                  = "invalid_customer_id_length"
+	CodeInvalidAlternativeBankAccountArrayLength = "invalid_alternative_bank_account_array_length"
+	CodeInvalidAlternativeBankAccountElemLength  = "invalid_alternative_bank_account_elem_length"
+	CodeInvalidTitleLength                       = "invalid_title_length"
+	CodeInvalidNameArrayLength                   = "invalid_name_array_length"
+	CodeInvalidNameElemLength                    = "invalid_name_elem_length"
+	CodeInvalidBankAccountNameLength             = "invalid_bank_account_name_length"
+	CodeInvalidAlternativeNamesArrayLength       = "invalid_alternative_names_array_length"
+	CodeInvalidAlternativeNamesElemLength        = "invalid_alternative_names_elem_length"
+	CodeAlternativeNamesMismatch                 = "alternative_names_mismatch"
+	CodeInvalidAccountClassification             = "invalid_account_classification"
+	CodeInvalidStatus                            = "invalid_status"
+	CodeFirstNameBusinessAccount                 = "first_name_business_account"
+	CodeSwitchedNotSupported                     = "switched_not_supported"
+	CodeInvalidSecondaryIdentificationLength     = "invalid_secondary_identification_length"
+	CodeInvalidDate                              = "invalid_date"
+	CodeDateInFuture                             = "date_in_future"
+	CodeInvalidIdentificationLength              = "invalid_identification_length"
+	CodeInvalidAddressArrayLength                = "invalid_address_array_length"
+	CodeInvalidAddressElemLength                 = "invalid_address_elem_length"
+	CodeInvalidCityLength                        = "invalid_city_length"
+	CodeInvalidRepresentativeNameLength          = "invalid_representative_name_length"
+	CodeInvalidNameCharacter                     = "invalid_name_character"
+	CodeUntransliterableCharacter                = "untransliterable_character"
+	CodeInvalidCheckDigit                        = "invalid_check_digit"
+	CodeUnsupportedCountry                       = "unsupported_country"
+	CodeUnknownCurrency                          = "unknown_currency"
+	CodeWithdrawnCurrency                        = "withdrawn_currency"
+	CodeUnknownBankID                            = "unknown_bank_id"
+	CodeUnknownBIC                               = "unknown_bic"
 )
 
 // custom errors
@@ -23,6 +78,11 @@ var (
 	ErrBankIDCodeBlank            = errors.New(errMsgBlank)
 	ErrAccountNumberBlank         = errors.New(errMsgBlank)
 	ErrAccountNumberFirstCharZero = errors.New(errMsgFirstCharZero)
+	ErrAlternativeNamesMismatch   = errors.New(errMsgNamesMismatch)
+	ErrFirstNameBusinessAccount   = errors.New(errMsgBusinessAccount)
+	ErrSwitchedNotSupported       = errors.New(errMsgSwitchedCountry)
+	ErrDateInFuture               = errors.New(errMsgDateInFuture)
+	ErrAccountNumberCheckDigit    = errors.New(errMsgCheckDigit)
 )
 
 // InvalidAccountTypeError is returned if Account Type is not 'accounts'.
@@ -35,6 +95,15 @@ func (e *InvalidAccountTypeError) Error() string {
 	return fmt.Sprintf("must be '%s' but it's '%s'", e.MustType, e.Type)
 }
 
+// MessageCode returns the message code of the error.
+func (e *InvalidAccountTypeError) MessageCode() string {
+	return CodeInvalidAccountType
+}
+
+func (e *InvalidAccountTypeError) messageArgs() []interface{} {
+	return []interface{}{e.MustType, e.Type}
+}
+
 // InvalidCountryError is returned if Country Code for a country is incorrect.
 type InvalidCountryError struct {
 	Country string
@@ -44,6 +113,35 @@ func (e *InvalidCountryError) Error() string {
 	return fmt.Sprintf("invalid country '%s'", e.Country)
 }
 
+// MessageCode returns the message code of the error.
+func (e *InvalidCountryError) MessageCode() string {
+	return CodeInvalidCountry
+}
+
+func (e *InvalidCountryError) messageArgs() []interface{} {
+	return []interface{}{e.Country}
+}
+
+// UnsupportedCountryError is returned if Country Code is an ISO 3166-1 country
+// that the account API doesn't support.
+type UnsupportedCountryError struct {
+	Country string
+	Name    string
+}
+
+func (e *UnsupportedCountryError) Error() string {
+	return fmt.Sprintf("country '%s' (%s) is not supported", e.Country, e.Name)
+}
+
+// MessageCode returns the message code of the error.
+func (e *UnsupportedCountryError) MessageCode() string {
+	return CodeUnsupportedCountry
+}
+
+func (e *UnsupportedCountryError) messageArgs() []interface{} {
+	return []interface{}{e.Country, e.Name}
+}
+
 // InvalidCountryLengthError is returned if Country Code length is not 2 characters long.
 type InvalidCountryLengthError struct {
 	MustLength int
@@ -55,6 +153,15 @@ func (e *InvalidCountryLengthError) Error() string {
 		e.MustLength, e.Length)
 }
 
+// MessageCode returns the message code of the error.
+func (e *InvalidCountryLengthError) MessageCode() string {
+	return CodeInvalidCountryLength
+}
+
+func (e *InvalidCountryLengthError) messageArgs() []interface{} {
+	return []interface{}{e.MustLength, e.Length}
+}
+
 // InvalidBankIDLengthError is returned if Bank ID length for a country is incorrect.
 type InvalidBankIDLengthError struct {
 	MustLength int
@@ -66,6 +173,15 @@ func (e *InvalidBankIDLengthError) Error() string {
 		e.MustLength, e.Length)
 }
 
+// MessageCode returns the message code of the error.
+func (e *InvalidBankIDLengthError) MessageCode() string {
+	return CodeInvalidBankIDLength
+}
+
+func (e *InvalidBankIDLengthError) messageArgs() []interface{} {
+	return []interface{}{e.MustLength, e.Length}
+}
+
 // InvalidBankIDCodeError is returned if the Bank ID Code for a country is incorrect.
 type InvalidBankIDCodeError struct {
 	MustCode string
@@ -76,6 +192,15 @@ func (e *InvalidBankIDCodeError) Error() string {
 	return fmt.Sprintf("must be '%s' but it's '%s'", e.MustCode, e.Code)
 }
 
+// MessageCode returns the message code of the error.
+func (e *InvalidBankIDCodeError) MessageCode() string {
+	return CodeInvalidBankIDCode
+}
+
+func (e *InvalidBankIDCodeError) messageArgs() []interface{} {
+	return []interface{}{e.MustCode, e.Code}
+}
+
 // InvalidBICLengthError is returned if BIC length is incorrect.
 type InvalidBICLengthError struct {
 	MustLength1 int
@@ -88,6 +213,15 @@ func (e *InvalidBICLengthError) Error() string {
 		e.MustLength1, e.MustLength2, e.Length)
 }
 
+// MessageCode returns the message code of the error.
+func (e *InvalidBICLengthError) MessageCode() string {
+	return CodeInvalidBICLength
+}
+
+func (e *InvalidBICLengthError) messageArgs() []interface{} {
+	return []interface{}{e.MustLength1, e.MustLength2, e.Length}
+}
+
 // InvalidAccountNumberLengthError is returned if Account Number length for a country is incorrect.
 type InvalidAccountNumberLengthError struct {
 	MustLength     int
@@ -98,7 +232,7 @@ type InvalidAccountNumberLengthError struct {
 
 func (e *InvalidAccountNumberLengthError) Error() string {
 	var message string
-	if e.MustLength == 0 || e.MustLengthFrom != 0 && e.MustLengthTo != 0 {
+	if e.isRange() {
 		message = fmt.Sprintf("must be between %d and %d characters long but its length is %d",
 			e.MustLengthFrom, e.MustLengthTo, e.Length)
 	} else {
@@ -108,6 +242,25 @@ func (e *InvalidAccountNumberLengthError) Error() string {
 	return message
 }
 
+// MessageCode returns the message code of the error.
+func (e *InvalidAccountNumberLengthError) MessageCode() string {
+	if e.isRange() {
+		return CodeInvalidAccountNumberLengthRange
+	}
+	return CodeInvalidAccountNumberLength
+}
+
+func (e *InvalidAccountNumberLengthError) messageArgs() []interface{} {
+	if e.isRange() {
+		return []interface{}{e.MustLengthFrom, e.MustLengthTo, e.Length}
+	}
+	return []interface{}{e.MustLength, e.Length}
+}
+
+func (e *InvalidAccountNumberLengthError) isRange() bool {
+	return e.MustLength == 0 || e.MustLengthFrom != 0 && e.MustLengthTo != 0
+}
+
 // InvalidAccountNumberError is returned if Account Number is not a number.
 type InvalidAccountNumberError struct {
 	Number string
@@ -117,6 +270,15 @@ func (e *InvalidAccountNumberError) Error() string {
 	return fmt.Sprintf("must be a number but '%s' is not", e.Number)
 }
 
+// MessageCode returns the message code of the error.
+func (e *InvalidAccountNumberError) MessageCode() string {
+	return CodeInvalidAccountNumber
+}
+
+func (e *InvalidAccountNumberError) messageArgs() []interface{} {
+	return []interface{}{e.Number}
+}
+
 // InvalidBaseCurrencyLengthError is returned if Base Currency length is not 3 characters long.
 // See https://www.iso.org/iso-4217-currency-codes.html
 type InvalidBaseCurrencyLengthError struct {
@@ -129,6 +291,15 @@ func (e *InvalidBaseCurrencyLengthError) Error() string {
 		e.MustLength, e.Length)
 }
 
+// MessageCode returns the message code of the error.
+func (e *InvalidBaseCurrencyLengthError) MessageCode() string {
+	return CodeInvalidBaseCurrencyLength
+}
+
+func (e *InvalidBaseCurrencyLengthError) messageArgs() []interface{} {
+	return []interface{}{e.MustLength, e.Length}
+}
+
 // InvalidBaseCurrencyError is returned if Base Currency for a country is incorrect.
 type InvalidBaseCurrencyError struct {
 	MustCurrency string
@@ -139,6 +310,15 @@ func (e *InvalidBaseCurrencyError) Error() string {
 	return fmt.Sprintf("must be '%s' but it's '%s'", e.MustCurrency, e.Currency)
 }
 
+// MessageCode returns the message code of the error.
+func (e *InvalidBaseCurrencyError) MessageCode() string {
+	return CodeInvalidBaseCurrency
+}
+
+func (e *InvalidBaseCurrencyError) messageArgs() []interface{} {
+	return []interface{}{e.MustCurrency, e.Currency}
+}
+
 // InvalidFirstNameLengthError is returned if Firstname is not between between 2 and 140 characters long.
 type InvalidFirstNameLengthError struct {
 	MustLengthFrom int
@@ -151,6 +331,15 @@ func (e *InvalidFirstNameLengthError) Error() string {
 		e.MustLengthFrom, e.MustLengthTo, e.Length)
 }
 
+// MessageCode returns the message code of the error.
+func (e *InvalidFirstNameLengthError) MessageCode() string {
+	return CodeInvalidFirstNameLength
+}
+
+func (e *InvalidFirstNameLengthError) messageArgs() []interface{} {
+	return []interface{}{e.MustLengthFrom, e.MustLengthTo, e.Length}
+}
+
 // InvalidCustomerIDLengthError is returned if CustomerID Length is not between 5 and 15 characters long.
 type InvalidCustomerIDLengthError struct {
 	MustLengthFrom int
@@ -163,6 +352,15 @@ func (e *InvalidCustomerIDLengthError) Error() string {
 		e.MustLengthFrom, e.MustLengthTo, e.Length)
 }
 
+// MessageCode returns the message code of the error.
+func (e *InvalidCustomerIDLengthError) MessageCode() string {
+	return CodeInvalidCustomerIDLength
+}
+
+func (e *InvalidCustomerIDLengthError) messageArgs() []interface{} {
+	return []interface{}{e.MustLengthFrom, e.MustLengthTo, e.Length}
+}
+
 // InvalidAlternativeBankAccountArrayLengthError is returned if Alternative Bank Account Array's length is bigger than 3.
 type InvalidAlternativeBankAccountArrayLengthError struct {
 	MustLengthFrom int
@@ -175,6 +373,15 @@ func (e *InvalidAlternativeBankAccountArrayLengthError) Error() string {
 		e.MustLengthFrom, e.MustLengthTo, e.Length)
 }
 
+// MessageCode returns the message code of the error.
+func (e *InvalidAlternativeBankAccountArrayLengthError) MessageCode() string {
+	return CodeInvalidAlternativeBankAccountArrayLength
+}
+
+func (e *InvalidAlternativeBankAccountArrayLengthError) messageArgs() []interface{} {
+	return []interface{}{e.MustLengthFrom, e.MustLengthTo, e.Length}
+}
+
 // InvalidAlternativeBankAccountElemLengthError is returned if Alternative Bank Account element is not between 3 and 140 characters long.
 type InvalidAlternativeBankAccountElemLengthError struct {
 	MustLengthFrom int
@@ -186,3 +393,425 @@ func (e *InvalidAlternativeBankAccountElemLengthError) Error() string {
 	return fmt.Sprintf("must between %d and %d characters long but its length is %d",
 		e.MustLengthFrom, e.MustLengthTo, e.Length)
 }
+
+// MessageCode returns the message code of the error.
+func (e *InvalidAlternativeBankAccountElemLengthError) MessageCode() string {
+	return CodeInvalidAlternativeBankAccountElemLength
+}
+
+func (e *InvalidAlternativeBankAccountElemLengthError) messageArgs() []interface{} {
+	return []interface{}{e.MustLengthFrom, e.MustLengthTo, e.Length}
+}
+
+// InvalidTitleLengthError is returned if Title is longer than 40 characters.
+type InvalidTitleLengthError struct {
+	MustLengthTo int
+	Length       int
+}
+
+func (e *InvalidTitleLengthError) Error() string {
+	return fmt.Sprintf("must be at most %d characters long but its length is %d",
+		e.MustLengthTo, e.Length)
+}
+
+// MessageCode returns the message code of the error.
+func (e *InvalidTitleLengthError) MessageCode() string {
+	return CodeInvalidTitleLength
+}
+
+func (e *InvalidTitleLengthError) messageArgs() []interface{} {
+	return []interface{}{e.MustLengthTo, e.Length}
+}
+
+// InvalidNameArrayLengthError is returned if Name has more than 4 lines.
+type InvalidNameArrayLengthError struct {
+	MustLengthFrom int
+	MustLengthTo   int
+	Length         int
+}
+
+func (e *InvalidNameArrayLengthError) Error() string {
+	return fmt.Sprintf("must be between %d and %d in length but its length is %d",
+		e.MustLengthFrom, e.MustLengthTo, e.Length)
+}
+
+// MessageCode returns the message code of the error.
+func (e *InvalidNameArrayLengthError) MessageCode() string {
+	return CodeInvalidNameArrayLength
+}
+
+func (e *InvalidNameArrayLengthError) messageArgs() []interface{} {
+	return []interface{}{e.MustLengthFrom, e.MustLengthTo, e.Length}
+}
+
+// InvalidNameElemLengthError is returned if a Name line is not between 1 and 140 characters long.
+type InvalidNameElemLengthError struct {
+	MustLengthFrom int
+	MustLengthTo   int
+	Length         int
+}
+
+func (e *InvalidNameElemLengthError) Error() string {
+	return fmt.Sprintf("must be between %d and %d characters long but its length is %d",
+		e.MustLengthFrom, e.MustLengthTo, e.Length)
+}
+
+// MessageCode returns the message code of the error.
+func (e *InvalidNameElemLengthError) MessageCode() string {
+	return CodeInvalidNameElemLength
+}
+
+func (e *InvalidNameElemLengthError) messageArgs() []interface{} {
+	return []interface{}{e.MustLengthFrom, e.MustLengthTo, e.Length}
+}
+
+// InvalidBankAccountNameLengthError is returned if Bank Account Name is longer than 140 characters.
+type InvalidBankAccountNameLengthError struct {
+	MustLengthTo int
+	Length       int
+}
+
+func (e *InvalidBankAccountNameLengthError) Error() string {
+	return fmt.Sprintf("must be at most %d characters long but its length is %d",
+		e.MustLengthTo, e.Length)
+}
+
+// MessageCode returns the message code of the error.
+func (e *InvalidBankAccountNameLengthError) MessageCode() string {
+	return CodeInvalidBankAccountNameLength
+}
+
+func (e *InvalidBankAccountNameLengthError) messageArgs() []interface{} {
+	return []interface{}{e.MustLengthTo, e.Length}
+}
+
+// InvalidAlternativeNamesArrayLengthError is returned if Alternative Names has more than 3 names.
+type InvalidAlternativeNamesArrayLengthError struct {
+	MustLengthFrom int
+	MustLengthTo   int
+	Length         int
+}
+
+func (e *InvalidAlternativeNamesArrayLengthError) Error() string {
+	return fmt.Sprintf("must be between %d and %d in length but its length is %d",
+		e.MustLengthFrom, e.MustLengthTo, e.Length)
+}
+
+// MessageCode returns the message code of the error.
+func (e *InvalidAlternativeNamesArrayLengthError) MessageCode() string {
+	return CodeInvalidAlternativeNamesArrayLength
+}
+
+func (e *InvalidAlternativeNamesArrayLengthError) messageArgs() []interface{} {
+	return []interface{}{e.MustLengthFrom, e.MustLengthTo, e.Length}
+}
+
+// InvalidAlternativeNamesElemLengthError is returned if an Alternative Names element is not between 1 and 140 characters long.
+type InvalidAlternativeNamesElemLengthError struct {
+	MustLengthFrom int
+	MustLengthTo   int
+	Length         int
+}
+
+func (e *InvalidAlternativeNamesElemLengthError) Error() string {
+	return fmt.Sprintf("must be between %d and %d characters long but its length is %d",
+		e.MustLengthFrom, e.MustLengthTo, e.Length)
+}
+
+// MessageCode returns the message code of the error.
+func (e *InvalidAlternativeNamesElemLengthError) MessageCode() string {
+	return CodeInvalidAlternativeNamesElemLength
+}
+
+func (e *InvalidAlternativeNamesElemLengthError) messageArgs() []interface{} {
+	return []interface{}{e.MustLengthFrom, e.MustLengthTo, e.Length}
+}
+
+// InvalidAccountClassificationError is returned if Account Classification is not 'Personal' or 'Business'.
+type InvalidAccountClassificationError struct {
+	Classification string
+}
+
+func (e *InvalidAccountClassificationError) Error() string {
+	return fmt.Sprintf("must be either 'Personal' or 'Business' but it's '%s'", e.Classification)
+}
+
+// MessageCode returns the message code of the error.
+func (e *InvalidAccountClassificationError) MessageCode() string {
+	return CodeInvalidAccountClassification
+}
+
+func (e *InvalidAccountClassificationError) messageArgs() []interface{} {
+	return []interface{}{e.Classification}
+}
+
+// InvalidStatusError is returned if Status is not 'pending', 'confirmed' or 'closed'.
+type InvalidStatusError struct {
+	Status string
+}
+
+func (e *InvalidStatusError) Error() string {
+	return fmt.Sprintf("must be one of 'pending', 'confirmed' or 'closed' but it's '%s'", e.Status)
+}
+
+// MessageCode returns the message code of the error.
+func (e *InvalidStatusError) MessageCode() string {
+	return CodeInvalidStatus
+}
+
+func (e *InvalidStatusError) messageArgs() []interface{} {
+	return []interface{}{e.Status}
+}
+
+// InvalidSecondaryIdentificationLengthError is returned if Secondary Identification is longer than 140 characters.
+type InvalidSecondaryIdentificationLengthError struct {
+	MustLengthTo int
+	Length       int
+}
+
+func (e *InvalidSecondaryIdentificationLengthError) Error() string {
+	return fmt.Sprintf("must be at most %d characters long but its length is %d",
+		e.MustLengthTo, e.Length)
+}
+
+// MessageCode returns the message code of the error.
+func (e *InvalidSecondaryIdentificationLengthError) MessageCode() string {
+	return CodeInvalidSecondaryIdentificationLength
+}
+
+func (e *InvalidSecondaryIdentificationLengthError) messageArgs() []interface{} {
+	return []interface{}{e.MustLengthTo, e.Length}
+}
+
+// InvalidDateError is returned if a date is not formatted as YYYY-MM-DD.
+type InvalidDateError struct {
+	Date string
+}
+
+func (e *InvalidDateError) Error() string {
+	return fmt.Sprintf("must be a date in YYYY-MM-DD format but it's '%s'", e.Date)
+}
+
+// MessageCode returns the message code of the error.
+func (e *InvalidDateError) MessageCode() string {
+	return CodeInvalidDate
+}
+
+func (e *InvalidDateError) messageArgs() []interface{} {
+	return []interface{}{e.Date}
+}
+
+// InvalidIdentificationLengthError is returned if an Identification or a Registration Number is longer than 140 characters.
+type InvalidIdentificationLengthError struct {
+	MustLengthTo int
+	Length       int
+}
+
+func (e *InvalidIdentificationLengthError) Error() string {
+	return fmt.Sprintf("must be at most %d characters long but its length is %d",
+		e.MustLengthTo, e.Length)
+}
+
+// MessageCode returns the message code of the error.
+func (e *InvalidIdentificationLengthError) MessageCode() string {
+	return CodeInvalidIdentificationLength
+}
+
+func (e *InvalidIdentificationLengthError) messageArgs() []interface{} {
+	return []interface{}{e.MustLengthTo, e.Length}
+}
+
+// InvalidAddressArrayLengthError is returned if an Address has more than 3 lines.
+type InvalidAddressArrayLengthError struct {
+	MustLengthFrom int
+	MustLengthTo   int
+	Length         int
+}
+
+func (e *InvalidAddressArrayLengthError) Error() string {
+	return fmt.Sprintf("must be between %d and %d in length but its length is %d",
+		e.MustLengthFrom, e.MustLengthTo, e.Length)
+}
+
+// MessageCode returns the message code of the error.
+func (e *InvalidAddressArrayLengthError) MessageCode() string {
+	return CodeInvalidAddressArrayLength
+}
+
+func (e *InvalidAddressArrayLengthError) messageArgs() []interface{} {
+	return []interface{}{e.MustLengthFrom, e.MustLengthTo, e.Length}
+}
+
+// InvalidAddressElemLengthError is returned if an Address line is not between 1 and 140 characters long.
+type InvalidAddressElemLengthError struct {
+	MustLengthFrom int
+	MustLengthTo   int
+	Length         int
+}
+
+func (e *InvalidAddressElemLengthError) Error() string {
+	return fmt.Sprintf("must be between %d and %d characters long but its length is %d",
+		e.MustLengthFrom, e.MustLengthTo, e.Length)
+}
+
+// MessageCode returns the message code of the error.
+func (e *InvalidAddressElemLengthError) MessageCode() string {
+	return CodeInvalidAddressElemLength
+}
+
+func (e *InvalidAddressElemLengthError) messageArgs() []interface{} {
+	return []interface{}{e.MustLengthFrom, e.MustLengthTo, e.Length}
+}
+
+// InvalidCityLengthError is returned if a City is longer than 35 characters.
+type InvalidCityLengthError struct {
+	MustLengthTo int
+	Length       int
+}
+
+func (e *InvalidCityLengthError) Error() string {
+	return fmt.Sprintf("must be at most %d characters long but its length is %d",
+		e.MustLengthTo, e.Length)
+}
+
+// MessageCode returns the message code of the error.
+func (e *InvalidCityLengthError) MessageCode() string {
+	return CodeInvalidCityLength
+}
+
+func (e *InvalidCityLengthError) messageArgs() []interface{} {
+	return []interface{}{e.MustLengthTo, e.Length}
+}
+
+// InvalidRepresentativeNameLengthError is returned if the Representative Name is longer than 140 characters.
+type InvalidRepresentativeNameLengthError struct {
+	MustLengthTo int
+	Length       int
+}
+
+func (e *InvalidRepresentativeNameLengthError) Error() string {
+	return fmt.Sprintf("must be at most %d characters long but its length is %d",
+		e.MustLengthTo, e.Length)
+}
+
+// MessageCode returns the message code of the error.
+func (e *InvalidRepresentativeNameLengthError) MessageCode() string {
+	return CodeInvalidRepresentativeNameLength
+}
+
+func (e *InvalidRepresentativeNameLengthError) messageArgs() []interface{} {
+	return []interface{}{e.MustLengthTo, e.Length}
+}
+
+// InvalidNameCharacterError is returned if a name contains something else than
+// letters, spaces, hyphens, apostrophes and dots.
+type InvalidNameCharacterError struct {
+	Character string
+}
+
+func (e *InvalidNameCharacterError) Error() string {
+	return fmt.Sprintf("must contain only letters, spaces, hyphens, apostrophes and dots but it contains '%s'",
+		e.Character)
+}
+
+// MessageCode returns the message code of the error.
+func (e *InvalidNameCharacterError) MessageCode() string {
+	return CodeInvalidNameCharacter
+}
+
+func (e *InvalidNameCharacterError) messageArgs() []interface{} {
+	return []interface{}{e.Character}
+}
+
+// UntransliterableCharacterError is returned by NewAccount with TransliterateNames
+// if a name contains a character that has no SWIFT transliteration, e.g. in CJK names.
+type UntransliterableCharacterError struct {
+	Character string
+}
+
+func (e *UntransliterableCharacterError) Error() string {
+	return fmt.Sprintf("cannot transliterate '%s' to the SWIFT character set", e.Character)
+}
+
+// MessageCode returns the message code of the error.
+func (e *UntransliterableCharacterError) MessageCode() string {
+	return CodeUntransliterableCharacter
+}
+
+func (e *UntransliterableCharacterError) messageArgs() []interface{} {
+	return []interface{}{e.Character}
+}
+
+// UnknownCurrencyError is returned if a currency code is not an ISO 4217 currency.
+type UnknownCurrencyError struct {
+	Currency string
+}
+
+func (e *UnknownCurrencyError) Error() string {
+	return fmt.Sprintf("'%s' is not an ISO 4217 currency", e.Currency)
+}
+
+// MessageCode returns the message code of the error.
+func (e *UnknownCurrencyError) MessageCode() string {
+	return CodeUnknownCurrency
+}
+
+func (e *UnknownCurrencyError) messageArgs() []interface{} {
+	return []interface{}{e.Currency}
+}
+
+// WithdrawnCurrencyError is returned if a currency code is an ISO 4217 currency that is no longer in use.
+type WithdrawnCurrencyError struct {
+	Currency string
+	Name     string
+}
+
+func (e *WithdrawnCurrencyError) Error() string {
+	return fmt.Sprintf("currency '%s' (%s) has been withdrawn", e.Currency, e.Name)
+}
+
+// MessageCode returns the message code of the error.
+func (e *WithdrawnCurrencyError) MessageCode() string {
+	return CodeWithdrawnCurrency
+}
+
+func (e *WithdrawnCurrencyError) messageArgs() []interface{} {
+	return []interface{}{e.Currency, e.Name}
+}
+
+// UnknownBankIDError is returned if a Bank ID is not in the Directory.
+type UnknownBankIDError struct {
+	Country string
+	BankID  string
+}
+
+func (e *UnknownBankIDError) Error() string {
+	return fmt.Sprintf("bank id '%s' does not exist in country '%s'", e.BankID, e.Country)
+}
+
+// MessageCode returns the message code of the error.
+func (e *UnknownBankIDError) MessageCode() string {
+	return CodeUnknownBankID
+}
+
+func (e *UnknownBankIDError) messageArgs() []interface{} {
+	return []interface{}{e.BankID, e.Country}
+}
+
+// UnknownBICError is returned if a BIC is not in the Directory.
+type UnknownBICError struct {
+	BIC string
+}
+
+func (e *UnknownBICError) Error() string {
+	return fmt.Sprintf("bic '%s' does not exist", e.BIC)
+}
+
+// MessageCode returns the message code of the error.
+func (e *UnknownBICError) MessageCode() string {
+	return CodeUnknownBIC
+}
+
+func (e *UnknownBICError) messageArgs() []interface{} {
+	return []interface{}{e.BIC}
+}
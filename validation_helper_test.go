@@ -73,6 +73,26 @@ func randomFullName() string {
 	return fullName
 }
 
+func randomTitle() string {
+	titles := []string{"Mr", "Mrs", "Ms", "Miss", "Dr", "Prof"}
+	random := rand.New(rand.NewSource(time.Now().UnixNano()))
+	return titles[random.Intn(len(titles))]
+}
+
+func randomName(length ...int) []string {
+	var mustLength int
+	if len(length) != 0 {
+		mustLength = length[0]
+	} else {
+		mustLength = randomLength(1, 4)
+	}
+	lines := make([]string, mustLength)
+	for i := range lines {
+		lines[i] = randomFullName()
+	}
+	return lines
+}
+
 func randomAlphanumeric(length, style int, uppercase ...bool) string {
 	chars := make([]byte, length)
 	random := rand.New(rand.NewSource(time.Now().UnixNano()))
@@ -283,6 +303,10 @@ func randomBankIDAustralia() string {
 	return randomNumberString(BankIDLengthAustralia)
 }
 
+func randomBankIDAustria() string {
+	return randomNumberString(BankIDLengthAustria)
+}
+
 func randomBankIDBelgium() string {
 	return randomNumberString(BankIDLengthBelgium)
 }
@@ -291,6 +315,15 @@ func randomBankIDCanada() string {
 	return randomNumberString(BankIDLengthCanada, startWithZero)
 }
 
+func randomBankIDDenmark() string {
+	return randomNumberString(BankIDLengthDenmark)
+}
+
+// returns empty string because BankID for Finland must be blank
+func randomBankIDFinland() string {
+	return ""
+}
+
 func randomBankIDFrance() string {
 	return randomNumberString(BankIDLengthFrance)
 }
@@ -307,6 +340,10 @@ func randomBankIDHongKong() string {
 	return randomNumberString(BankIDLengthHongKong)
 }
 
+func randomBankIDIreland() string {
+	return randomNumberString(BankIDLengthIreland)
+}
+
 func randomBankIDItaly(accountNumberPresent ...bool) string {
 	var length int
 	if len(accountNumberPresent) != 0 && accountNumberPresent[0] {
@@ -326,6 +363,11 @@ func randomBankIDNetherlands() string {
 	return ""
 }
 
+// returns empty string because BankID for Norway must be blank
+func randomBankIDNorway() string {
+	return ""
+}
+
 func randomBankIDPoland() string {
 	return randomNumberString(BankIDLengthPoland)
 }
@@ -338,6 +380,10 @@ func randomBankIDSpain() string {
 	return randomNumberString(BankIDLengthSpain)
 }
 
+func randomBankIDSweden() string {
+	return randomNumberString(BankIDLengthSweden)
+}
+
 func randomBankIDSwitzerland() string {
 	return randomNumberString(BankIDLengthSwitzerland)
 }
@@ -358,6 +404,10 @@ func randomAccountNumberAustralia() string {
 	return randomNumberString(length)
 }
 
+func randomAccountNumberAustria() string {
+	return randomNumberString(AccountNumberLengthAustria)
+}
+
 func randomAccountNumberBelgium() string {
 	return randomNumberString(AccountNumberLengthBelgium)
 }
@@ -370,6 +420,16 @@ func randomAccountNumberCanada() string {
 	return randomNumberString(length)
 }
 
+func randomAccountNumberDenmark() string {
+	return randomNumberString(AccountNumberLengthDenmark)
+}
+
+// returns an account number with a valid check digit
+func randomAccountNumberFinland() string {
+	number, _ := GenerateAccountNumber(CountryFinland, randomBankIDFinland())
+	return number
+}
+
 func randomAccountNumberFrance() string {
 	return randomNumberString(AccountNumberLengthFrance)
 }
@@ -390,6 +450,10 @@ func randomAccountNumberHongKong() string {
 	return randomNumberString(length)
 }
 
+func randomAccountNumberIreland() string {
+	return randomNumberString(AccountNumberLengthIreland)
+}
+
 func randomAccountNumberItaly() string {
 	return randomNumberString(AccountNumberLengthItaly)
 }
@@ -402,6 +466,12 @@ func randomAccountNumberNetherlands() string {
 	return randomNumberString(AccountNumberLengthNetherlands)
 }
 
+// returns an account number with a valid check digit
+func randomAccountNumberNorway() string {
+	number, _ := GenerateAccountNumber(CountryNorway, randomBankIDNorway())
+	return number
+}
+
 func randomAccountNumberPoland() string {
 	return randomNumberString(AccountNumberLengthPoland)
 }
@@ -414,6 +484,14 @@ func randomAccountNumberSpain() string {
 	return randomNumberString(AccountNumberLengthSpain)
 }
 
+func randomAccountNumberSweden() string {
+	length := randomLength(
+		AccountNumberLengthSwedenStart,
+		AccountNumberLengthSwedenStop,
+	)
+	return randomNumberString(length)
+}
+
 func randomAccountNumberSwitzerland() string {
 	return randomNumberString(AccountNumberLengthSwitzerland)
 }
@@ -425,3 +503,9 @@ func randomAccountNumberUnitedStates() string {
 	)
 	return randomNumberString(length)
 }
+
+// returns the account number with its last digit changed, so its check digit is invalid
+func invalidCheckDigit(number string) string {
+	last := number[len(number)-1] - '0'
+	return number[:len(number)-1] + string('0'+(last+1)%10)
+}
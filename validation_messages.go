@@ -0,0 +1,465 @@
+package accountapi
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"golang.org/x/text/language"
+)
+
+// Catalogue maps message codes to message templates.
+//
+// Templates for the errors defined in this package are fmt format strings.
+// Arguments are passed in the same order as the fields of the error type,
+// so translations can reorder them with explicit indexes, e.g. '%[2]d'.
+// Templates for the ozzo-validation error codes (e.g. 'validation_required')
+// use the text/template syntax of that package, e.g. '{{.min}}'.
+type Catalogue map[string]string
+
+// localizable is implemented by the validation errors of this package.
+type localizable interface {
+	MessageCode() string
+	messageArgs() []interface{}
+}
+
+// message codes of the sentinel errors
+var sentinelCodes = map[error]string{
+	ErrAccountTypeBlank:           CodeBlank,
+	ErrBankIDBlank:                CodeBlank,
+	ErrBankIDNotBlank:             CodeNotBlank,
+	ErrBankIDCodeFirstCharNonZero: CodeFirstCharNotZero,
+	ErrBankIDCodeNotBlank:         CodeNotBlank,
+	ErrBankIDCodeBlank:            CodeBlank,
+	ErrAccountNumberBlank:         CodeBlank,
+	ErrAccountNumberFirstCharZero: CodeFirstCharZero,
+	ErrAlternativeNamesMismatch:   CodeAlternativeNamesMismatch,
+	ErrFirstNameBusinessAccount:   CodeFirstNameBusinessAccount,
+	ErrSwitchedNotSupported:       CodeSwitchedNotSupported,
+	ErrDateInFuture:               CodeDateInFuture,
+	ErrAccountNumberCheckDigit:    CodeInvalidCheckDigit,
+}
+
+var catalogueEnglish = Catalogue{
+	CodeBlank:                                    errMsgBlank,
+	CodeNotBlank:                                 errMsgNotBlank,
+	CodeFirstCharZero:                            errMsgFirstCharZero,
+	CodeFirstCharNotZero:                         errMsgFirstCharNotZero,
+	CodeInvalidAccountType:                       "must be '%[1]s' but it's '%[2]s'",
+	CodeInvalidCountry:                           "invalid country '%[1]s'",
+	CodeInvalidCountryLength:                     "must be %[1]d characters long but its length is %[2]d",
+	CodeInvalidBankIDLength:                      "must be %[1]d characters long but its length is %[2]d",
+	CodeInvalidBankIDCode:                        "must be '%[1]s' but it's '%[2]s'",
+	CodeInvalidBICLength:                         "must be either %[1]d or %[2]d characters long but its length is %[3]d",
+	CodeInvalidAccountNumberLength:               "must be %[1]d characters long but its length is %[2]d",
+	CodeInvalidAccountNumberLengthRange:          "must be between %[1]d and %[2]d characters long but its length is %[3]d",
+	CodeInvalidAccountNumber:                     "must be a number but '%[1]s' is not",
+	CodeInvalidBaseCurrencyLength:                "must be %[1]d characters long but its length is %[2]d",
+	CodeInvalidBaseCurrency:                      "must be '%[1]s' but it's '%[2]s'",
+	CodeInvalidFirstNameLength:                   "must be between %[1]d and %[2]d characters long but its length is %[3]d",
+	CodeInvalidCustomerIDLength:                  "must be between %[1]d and %[2]d characters long but its length is %[3]d",
+	CodeInvalidAlternativeBankAccountArrayLength: "must be between %[1]d and %[2]d in length but its length is %[3]d",
+	CodeInvalidAlternativeBankAccountElemLength:  "must between %[1]d and %[2]d characters long but its length is %[3]d",
+	CodeInvalidTitleLength:                       "must be at most %[1]d characters long but its length is %[2]d",
+	CodeInvalidNameArrayLength:                   "must be between %[1]d and %[2]d in length but its length is %[3]d",
+	CodeInvalidNameElemLength:                    "must be between %[1]d and %[2]d characters long but its length is %[3]d",
+	CodeInvalidBankAccountNameLength:             "must be at most %[1]d characters long but its length is %[2]d",
+	CodeInvalidAlternativeNamesArrayLength:       "must be between %[1]d and %[2]d in length but its length is %[3]d",
+	CodeInvalidAlternativeNamesElemLength:        "must be between %[1]d and %[2]d characters long but its length is %[3]d",
+	CodeAlternativeNamesMismatch:                 errMsgNamesMismatch,
+	CodeInvalidAccountClassification:             "must be either 'Personal' or 'Business' but it's '%[1]s'",
+	CodeInvalidStatus:                            "must be one of 'pending', 'confirmed' or 'closed' but it's '%[1]s'",
+	CodeFirstNameBusinessAccount:                 errMsgBusinessAccount,
+	CodeSwitchedNotSupported:                     errMsgSwitchedCountry,
+	CodeInvalidSecondaryIdentificationLength:     "must be at most %[1]d characters long but its length is %[2]d",
+	CodeInvalidDate:                              "must be a date in YYYY-MM-DD format but it's '%[1]s'",
+	CodeDateInFuture:                             errMsgDateInFuture,
+	CodeInvalidIdentificationLength:              "must be at most %[1]d characters long but its length is %[2]d",
+	CodeInvalidAddressArrayLength:                "must be between %[1]d and %[2]d in length but its length is %[3]d",
+	CodeInvalidAddressElemLength:                 "must be between %[1]d and %[2]d characters long but its length is %[3]d",
+	CodeInvalidCityLength:                        "must be at most %[1]d characters long but its length is %[2]d",
+	CodeInvalidRepresentativeNameLength:          "must be at most %[1]d characters long but its length is %[2]d",
+	CodeInvalidNameCharacter:                     "must contain only letters, spaces, hyphens, apostrophes and dots but it contains '%[1]s'",
+	CodeUntransliterableCharacter:                "cannot transliterate '%[1]s' to the SWIFT character set",
+	CodeInvalidCheckDigit:                        errMsgCheckDigit,
+	CodeUnsupportedCountry:                       "country '%[1]s' (%[2]s) is not supported",
+	CodeUnknownCurrency:                          "'%[1]s' is not an ISO 4217 currency",
+	CodeWithdrawnCurrency:                        "currency '%[1]s' (%[2]s) has been withdrawn",
+	CodeUnknownBankID:                            "bank id '%[1]s' does not exist in country '%[2]s'",
+	CodeUnknownBIC:                               "bic '%[1]s' does not exist",
+	"validation_required":                        "cannot be blank",
+	"validation_nil_or_not_empty_required":       "cannot be blank",
+	"validation_is_alpha":                        "must contain English letters only",
+	"validation_is_uuid":                         "must be a valid UUID",
+	"validation_match_invalid":                   "must be in a valid format",
+}
+
+var catalogueFrench = Catalogue{
+	CodeBlank:                                    "ne peut pas être vide",
+	CodeNotBlank:                                 "doit être vide",
+	CodeFirstCharZero:                            "le premier caractère ne peut pas être '0'",
+	CodeFirstCharNotZero:                         "le premier caractère doit être '0'",
+	CodeInvalidAccountType:                       "doit être '%[1]s' mais vaut '%[2]s'",
+	CodeInvalidCountry:                           "pays invalide '%[1]s'",
+	CodeInvalidCountryLength:                     "doit comporter %[1]d caractères mais sa longueur est de %[2]d",
+	CodeInvalidBankIDLength:                      "doit comporter %[1]d caractères mais sa longueur est de %[2]d",
+	CodeInvalidBankIDCode:                        "doit être '%[1]s' mais vaut '%[2]s'",
+	CodeInvalidBICLength:                         "doit comporter %[1]d ou %[2]d caractères mais sa longueur est de %[3]d",
+	CodeInvalidAccountNumberLength:               "doit comporter %[1]d caractères mais sa longueur est de %[2]d",
+	CodeInvalidAccountNumberLengthRange:          "doit comporter entre %[1]d et %[2]d caractères mais sa longueur est de %[3]d",
+	CodeInvalidAccountNumber:                     "doit être un nombre mais '%[1]s' n'en est pas un",
+	CodeInvalidBaseCurrencyLength:                "doit comporter %[1]d caractères mais sa longueur est de %[2]d",
+	CodeInvalidBaseCurrency:                      "doit être '%[1]s' mais vaut '%[2]s'",
+	CodeInvalidFirstNameLength:                   "doit comporter entre %[1]d et %[2]d caractères mais sa longueur est de %[3]d",
+	CodeInvalidCustomerIDLength:                  "doit comporter entre %[1]d et %[2]d caractères mais sa longueur est de %[3]d",
+	CodeInvalidAlternativeBankAccountArrayLength: "doit contenir entre %[1]d et %[2]d éléments mais en contient %[3]d",
+	CodeInvalidAlternativeBankAccountElemLength:  "doit comporter entre %[1]d et %[2]d caractères mais sa longueur est de %[3]d",
+	CodeInvalidTitleLength:                       "doit comporter au plus %[1]d caractères mais sa longueur est de %[2]d",
+	CodeInvalidNameArrayLength:                   "doit contenir entre %[1]d et %[2]d lignes mais en contient %[3]d",
+	CodeInvalidNameElemLength:                    "doit comporter entre %[1]d et %[2]d caractères mais sa longueur est de %[3]d",
+	CodeInvalidBankAccountNameLength:             "doit comporter au plus %[1]d caractères mais sa longueur est de %[2]d",
+	CodeInvalidAlternativeNamesArrayLength:       "doit contenir entre %[1]d et %[2]d éléments mais en contient %[3]d",
+	CodeInvalidAlternativeNamesElemLength:        "doit comporter entre %[1]d et %[2]d caractères mais sa longueur est de %[3]d",
+	CodeAlternativeNamesMismatch:                 "doit être identique à alternative_names",
+	CodeInvalidAccountClassification:             "doit être 'Personal' ou 'Business' mais vaut '%[1]s'",
+	CodeInvalidStatus:                            "doit être 'pending', 'confirmed' ou 'closed' mais vaut '%[1]s'",
+	CodeFirstNameBusinessAccount:                 "doit être vide pour les comptes professionnels",
+	CodeSwitchedNotSupported:                     "ne peut être défini que pour les comptes 'GB'",
+	CodeInvalidSecondaryIdentificationLength:     "doit comporter au plus %[1]d caractères mais sa longueur est de %[2]d",
+	CodeInvalidDate:                              "doit être une date au format AAAA-MM-JJ mais vaut '%[1]s'",
+	CodeDateInFuture:                             "ne peut pas être dans le futur",
+	CodeInvalidIdentificationLength:              "doit comporter au plus %[1]d caractères mais sa longueur est de %[2]d",
+	CodeInvalidAddressArrayLength:                "doit contenir entre %[1]d et %[2]d lignes mais en contient %[3]d",
+	CodeInvalidAddressElemLength:                 "doit comporter entre %[1]d et %[2]d caractères mais sa longueur est de %[3]d",
+	CodeInvalidCityLength:                        "doit comporter au plus %[1]d caractères mais sa longueur est de %[2]d",
+	CodeInvalidRepresentativeNameLength:          "doit comporter au plus %[1]d caractères mais sa longueur est de %[2]d",
+	CodeInvalidNameCharacter:                     "ne doit contenir que des lettres, des espaces, des traits d'union, des apostrophes et des points mais contient '%[1]s'",
+	CodeUntransliterableCharacter:                "impossible de translittérer '%[1]s' dans le jeu de caractères SWIFT",
+	CodeInvalidCheckDigit:                        "le chiffre de contrôle n'est pas valide",
+	CodeUnsupportedCountry:                       "le pays '%[1]s' (%[2]s) n'est pas pris en charge",
+	CodeUnknownCurrency:                          "'%[1]s' n'est pas une devise ISO 4217",
+	CodeWithdrawnCurrency:                        "la devise '%[1]s' (%[2]s) a été retirée",
+	CodeUnknownBankID:                            "l'identifiant bancaire '%[1]s' n'existe pas dans le pays '%[2]s'",
+	CodeUnknownBIC:                               "le bic '%[1]s' n'existe pas",
+	"validation_required":                        "ne peut pas être vide",
+	"validation_nil_or_not_empty_required":       "ne peut pas être vide",
+	"validation_is_alpha":                        "ne doit contenir que des lettres anglaises",
+	"validation_is_uuid":                         "doit être un UUID valide",
+	"validation_match_invalid":                   "doit être dans un format valide",
+}
+
+var catalogueGerman = Catalogue{
+	CodeBlank:                                    "darf nicht leer sein",
+	CodeNotBlank:                                 "muss leer sein",
+	CodeFirstCharZero:                            "das erste Zeichen darf nicht '0' sein",
+	CodeFirstCharNotZero:                         "das erste Zeichen muss '0' sein",
+	CodeInvalidAccountType:                       "muss '%[1]s' sein, ist aber '%[2]s'",
+	CodeInvalidCountry:                           "ungültiges Land '%[1]s'",
+	CodeInvalidCountryLength:                     "muss %[1]d Zeichen lang sein, ist aber %[2]d Zeichen lang",
+	CodeInvalidBankIDLength:                      "muss %[1]d Zeichen lang sein, ist aber %[2]d Zeichen lang",
+	CodeInvalidBankIDCode:                        "muss '%[1]s' sein, ist aber '%[2]s'",
+	CodeInvalidBICLength:                         "muss entweder %[1]d oder %[2]d Zeichen lang sein, ist aber %[3]d Zeichen lang",
+	CodeInvalidAccountNumberLength:               "muss %[1]d Zeichen lang sein, ist aber %[2]d Zeichen lang",
+	CodeInvalidAccountNumberLengthRange:          "muss zwischen %[1]d und %[2]d Zeichen lang sein, ist aber %[3]d Zeichen lang",
+	CodeInvalidAccountNumber:                     "muss eine Zahl sein, aber '%[1]s' ist keine",
+	CodeInvalidBaseCurrencyLength:                "muss %[1]d Zeichen lang sein, ist aber %[2]d Zeichen lang",
+	CodeInvalidBaseCurrency:                      "muss '%[1]s' sein, ist aber '%[2]s'",
+	CodeInvalidFirstNameLength:                   "muss zwischen %[1]d und %[2]d Zeichen lang sein, ist aber %[3]d Zeichen lang",
+	CodeInvalidCustomerIDLength:                  "muss zwischen %[1]d und %[2]d Zeichen lang sein, ist aber %[3]d Zeichen lang",
+	CodeInvalidAlternativeBankAccountArrayLength: "muss zwischen %[1]d und %[2]d Einträge enthalten, enthält aber %[3]d",
+	CodeInvalidAlternativeBankAccountElemLength:  "muss zwischen %[1]d und %[2]d Zeichen lang sein, ist aber %[3]d Zeichen lang",
+	CodeInvalidTitleLength:                       "darf höchstens %[1]d Zeichen lang sein, ist aber %[2]d Zeichen lang",
+	CodeInvalidNameArrayLength:                   "muss zwischen %[1]d und %[2]d Zeilen enthalten, enthält aber %[3]d",
+	CodeInvalidNameElemLength:                    "muss zwischen %[1]d und %[2]d Zeichen lang sein, ist aber %[3]d Zeichen lang",
+	CodeInvalidBankAccountNameLength:             "darf höchstens %[1]d Zeichen lang sein, ist aber %[2]d Zeichen lang",
+	CodeInvalidAlternativeNamesArrayLength:       "muss zwischen %[1]d und %[2]d Einträge enthalten, enthält aber %[3]d",
+	CodeInvalidAlternativeNamesElemLength:        "muss zwischen %[1]d und %[2]d Zeichen lang sein, ist aber %[3]d Zeichen lang",
+	CodeAlternativeNamesMismatch:                 "muss mit alternative_names übereinstimmen",
+	CodeInvalidAccountClassification:             "muss entweder 'Personal' oder 'Business' sein, ist aber '%[1]s'",
+	CodeInvalidStatus:                            "muss 'pending', 'confirmed' oder 'closed' sein, ist aber '%[1]s'",
+	CodeFirstNameBusinessAccount:                 "muss bei Geschäftskonten leer sein",
+	CodeSwitchedNotSupported:                     "darf nur bei 'GB'-Konten gesetzt sein",
+	CodeInvalidSecondaryIdentificationLength:     "darf höchstens %[1]d Zeichen lang sein, ist aber %[2]d Zeichen lang",
+	CodeInvalidDate:                              "muss ein Datum im Format JJJJ-MM-TT sein, ist aber '%[1]s'",
+	CodeDateInFuture:                             "darf nicht in der Zukunft liegen",
+	CodeInvalidIdentificationLength:              "darf höchstens %[1]d Zeichen lang sein, ist aber %[2]d Zeichen lang",
+	CodeInvalidAddressArrayLength:                "muss zwischen %[1]d und %[2]d Zeilen enthalten, enthält aber %[3]d",
+	CodeInvalidAddressElemLength:                 "muss zwischen %[1]d und %[2]d Zeichen lang sein, ist aber %[3]d Zeichen lang",
+	CodeInvalidCityLength:                        "darf höchstens %[1]d Zeichen lang sein, ist aber %[2]d Zeichen lang",
+	CodeInvalidRepresentativeNameLength:          "darf höchstens %[1]d Zeichen lang sein, ist aber %[2]d Zeichen lang",
+	CodeInvalidNameCharacter:                     "darf nur Buchstaben, Leerzeichen, Bindestriche, Apostrophe und Punkte enthalten, enthält aber '%[1]s'",
+	CodeUntransliterableCharacter:                "'%[1]s' kann nicht in den SWIFT-Zeichensatz transliteriert werden",
+	CodeInvalidCheckDigit:                        "die Prüfziffer ist ungültig",
+	CodeUnsupportedCountry:                       "das Land '%[1]s' (%[2]s) wird nicht unterstützt",
+	CodeUnknownCurrency:                          "'%[1]s' ist keine ISO-4217-Währung",
+	CodeWithdrawnCurrency:                        "die Währung '%[1]s' (%[2]s) wurde eingezogen",
+	CodeUnknownBankID:                            "die Bankleitzahl '%[1]s' existiert nicht im Land '%[2]s'",
+	CodeUnknownBIC:                               "der BIC '%[1]s' existiert nicht",
+	"validation_required":                        "darf nicht leer sein",
+	"validation_nil_or_not_empty_required":       "darf nicht leer sein",
+	"validation_is_alpha":                        "darf nur englische Buchstaben enthalten",
+	"validation_is_uuid":                         "muss eine gültige UUID sein",
+	"validation_match_invalid":                   "muss ein gültiges Format haben",
+}
+
+var catalogueItalian = Catalogue{
+	CodeBlank:                                    "non può essere vuoto",
+	CodeNotBlank:                                 "deve essere vuoto",
+	CodeFirstCharZero:                            "il primo carattere non può essere '0'",
+	CodeFirstCharNotZero:                         "il primo carattere deve essere '0'",
+	CodeInvalidAccountType:                       "deve essere '%[1]s' ma è '%[2]s'",
+	CodeInvalidCountry:                           "paese non valido '%[1]s'",
+	CodeInvalidCountryLength:                     "deve essere lungo %[1]d caratteri ma la sua lunghezza è %[2]d",
+	CodeInvalidBankIDLength:                      "deve essere lungo %[1]d caratteri ma la sua lunghezza è %[2]d",
+	CodeInvalidBankIDCode:                        "deve essere '%[1]s' ma è '%[2]s'",
+	CodeInvalidBICLength:                         "deve essere lungo %[1]d o %[2]d caratteri ma la sua lunghezza è %[3]d",
+	CodeInvalidAccountNumberLength:               "deve essere lungo %[1]d caratteri ma la sua lunghezza è %[2]d",
+	CodeInvalidAccountNumberLengthRange:          "deve essere lungo tra %[1]d e %[2]d caratteri ma la sua lunghezza è %[3]d",
+	CodeInvalidAccountNumber:                     "deve essere un numero ma '%[1]s' non lo è",
+	CodeInvalidBaseCurrencyLength:                "deve essere lungo %[1]d caratteri ma la sua lunghezza è %[2]d",
+	CodeInvalidBaseCurrency:                      "deve essere '%[1]s' ma è '%[2]s'",
+	CodeInvalidFirstNameLength:                   "deve essere lungo tra %[1]d e %[2]d caratteri ma la sua lunghezza è %[3]d",
+	CodeInvalidCustomerIDLength:                  "deve essere lungo tra %[1]d e %[2]d caratteri ma la sua lunghezza è %[3]d",
+	CodeInvalidAlternativeBankAccountArrayLength: "deve contenere tra %[1]d e %[2]d elementi ma ne contiene %[3]d",
+	CodeInvalidAlternativeBankAccountElemLength:  "deve essere lungo tra %[1]d e %[2]d caratteri ma la sua lunghezza è %[3]d",
+	CodeInvalidTitleLength:                       "deve essere lungo al massimo %[1]d caratteri ma la sua lunghezza è %[2]d",
+	CodeInvalidNameArrayLength:                   "deve contenere tra %[1]d e %[2]d righe ma ne contiene %[3]d",
+	CodeInvalidNameElemLength:                    "deve essere lungo tra %[1]d e %[2]d caratteri ma la sua lunghezza è %[3]d",
+	CodeInvalidBankAccountNameLength:             "deve essere lungo al massimo %[1]d caratteri ma la sua lunghezza è %[2]d",
+	CodeInvalidAlternativeNamesArrayLength:       "deve contenere tra %[1]d e %[2]d elementi ma ne contiene %[3]d",
+	CodeInvalidAlternativeNamesElemLength:        "deve essere lungo tra %[1]d e %[2]d caratteri ma la sua lunghezza è %[3]d",
+	CodeAlternativeNamesMismatch:                 "deve essere uguale ad alternative_names",
+	CodeInvalidAccountClassification:             "deve essere 'Personal' o 'Business' ma è '%[1]s'",
+	CodeInvalidStatus:                            "deve essere 'pending', 'confirmed' o 'closed' ma è '%[1]s'",
+	CodeFirstNameBusinessAccount:                 "deve essere vuoto per i conti aziendali",
+	CodeSwitchedNotSupported:                     "può essere impostato solo per i conti 'GB'",
+	CodeInvalidSecondaryIdentificationLength:     "deve essere lungo al massimo %[1]d caratteri ma la sua lunghezza è %[2]d",
+	CodeInvalidDate:                              "deve essere una data nel formato AAAA-MM-GG ma è '%[1]s'",
+	CodeDateInFuture:                             "non può essere nel futuro",
+	CodeInvalidIdentificationLength:              "deve essere lungo al massimo %[1]d caratteri ma la sua lunghezza è %[2]d",
+	CodeInvalidAddressArrayLength:                "deve contenere tra %[1]d e %[2]d righe ma ne contiene %[3]d",
+	CodeInvalidAddressElemLength:                 "deve essere lungo tra %[1]d e %[2]d caratteri ma la sua lunghezza è %[3]d",
+	CodeInvalidCityLength:                        "deve essere lungo al massimo %[1]d caratteri ma la sua lunghezza è %[2]d",
+	CodeInvalidRepresentativeNameLength:          "deve essere lungo al massimo %[1]d caratteri ma la sua lunghezza è %[2]d",
+	CodeInvalidNameCharacter:                     "deve contenere solo lettere, spazi, trattini, apostrofi e punti ma contiene '%[1]s'",
+	CodeUntransliterableCharacter:                "impossibile traslitterare '%[1]s' nel set di caratteri SWIFT",
+	CodeInvalidCheckDigit:                        "la cifra di controllo non è valida",
+	CodeUnsupportedCountry:                       "il paese '%[1]s' (%[2]s) non è supportato",
+	CodeUnknownCurrency:                          "'%[1]s' non è una valuta ISO 4217",
+	CodeWithdrawnCurrency:                        "la valuta '%[1]s' (%[2]s) è stata ritirata",
+	CodeUnknownBankID:                            "l'identificativo bancario '%[1]s' non esiste nel paese '%[2]s'",
+	CodeUnknownBIC:                               "il bic '%[1]s' non esiste",
+	"validation_required":                        "non può essere vuoto",
+	"validation_nil_or_not_empty_required":       "non può essere vuoto",
+	"validation_is_alpha":                        "deve contenere solo lettere inglesi",
+	"validation_is_uuid":                         "deve essere un UUID valido",
+	"validation_match_invalid":                   "deve essere in un formato valido",
+}
+
+var cataloguePolish = Catalogue{
+	CodeBlank:                                    "nie może być puste",
+	CodeNotBlank:                                 "musi być puste",
+	CodeFirstCharZero:                            "pierwszy znak nie może być '0'",
+	CodeFirstCharNotZero:                         "pierwszy znak musi być '0'",
+	CodeInvalidAccountType:                       "musi mieć wartość '%[1]s', a ma '%[2]s'",
+	CodeInvalidCountry:                           "nieprawidłowy kraj '%[1]s'",
+	CodeInvalidCountryLength:                     "musi mieć długość %[1]d znaków, a ma %[2]d",
+	CodeInvalidBankIDLength:                      "musi mieć długość %[1]d znaków, a ma %[2]d",
+	CodeInvalidBankIDCode:                        "musi mieć wartość '%[1]s', a ma '%[2]s'",
+	CodeInvalidBICLength:                         "musi mieć długość %[1]d lub %[2]d znaków, a ma %[3]d",
+	CodeInvalidAccountNumberLength:               "musi mieć długość %[1]d znaków, a ma %[2]d",
+	CodeInvalidAccountNumberLengthRange:          "musi mieć długość od %[1]d do %[2]d znaków, a ma %[3]d",
+	CodeInvalidAccountNumber:                     "musi być liczbą, a '%[1]s' nią nie jest",
+	CodeInvalidBaseCurrencyLength:                "musi mieć długość %[1]d znaków, a ma %[2]d",
+	CodeInvalidBaseCurrency:                      "musi mieć wartość '%[1]s', a ma '%[2]s'",
+	CodeInvalidFirstNameLength:                   "musi mieć długość od %[1]d do %[2]d znaków, a ma %[3]d",
+	CodeInvalidCustomerIDLength:                  "musi mieć długość od %[1]d do %[2]d znaków, a ma %[3]d",
+	CodeInvalidAlternativeBankAccountArrayLength: "musi zawierać od %[1]d do %[2]d elementów, a zawiera %[3]d",
+	CodeInvalidAlternativeBankAccountElemLength:  "musi mieć długość od %[1]d do %[2]d znaków, a ma %[3]d",
+	CodeInvalidTitleLength:                       "może mieć długość najwyżej %[1]d znaków, a ma %[2]d",
+	CodeInvalidNameArrayLength:                   "musi zawierać od %[1]d do %[2]d wierszy, a zawiera %[3]d",
+	CodeInvalidNameElemLength:                    "musi mieć długość od %[1]d do %[2]d znaków, a ma %[3]d",
+	CodeInvalidBankAccountNameLength:             "może mieć długość najwyżej %[1]d znaków, a ma %[2]d",
+	CodeInvalidAlternativeNamesArrayLength:       "musi zawierać od %[1]d do %[2]d elementów, a zawiera %[3]d",
+	CodeInvalidAlternativeNamesElemLength:        "musi mieć długość od %[1]d do %[2]d znaków, a ma %[3]d",
+	CodeAlternativeNamesMismatch:                 "musi być takie samo jak alternative_names",
+	CodeInvalidAccountClassification:             "musi mieć wartość 'Personal' lub 'Business', a ma '%[1]s'",
+	CodeInvalidStatus:                            "musi mieć wartość 'pending', 'confirmed' lub 'closed', a ma '%[1]s'",
+	CodeFirstNameBusinessAccount:                 "musi być puste dla kont firmowych",
+	CodeSwitchedNotSupported:                     "może być ustawione tylko dla kont 'GB'",
+	CodeInvalidSecondaryIdentificationLength:     "może mieć długość najwyżej %[1]d znaków, a ma %[2]d",
+	CodeInvalidDate:                              "musi być datą w formacie RRRR-MM-DD, a ma wartość '%[1]s'",
+	CodeDateInFuture:                             "nie może być w przyszłości",
+	CodeInvalidIdentificationLength:              "może mieć długość najwyżej %[1]d znaków, a ma %[2]d",
+	CodeInvalidAddressArrayLength:                "musi zawierać od %[1]d do %[2]d wierszy, a zawiera %[3]d",
+	CodeInvalidAddressElemLength:                 "musi mieć długość od %[1]d do %[2]d znaków, a ma %[3]d",
+	CodeInvalidCityLength:                        "może mieć długość najwyżej %[1]d znaków, a ma %[2]d",
+	CodeInvalidRepresentativeNameLength:          "może mieć długość najwyżej %[1]d znaków, a ma %[2]d",
+	CodeInvalidNameCharacter:                     "może zawierać tylko litery, spacje, łączniki, apostrofy i kropki, a zawiera '%[1]s'",
+	CodeUntransliterableCharacter:                "nie można transliterować '%[1]s' na zestaw znaków SWIFT",
+	CodeInvalidCheckDigit:                        "cyfra kontrolna jest nieprawidłowa",
+	CodeUnsupportedCountry:                       "kraj '%[1]s' (%[2]s) nie jest obsługiwany",
+	CodeUnknownCurrency:                          "'%[1]s' nie jest walutą ISO 4217",
+	CodeWithdrawnCurrency:                        "waluta '%[1]s' (%[2]s) została wycofana",
+	CodeUnknownBankID:                            "identyfikator banku '%[1]s' nie istnieje w kraju '%[2]s'",
+	CodeUnknownBIC:                               "bic '%[1]s' nie istnieje",
+	"validation_required":                        "nie może być puste",
+	"validation_nil_or_not_empty_required":       "nie może być puste",
+	"validation_is_alpha":                        "może zawierać tylko litery angielskie",
+	"validation_is_uuid":                         "musi być prawidłowym UUID",
+	"validation_match_invalid":                   "musi mieć prawidłowy format",
+}
+
+// languages of the built-in catalogues, English must stay first because it's the fallback
+var defaultCatalogueTags = []language.Tag{
+	language.English,
+	language.French,
+	language.German,
+	language.Italian,
+	language.Polish,
+}
+
+// registered catalogues
+var catalogues = struct {
+	sync.RWMutex
+	tags []language.Tag
+	byID map[language.Tag]Catalogue
+
+	// matcher matches tags, it's built again when a language is registered
+	matcher language.Matcher
+}{
+	tags:    defaultCatalogueTags,
+	matcher: language.NewMatcher(defaultCatalogueTags),
+	byID: map[language.Tag]Catalogue{
+		language.English: catalogueEnglish,
+		language.French:  catalogueFrench,
+		language.German:  catalogueGerman,
+		language.Italian: catalogueItalian,
+		language.Polish:  cataloguePolish,
+	},
+}
+
+// RegisterCatalogue registers a message catalogue for a language.
+// If a catalogue is already registered for that language, the messages
+// are merged into it, so a partial catalogue can be used to override
+// only some of the messages.
+func RegisterCatalogue(tag language.Tag, catalogue Catalogue) {
+	catalogues.Lock()
+	defer catalogues.Unlock()
+
+	merged := Catalogue{}
+	if existing, ok := catalogues.byID[tag]; ok {
+		for code, message := range existing {
+			merged[code] = message
+		}
+	} else {
+		catalogues.tags = append(catalogues.tags[:len(catalogues.tags):len(catalogues.tags)], tag)
+		catalogues.matcher = language.NewMatcher(catalogues.tags)
+	}
+
+	for code, message := range catalogue {
+		merged[code] = message
+	}
+
+	catalogues.byID[tag] = merged
+}
+
+// LocalizeError renders a validation error returned by NewAccount in the
+// language that best matches tag. Messages missing from the matched catalogue
+// fall back to English and errors unknown to every catalogue are rendered
+// with their Error method.
+func LocalizeError(err error, tag language.Tag) string {
+	if err == nil {
+		return ""
+	}
+
+	catalogues.RLock()
+	defer catalogues.RUnlock()
+
+	_, index, _ := catalogues.matcher.Match(tag)
+	catalogue := catalogues.byID[catalogues.tags[index]]
+	fallback := catalogues.byID[language.English]
+
+	return localize(err, catalogue, fallback)
+}
+
+// LocalizeErrorString is like LocalizeError, but takes a BCP 47 locale
+// string, e.g. 'fr', 'de-CH' or 'pl_PL'. An invalid locale falls back to English.
+func LocalizeErrorString(err error, locale string) string {
+	tag, parseErr := language.Parse(strings.Replace(locale, "_", "-", -1))
+	if parseErr != nil {
+		tag = language.English
+	}
+	return LocalizeError(err, tag)
+}
+
+func localize(err error, catalogue, fallback Catalogue) string {
+	if errs, ok := err.(validation.Errors); ok {
+		return localizeErrors(errs, catalogue, fallback)
+	}
+
+	if message, ok := catalogue.render(err); ok {
+		return message
+	}
+
+	if message, ok := fallback.render(err); ok {
+		return message
+	}
+
+	return err.Error()
+}
+
+// localizeErrors follows the format of validation.Errors.Error
+func localizeErrors(errs validation.Errors, catalogue, fallback Catalogue) string {
+	if len(errs) == 0 {
+		return ""
+	}
+
+	keys := make([]string, 0, len(errs))
+	for key := range errs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var s strings.Builder
+	for i, key := range keys {
+		if i > 0 {
+			s.WriteString("; ")
+		}
+		if nested, ok := errs[key].(validation.Errors); ok {
+			fmt.Fprintf(&s, "%v: (%v)", key, localizeErrors(nested, catalogue, fallback))
+		} else {
+			fmt.Fprintf(&s, "%v: %v", key, localize(errs[key], catalogue, fallback))
+		}
+	}
+	s.WriteString(".")
+
+	return s.String()
+}
+
+func (c Catalogue) render(err error) (string, bool) {
+	switch e := err.(type) {
+	case validation.Error:
+		if template, ok := c[e.Code()]; ok {
+			return e.SetMessage(template).Error(), true
+		}
+
+	case localizable:
+		if template, ok := c[e.MessageCode()]; ok {
+			return fmt.Sprintf(template, e.messageArgs()...), true
+		}
+
+	default:
+		for sentinel, code := range sentinelCodes {
+			if errors.Is(err, sentinel) {
+				template, ok := c[code]
+				return template, ok
+			}
+		}
+	}
+
+	return "", false
+}
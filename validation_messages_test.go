@@ -0,0 +1,112 @@
+package accountapi_test
+
+import (
+	"testing"
+
+	. "github.com/alexdreptu/form3-accountapi-client"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestLocalizeError(t *testing.T) {
+	testCases := []struct {
+		name  string
+		err   error
+		tag   language.Tag
+		equal string
+	}{
+		{
+			name:  "english bank id length",
+			err:   &InvalidBankIDLengthError{MustLength: 6, Length: 5},
+			tag:   language.English,
+			equal: "must be 6 characters long but its length is 5",
+		},
+		{
+			name:  "french bank id length",
+			err:   &InvalidBankIDLengthError{MustLength: 6, Length: 5},
+			tag:   language.French,
+			equal: "doit comporter 6 caractères mais sa longueur est de 5",
+		},
+		{
+			name:  "german account number length range",
+			err:   &InvalidAccountNumberLengthError{MustLengthFrom: 6, MustLengthTo: 10, Length: 11},
+			tag:   language.German,
+			equal: "muss zwischen 6 und 10 Zeichen lang sein, ist aber 11 Zeichen lang",
+		},
+		{
+			name:  "italian sentinel error",
+			err:   ErrAccountNumberFirstCharZero,
+			tag:   language.Italian,
+			equal: "il primo carattere non può essere '0'",
+		},
+		{
+			name:  "polish regional variant",
+			err:   &InvalidCountryError{Country: "XX"},
+			tag:   language.MustParse("pl-PL"),
+			equal: "nieprawidłowy kraj 'XX'",
+		},
+		{
+			name:  "unsupported language falls back to english",
+			err:   &InvalidBaseCurrencyError{MustCurrency: CurrencyUnitedKingdom, Currency: "EUR"},
+			tag:   language.Japanese,
+			equal: "must be 'GBP' but it's 'EUR'",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.equal, LocalizeError(tc.err, tc.tag))
+		})
+	}
+}
+
+func TestLocalizeError_NewAccount(t *testing.T) {
+	options := &Options{
+		Type:           accountType,
+		ID:             uuid.New().String(),
+		OrganisationID: "",
+		Attributes: []Attribute{
+			WithAttrCountry(CountryUnitedKingdom),
+		},
+	}
+
+	_, err := NewAccount(options)
+	require.Error(t, err)
+
+	// english rendering must be the same as the error string
+	assert.Equal(t, err.Error(), LocalizeError(err, language.English))
+	assert.Equal(t, "OrganisationID: ne peut pas être vide.", LocalizeErrorString(err, "fr_FR"))
+	assert.Equal(t, err.Error(), LocalizeErrorString(err, "not a locale"))
+
+	options.OrganisationID = uuid.New().String()
+	options.Attributes = append(options.Attributes, WithAttrAlternativeBankAccountNames("ab"))
+	_, err = NewAccount(options)
+	require.Error(t, err)
+	assert.Equal(t, err.Error(), LocalizeError(err, language.English))
+	assert.Contains(t, LocalizeError(err, language.German),
+		"alternative_bank_account_names: (0: muss zwischen 3 und 140 Zeichen lang sein")
+}
+
+func TestRegisterCatalogue(t *testing.T) {
+	err := &InvalidCountryError{Country: "XX"}
+
+	RegisterCatalogue(language.Dutch, Catalogue{
+		CodeInvalidCountry: "ongeldig land '%[1]s'",
+	})
+	assert.Equal(t, "ongeldig land 'XX'", LocalizeError(err, language.Dutch))
+
+	// missing messages fall back to english
+	assert.Equal(t, "must be a number but 'x' is not",
+		LocalizeError(&InvalidAccountNumberError{Number: "x"}, language.Dutch))
+
+	// overriding a message keeps the rest of the catalogue
+	RegisterCatalogue(language.Dutch, Catalogue{
+		CodeInvalidAccountNumber: "moet een getal zijn, maar '%[1]s' is dat niet",
+	})
+	assert.Equal(t, "ongeldig land 'XX'", LocalizeError(err, language.Dutch))
+	assert.Equal(t, "moet een getal zijn, maar 'x' is dat niet",
+		LocalizeError(&InvalidAccountNumberError{Number: "x"}, language.Dutch))
+}
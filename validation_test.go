@@ -26,6 +26,14 @@ type testOptions struct {
 	accAlternativeBankAccountNames []string
 	accAccountMatchingOptOut       bool
 	accCustomerID                  string
+	accTitle                       string
+	accName                        []string
+	accBankAccountName             string
+	accAlternativeNames            []string
+	accAccountClassification       string
+	accStatus                      string
+	accSwitched                    bool
+	accSecondaryIdentification     string
 }
 
 func TestNewAccount(t *testing.T) {
@@ -316,22 +324,1262 @@ func TestNewAccount(t *testing.T) {
 				uppercase,
 			),
 		},
+		// Title, Name, BankAccountName and AlternativeNames tests
+		{
+			name:                           "title",
+			shouldError:                    false,
+			accType:                        accountType,
+			accID:                          uuid.New().String(),
+			accOrganisationID:              uuid.New().String(),
+			accCountry:                     CountryUnitedKingdom,
+			accBIC:                         randomBIC(),
+			accBankID:                      randomBankIDUnitedKingdom(),
+			accBankIDCode:                  BankIDCodeUnitedKingdom,
+			accAccountNumber:               randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:                CurrencyUnitedKingdom,
+			accFirstName:                   randomFirstName(),
+			accAlternativeBankAccountNames: randomAlternativeBankAccountNames(),
+			accCustomerID:                  randomCustomerID(),
+			accTitle:                       randomTitle(),
+		},
+		{
+			name:                           "invalid title length 41",
+			shouldError:                    true,
+			accType:                        accountType,
+			accID:                          uuid.New().String(),
+			accOrganisationID:              uuid.New().String(),
+			accCountry:                     CountryUnitedKingdom,
+			accBIC:                         randomBIC(),
+			accBankID:                      randomBankIDUnitedKingdom(),
+			accBankIDCode:                  BankIDCodeUnitedKingdom,
+			accAccountNumber:               randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:                CurrencyUnitedKingdom,
+			accFirstName:                   randomFirstName(),
+			accAlternativeBankAccountNames: randomAlternativeBankAccountNames(),
+			accCustomerID:                  randomCustomerID(),
+			accTitle:                       randomAlpha(41),
+		},
+		{
+			name:                           "name",
+			shouldError:                    false,
+			accType:                        accountType,
+			accID:                          uuid.New().String(),
+			accOrganisationID:              uuid.New().String(),
+			accCountry:                     CountryUnitedKingdom,
+			accBIC:                         randomBIC(),
+			accBankID:                      randomBankIDUnitedKingdom(),
+			accBankIDCode:                  BankIDCodeUnitedKingdom,
+			accAccountNumber:               randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:                CurrencyUnitedKingdom,
+			accFirstName:                   randomFirstName(),
+			accAlternativeBankAccountNames: randomAlternativeBankAccountNames(),
+			accCustomerID:                  randomCustomerID(),
+			accName:                        randomName(),
+		},
+		{
+			name:                           "invalid name array length 5",
+			shouldError:                    true,
+			accType:                        accountType,
+			accID:                          uuid.New().String(),
+			accOrganisationID:              uuid.New().String(),
+			accCountry:                     CountryUnitedKingdom,
+			accBIC:                         randomBIC(),
+			accBankID:                      randomBankIDUnitedKingdom(),
+			accBankIDCode:                  BankIDCodeUnitedKingdom,
+			accAccountNumber:               randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:                CurrencyUnitedKingdom,
+			accFirstName:                   randomFirstName(),
+			accAlternativeBankAccountNames: randomAlternativeBankAccountNames(),
+			accCustomerID:                  randomCustomerID(),
+			accName:                        randomName(5),
+		},
+		{
+			name:                           "invalid name element blank",
+			shouldError:                    true,
+			accType:                        accountType,
+			accID:                          uuid.New().String(),
+			accOrganisationID:              uuid.New().String(),
+			accCountry:                     CountryUnitedKingdom,
+			accBIC:                         randomBIC(),
+			accBankID:                      randomBankIDUnitedKingdom(),
+			accBankIDCode:                  BankIDCodeUnitedKingdom,
+			accAccountNumber:               randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:                CurrencyUnitedKingdom,
+			accFirstName:                   randomFirstName(),
+			accAlternativeBankAccountNames: randomAlternativeBankAccountNames(),
+			accCustomerID:                  randomCustomerID(),
+			accName:                        []string{randomFullName(), ""},
+		},
+		{
+			name:                           "invalid name element length 141",
+			shouldError:                    true,
+			accType:                        accountType,
+			accID:                          uuid.New().String(),
+			accOrganisationID:              uuid.New().String(),
+			accCountry:                     CountryUnitedKingdom,
+			accBIC:                         randomBIC(),
+			accBankID:                      randomBankIDUnitedKingdom(),
+			accBankIDCode:                  BankIDCodeUnitedKingdom,
+			accAccountNumber:               randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:                CurrencyUnitedKingdom,
+			accFirstName:                   randomFirstName(),
+			accAlternativeBankAccountNames: randomAlternativeBankAccountNames(),
+			accCustomerID:                  randomCustomerID(),
+			accName:                        []string{randomAlpha(141)},
+		},
+		{
+			name:                           "bank account name",
+			shouldError:                    false,
+			accType:                        accountType,
+			accID:                          uuid.New().String(),
+			accOrganisationID:              uuid.New().String(),
+			accCountry:                     CountryUnitedKingdom,
+			accBIC:                         randomBIC(),
+			accBankID:                      randomBankIDUnitedKingdom(),
+			accBankIDCode:                  BankIDCodeUnitedKingdom,
+			accAccountNumber:               randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:                CurrencyUnitedKingdom,
+			accFirstName:                   randomFirstName(),
+			accAlternativeBankAccountNames: randomAlternativeBankAccountNames(),
+			accCustomerID:                  randomCustomerID(),
+			accBankAccountName:             randomFullName(),
+		},
+		{
+			name:                           "invalid bank account name length 141",
+			shouldError:                    true,
+			accType:                        accountType,
+			accID:                          uuid.New().String(),
+			accOrganisationID:              uuid.New().String(),
+			accCountry:                     CountryUnitedKingdom,
+			accBIC:                         randomBIC(),
+			accBankID:                      randomBankIDUnitedKingdom(),
+			accBankIDCode:                  BankIDCodeUnitedKingdom,
+			accAccountNumber:               randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:                CurrencyUnitedKingdom,
+			accFirstName:                   randomFirstName(),
+			accAlternativeBankAccountNames: randomAlternativeBankAccountNames(),
+			accCustomerID:                  randomCustomerID(),
+			accBankAccountName:             randomAlpha(141),
+		},
+		{
+			name:                           "alternative names",
+			shouldError:                    false,
+			accType:                        accountType,
+			accID:                          uuid.New().String(),
+			accOrganisationID:              uuid.New().String(),
+			accCountry:                     CountryUnitedKingdom,
+			accBIC:                         randomBIC(),
+			accBankID:                      randomBankIDUnitedKingdom(),
+			accBankIDCode:                  BankIDCodeUnitedKingdom,
+			accAccountNumber:               randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:                CurrencyUnitedKingdom,
+			accFirstName:                   randomFirstName(),
+			accAlternativeBankAccountNames: nil,
+			accCustomerID:                  randomCustomerID(),
+			accAlternativeNames:            randomAlternativeBankAccountNames(),
+		},
+		{
+			name:                           "invalid alternative names array length 4",
+			shouldError:                    true,
+			accType:                        accountType,
+			accID:                          uuid.New().String(),
+			accOrganisationID:              uuid.New().String(),
+			accCountry:                     CountryUnitedKingdom,
+			accBIC:                         randomBIC(),
+			accBankID:                      randomBankIDUnitedKingdom(),
+			accBankIDCode:                  BankIDCodeUnitedKingdom,
+			accAccountNumber:               randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:                CurrencyUnitedKingdom,
+			accFirstName:                   randomFirstName(),
+			accAlternativeBankAccountNames: randomAlternativeBankAccountNames(),
+			accCustomerID:                  randomCustomerID(),
+			accAlternativeNames:            randomAlternativeBankAccountNames(4),
+		},
+		{
+			name:                           "invalid alternative names element length 141",
+			shouldError:                    true,
+			accType:                        accountType,
+			accID:                          uuid.New().String(),
+			accOrganisationID:              uuid.New().String(),
+			accCountry:                     CountryUnitedKingdom,
+			accBIC:                         randomBIC(),
+			accBankID:                      randomBankIDUnitedKingdom(),
+			accBankIDCode:                  BankIDCodeUnitedKingdom,
+			accAccountNumber:               randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:                CurrencyUnitedKingdom,
+			accFirstName:                   randomFirstName(),
+			accAlternativeBankAccountNames: randomAlternativeBankAccountNames(),
+			accCustomerID:                  randomCustomerID(),
+			accAlternativeNames:            []string{randomAlpha(141)},
+		},
+		{
+			name:                           "invalid alternative names mismatch",
+			shouldError:                    true,
+			accType:                        accountType,
+			accID:                          uuid.New().String(),
+			accOrganisationID:              uuid.New().String(),
+			accCountry:                     CountryUnitedKingdom,
+			accBIC:                         randomBIC(),
+			accBankID:                      randomBankIDUnitedKingdom(),
+			accBankIDCode:                  BankIDCodeUnitedKingdom,
+			accAccountNumber:               randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:                CurrencyUnitedKingdom,
+			accFirstName:                   randomFirstName(),
+			accAlternativeBankAccountNames: randomAlternativeBankAccountNames(),
+			accCustomerID:                  randomCustomerID(),
+			accAlternativeNames:            []string{randomFullName() + " Jr"},
+		},
+		{
+			name:                           "alternative names same as alternative bank account names",
+			shouldError:                    false,
+			accType:                        accountType,
+			accID:                          uuid.New().String(),
+			accOrganisationID:              uuid.New().String(),
+			accCountry:                     CountryUnitedKingdom,
+			accBIC:                         randomBIC(),
+			accBankID:                      randomBankIDUnitedKingdom(),
+			accBankIDCode:                  BankIDCodeUnitedKingdom,
+			accAccountNumber:               randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:                CurrencyUnitedKingdom,
+			accFirstName:                   randomFirstName(),
+			accAlternativeBankAccountNames: []string{"Lola Andrews", "Mitchell Davis"},
+			accCustomerID:                  randomCustomerID(),
+			accAlternativeNames:            []string{"Lola Andrews", "Mitchell Davis"},
+		},
+		// AccountClassification, Status, Switched and SecondaryIdentification tests
+		{
+			name:                           "personal account",
+			shouldError:                    false,
+			accType:                        accountType,
+			accID:                          uuid.New().String(),
+			accOrganisationID:              uuid.New().String(),
+			accCountry:                     CountryUnitedKingdom,
+			accBIC:                         randomBIC(),
+			accBankID:                      randomBankIDUnitedKingdom(),
+			accBankIDCode:                  BankIDCodeUnitedKingdom,
+			accAccountNumber:               randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:                CurrencyUnitedKingdom,
+			accFirstName:                   randomFirstName(),
+			accAlternativeBankAccountNames: randomAlternativeBankAccountNames(),
+			accCustomerID:                  randomCustomerID(),
+			accAccountClassification:       AccountClassificationPersonal,
+		},
+		{
+			name:                           "business account",
+			shouldError:                    false,
+			accType:                        accountType,
+			accID:                          uuid.New().String(),
+			accOrganisationID:              uuid.New().String(),
+			accCountry:                     CountryUnitedKingdom,
+			accBIC:                         randomBIC(),
+			accBankID:                      randomBankIDUnitedKingdom(),
+			accBankIDCode:                  BankIDCodeUnitedKingdom,
+			accAccountNumber:               randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:                CurrencyUnitedKingdom,
+			accFirstName:                   "",
+			accAlternativeBankAccountNames: randomAlternativeBankAccountNames(),
+			accCustomerID:                  randomCustomerID(),
+			accAccountClassification:       AccountClassificationBusiness,
+			accName:                        []string{"Acme Holdings Ltd"},
+		},
+		{
+			name:                           "invalid business account with firstname",
+			shouldError:                    true,
+			accType:                        accountType,
+			accID:                          uuid.New().String(),
+			accOrganisationID:              uuid.New().String(),
+			accCountry:                     CountryUnitedKingdom,
+			accBIC:                         randomBIC(),
+			accBankID:                      randomBankIDUnitedKingdom(),
+			accBankIDCode:                  BankIDCodeUnitedKingdom,
+			accAccountNumber:               randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:                CurrencyUnitedKingdom,
+			accFirstName:                   randomFirstName(),
+			accAlternativeBankAccountNames: randomAlternativeBankAccountNames(),
+			accCustomerID:                  randomCustomerID(),
+			accAccountClassification:       AccountClassificationBusiness,
+		},
+		{
+			name:                           "invalid account classification",
+			shouldError:                    true,
+			accType:                        accountType,
+			accID:                          uuid.New().String(),
+			accOrganisationID:              uuid.New().String(),
+			accCountry:                     CountryUnitedKingdom,
+			accBIC:                         randomBIC(),
+			accBankID:                      randomBankIDUnitedKingdom(),
+			accBankIDCode:                  BankIDCodeUnitedKingdom,
+			accAccountNumber:               randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:                CurrencyUnitedKingdom,
+			accFirstName:                   randomFirstName(),
+			accAlternativeBankAccountNames: randomAlternativeBankAccountNames(),
+			accCustomerID:                  randomCustomerID(),
+			accAccountClassification:       randomAlpha(8),
+		},
+		{
+			name:                           "status confirmed",
+			shouldError:                    false,
+			accType:                        accountType,
+			accID:                          uuid.New().String(),
+			accOrganisationID:              uuid.New().String(),
+			accCountry:                     CountryUnitedKingdom,
+			accBIC:                         randomBIC(),
+			accBankID:                      randomBankIDUnitedKingdom(),
+			accBankIDCode:                  BankIDCodeUnitedKingdom,
+			accAccountNumber:               randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:                CurrencyUnitedKingdom,
+			accFirstName:                   randomFirstName(),
+			accAlternativeBankAccountNames: randomAlternativeBankAccountNames(),
+			accCustomerID:                  randomCustomerID(),
+			accStatus:                      AccountStatusConfirmed,
+		},
+		{
+			name:                           "invalid status",
+			shouldError:                    true,
+			accType:                        accountType,
+			accID:                          uuid.New().String(),
+			accOrganisationID:              uuid.New().String(),
+			accCountry:                     CountryUnitedKingdom,
+			accBIC:                         randomBIC(),
+			accBankID:                      randomBankIDUnitedKingdom(),
+			accBankIDCode:                  BankIDCodeUnitedKingdom,
+			accAccountNumber:               randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:                CurrencyUnitedKingdom,
+			accFirstName:                   randomFirstName(),
+			accAlternativeBankAccountNames: randomAlternativeBankAccountNames(),
+			accCustomerID:                  randomCustomerID(),
+			accStatus:                      randomAlpha(7),
+		},
+		{
+			name:                           "switched",
+			shouldError:                    false,
+			accType:                        accountType,
+			accID:                          uuid.New().String(),
+			accOrganisationID:              uuid.New().String(),
+			accCountry:                     CountryUnitedKingdom,
+			accBIC:                         randomBIC(),
+			accBankID:                      randomBankIDUnitedKingdom(),
+			accBankIDCode:                  BankIDCodeUnitedKingdom,
+			accAccountNumber:               randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:                CurrencyUnitedKingdom,
+			accFirstName:                   randomFirstName(),
+			accAlternativeBankAccountNames: randomAlternativeBankAccountNames(),
+			accCustomerID:                  randomCustomerID(),
+			accSwitched:                    true,
+		},
+		{
+			name:                           "invalid switched not united kingdom",
+			shouldError:                    true,
+			accType:                        accountType,
+			accID:                          uuid.New().String(),
+			accOrganisationID:              uuid.New().String(),
+			accCountry:                     CountryAustralia,
+			accBIC:                         randomBIC(),
+			accBankID:                      randomBankIDAustralia(),
+			accBankIDCode:                  BankIDCodeAustralia,
+			accAccountNumber:               randomAccountNumberAustralia(),
+			accBaseCurrency:                CurrencyAustralia,
+			accFirstName:                   randomFirstName(),
+			accAlternativeBankAccountNames: randomAlternativeBankAccountNames(),
+			accCustomerID:                  randomCustomerID(),
+			accSwitched:                    true,
+		},
+		{
+			name:                           "secondary identification",
+			shouldError:                    false,
+			accType:                        accountType,
+			accID:                          uuid.New().String(),
+			accOrganisationID:              uuid.New().String(),
+			accCountry:                     CountryUnitedKingdom,
+			accBIC:                         randomBIC(),
+			accBankID:                      randomBankIDUnitedKingdom(),
+			accBankIDCode:                  BankIDCodeUnitedKingdom,
+			accAccountNumber:               randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:                CurrencyUnitedKingdom,
+			accFirstName:                   randomFirstName(),
+			accAlternativeBankAccountNames: randomAlternativeBankAccountNames(),
+			accCustomerID:                  randomCustomerID(),
+			accSecondaryIdentification:     randomAlphanumeric(randomLength(1, 18), alphanumericStyleNormal, uppercase),
+		},
+		{
+			name:                           "invalid secondary identification length 141",
+			shouldError:                    true,
+			accType:                        accountType,
+			accID:                          uuid.New().String(),
+			accOrganisationID:              uuid.New().String(),
+			accCountry:                     CountryUnitedKingdom,
+			accBIC:                         randomBIC(),
+			accBankID:                      randomBankIDUnitedKingdom(),
+			accBankIDCode:                  BankIDCodeUnitedKingdom,
+			accAccountNumber:               randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:                CurrencyUnitedKingdom,
+			accFirstName:                   randomFirstName(),
+			accAlternativeBankAccountNames: randomAlternativeBankAccountNames(),
+			accCustomerID:                  randomCustomerID(),
+			accSecondaryIdentification:     randomAlpha(141),
+		},
+	}
+
+	unitedKingdomTestCases := []testOptions{
+		// BIC tests
+		{
+			name:              "invalid bic blank",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryUnitedKingdom,
+			accBIC:            "",
+			accBankID:         randomBankIDUnitedKingdom(),
+			accBankIDCode:     BankIDCodeUnitedKingdom,
+			accAccountNumber:  randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:   CurrencyUnitedKingdom,
+		},
+		{
+			name:              "invalid bic",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryUnitedKingdom,
+			accBIC:            randomBICInvalid(),
+			accBankID:         randomBankIDUnitedKingdom(),
+			accBankIDCode:     BankIDCodeUnitedKingdom,
+			accAccountNumber:  randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:   CurrencyUnitedKingdom,
+		},
+		{
+			name:              "invalid bic length minus 1",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryUnitedKingdom,
+			accBIC:            randomBIC()[1:],
+			accBankID:         randomBankIDUnitedKingdom(),
+			accBankIDCode:     BankIDCodeUnitedKingdom,
+			accAccountNumber:  randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:   CurrencyUnitedKingdom,
+		},
+		{
+			name:              "invalid bic length plus 1",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryUnitedKingdom,
+			accBIC:            randomBIC() + "1",
+			accBankID:         randomBankIDUnitedKingdom(),
+			accBankIDCode:     BankIDCodeUnitedKingdom,
+			accAccountNumber:  randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:   CurrencyUnitedKingdom,
+		},
+		// Bank ID tests
+		{
+			name:              "invalid bank id blank",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryUnitedKingdom,
+			accBIC:            randomBIC(),
+			accBankID:         "",
+			accBankIDCode:     BankIDCodeUnitedKingdom,
+			accAccountNumber:  randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:   CurrencyUnitedKingdom,
+		},
+		{
+			name:              "invalid bank id not number",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryUnitedKingdom,
+			accBIC:            randomBIC(),
+			accBankID: randomAlphanumeric(
+				BankIDLengthUnitedKingdom,
+				alphanumericStylePure,
+			),
+			accBankIDCode:    BankIDCodeUnitedKingdom,
+			accAccountNumber: randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:  CurrencyUnitedKingdom,
+		},
+		{
+			name:              "invalid bank id length 5",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryUnitedKingdom,
+			accBIC:            randomBIC(),
+			accBankID:         randomNumberString(5),
+			accBankIDCode:     BankIDCodeUnitedKingdom,
+			accAccountNumber:  randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:   CurrencyUnitedKingdom,
+		},
+		{
+			name:              "invalid bank id length 7",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryUnitedKingdom,
+			accBIC:            randomBIC(),
+			accBankID:         randomNumberString(7),
+			accBankIDCode:     BankIDCodeUnitedKingdom,
+			accAccountNumber:  randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:   CurrencyUnitedKingdom,
+		},
+		// Bank ID Code tests
+		{
+			name:              "invalid bank id code blank",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryUnitedKingdom,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDUnitedKingdom(),
+			accBankIDCode:     "",
+			accAccountNumber:  randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:   CurrencyUnitedKingdom,
+		},
+		{
+			name:              "invalid bank id code",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryUnitedKingdom,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDUnitedKingdom(),
+			accBankIDCode:     randomBankIDCodeInvalid(),
+			accAccountNumber:  randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:   CurrencyUnitedKingdom,
+		},
+		{
+			name:              "invalid bank id code non english letters",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryUnitedKingdom,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDUnitedKingdom(),
+			accBankIDCode: randomAlphanumeric(
+				len(BankIDCodeUnitedKingdom),
+				alphanumericStylePure,
+			),
+			accAccountNumber: randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:  CurrencyUnitedKingdom,
+		},
+		// Account Number tests
+		{
+			name:              "account number blank",
+			shouldError:       false,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryUnitedKingdom,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDUnitedKingdom(),
+			accBankIDCode:     BankIDCodeUnitedKingdom,
+			accAccountNumber:  "",
+			accBaseCurrency:   CurrencyUnitedKingdom,
+		},
+		{
+			name:              "invalid account number not number",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryUnitedKingdom,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDUnitedKingdom(),
+			accBankIDCode:     BankIDCodeUnitedKingdom,
+			accAccountNumber: randomAlphanumeric(
+				AccountNumberLengthUnitedKingdom,
+				alphanumericStylePure,
+			),
+			accBaseCurrency: CurrencyUnitedKingdom,
+		},
+		{
+			name:              "invalid account number length 7",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryUnitedKingdom,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDUnitedKingdom(),
+			accBankIDCode:     BankIDCodeUnitedKingdom,
+			accAccountNumber:  randomNumberString(7),
+			accBaseCurrency:   CurrencyUnitedKingdom,
+		},
+		{
+			name:              "invalid account number length 9",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryUnitedKingdom,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDUnitedKingdom(),
+			accBankIDCode:     BankIDCodeUnitedKingdom,
+			accAccountNumber:  randomNumberString(9),
+			accBaseCurrency:   CurrencyUnitedKingdom,
+		},
+		// Base Currency
+		{
+			name:              "base currency blank",
+			shouldError:       false,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryUnitedKingdom,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDUnitedKingdom(),
+			accBankIDCode:     BankIDCodeUnitedKingdom,
+			accAccountNumber:  randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:   "",
+		},
+		{
+			name:              "invalid base currency",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryUnitedKingdom,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDUnitedKingdom(),
+			accBankIDCode:     BankIDCodeUnitedKingdom,
+			accAccountNumber:  randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:   randomBaseCurrencyInvalid(),
+		},
+		{
+			name:              "invalid base currency non english letters",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryUnitedKingdom,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDUnitedKingdom(),
+			accBankIDCode:     BankIDCodeUnitedKingdom,
+			accAccountNumber:  randomAccountNumberUnitedKingdom(),
+			accBaseCurrency: randomAlphanumeric(
+				len(CurrencyUnitedKingdom),
+				alphanumericStylePure,
+				uppercase,
+			),
+		},
+		{
+			name:              "invalid base currency length 2",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryUnitedKingdom,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDUnitedKingdom(),
+			accBankIDCode:     BankIDCodeUnitedKingdom,
+			accAccountNumber:  randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:   randomAlpha(2, uppercase),
+		},
+		{
+			name:              "invalid base currency length 4",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryUnitedKingdom,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDUnitedKingdom(),
+			accBankIDCode:     BankIDCodeUnitedKingdom,
+			accAccountNumber:  randomAccountNumberUnitedKingdom(),
+			accBaseCurrency:   randomAlpha(4, uppercase),
+		},
+	}
+
+	australiaTestCases := []testOptions{
+		// BIC tests
+		{
+			name:              "invalid bic blank",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustralia,
+			accBIC:            "",
+			accBankID:         randomBankIDAustralia(),
+			accBankIDCode:     BankIDCodeAustralia,
+			accAccountNumber:  randomAccountNumberAustralia(),
+			accBaseCurrency:   CurrencyAustralia,
+		},
+		{
+			name:              "invalid bic",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustralia,
+			accBIC:            randomBICInvalid(),
+			accBankID:         randomBankIDAustralia(),
+			accBankIDCode:     BankIDCodeAustralia,
+			accAccountNumber:  randomAccountNumberAustralia(),
+			accBaseCurrency:   CurrencyAustralia,
+		},
+		{
+			name:              "invalid bic length minus 1",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustralia,
+			accBIC:            randomBIC()[1:],
+			accBankID:         randomBankIDAustralia(),
+			accBankIDCode:     BankIDCodeAustralia,
+			accAccountNumber:  randomAccountNumberAustralia(),
+			accBaseCurrency:   CurrencyAustralia,
+		},
+		{
+			name:              "invalid bic length plus 1",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustralia,
+			accBIC:            randomBIC() + "1",
+			accBankID:         randomBankIDAustralia(),
+			accBankIDCode:     BankIDCodeAustralia,
+			accAccountNumber:  randomAccountNumberAustralia(),
+			accBaseCurrency:   CurrencyAustralia,
+		},
+		// Bank ID tests
+		{
+			name:              "bank id blank",
+			shouldError:       false,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustralia,
+			accBIC:            randomBIC(),
+			accBankID:         "",
+			accBankIDCode:     BankIDCodeAustralia,
+			accAccountNumber:  randomAccountNumberAustralia(),
+			accBaseCurrency:   CurrencyAustralia,
+		},
+		{
+			name:              "invalid bank id not number",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustralia,
+			accBIC:            randomBIC(),
+			accBankID: randomAlphanumeric(
+				BankIDLengthAustralia,
+				alphanumericStylePure,
+			),
+			accBankIDCode:    BankIDCodeAustralia,
+			accAccountNumber: randomAccountNumberAustralia(),
+			accBaseCurrency:  CurrencyAustralia,
+		},
+		{
+			name:              "invalid bank id length 5",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustralia,
+			accBIC:            randomBIC(),
+			accBankID:         randomNumberString(5),
+			accBankIDCode:     BankIDCodeAustralia,
+			accAccountNumber:  randomAccountNumberAustralia(),
+			accBaseCurrency:   CurrencyAustralia,
+		},
+		{
+			name:              "invalid bank id length 7",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustralia,
+			accBIC:            randomBIC(),
+			accBankID:         randomNumberString(7),
+			accBankIDCode:     BankIDCodeAustralia,
+			accAccountNumber:  randomAccountNumberAustralia(),
+			accBaseCurrency:   CurrencyAustralia,
+		},
+		// Bank ID Code tests
+		{
+			name:              "invalid bank id code blank",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustralia,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDAustralia(),
+			accBankIDCode:     "",
+			accAccountNumber:  randomAccountNumberAustralia(),
+			accBaseCurrency:   CurrencyAustralia,
+		},
+		{
+			name:              "invalid bank id code",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustralia,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDAustralia(),
+			accBankIDCode:     randomBankIDCodeInvalid(),
+			accAccountNumber:  randomAccountNumberAustralia(),
+			accBaseCurrency:   CurrencyAustralia,
+		},
+		{
+			name:              "invalid bank id code non english letters",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustralia,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDAustralia(),
+			accBankIDCode: randomAlphanumeric(
+				len(BankIDCodeAustralia),
+				alphanumericStylePure,
+			),
+			accAccountNumber: randomAccountNumberAustralia(),
+			accBaseCurrency:  CurrencyAustralia,
+		},
+		// Account Number tests
+		{
+			name:              "account number blank",
+			shouldError:       false,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustralia,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDAustralia(),
+			accBankIDCode:     BankIDCodeAustralia,
+			accAccountNumber:  "",
+			accBaseCurrency:   CurrencyAustralia,
+		},
+		{
+			name:              "invalid account number not number",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustralia,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDAustralia(),
+			accBankIDCode:     BankIDCodeAustralia,
+			accAccountNumber: randomAlphanumeric(
+				randomLength(
+					AccountNumberLengthAustraliaStart,
+					AccountNumberLengthAustraliaStop,
+				),
+				alphanumericStylePure,
+			),
+			accBaseCurrency: CurrencyAustralia,
+		},
+		{
+			name:              "invalid account number length 5",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustralia,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDAustralia(),
+			accBankIDCode:     BankIDCodeAustralia,
+			accAccountNumber:  randomNumberString(5),
+			accBaseCurrency:   CurrencyAustralia,
+		},
+		{
+			name:              "invalid account number length 11",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustralia,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDAustralia(),
+			accBankIDCode:     BankIDCodeAustralia,
+			accAccountNumber:  randomNumberString(11),
+			accBaseCurrency:   CurrencyAustralia,
+		},
+		// Base Currency
+		{
+			name:              "base currency blank",
+			shouldError:       false,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustralia,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDAustralia(),
+			accBankIDCode:     BankIDCodeAustralia,
+			accAccountNumber:  randomAccountNumberAustralia(),
+			accBaseCurrency:   "",
+		},
+		{
+			name:              "invalid base currency",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustralia,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDAustralia(),
+			accBankIDCode:     BankIDCodeAustralia,
+			accAccountNumber:  randomAccountNumberAustralia(),
+			accBaseCurrency:   randomBaseCurrencyInvalid(),
+		},
+		{
+			name:              "invalid base currency non english letters",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustralia,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDAustralia(),
+			accBankIDCode:     BankIDCodeAustralia,
+			accAccountNumber:  randomAccountNumberAustralia(),
+			accBaseCurrency: randomAlphanumeric(
+				len(CurrencyAustralia),
+				alphanumericStylePure,
+				uppercase,
+			),
+		},
+		{
+			name:              "invalid base currency length 2",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustralia,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDAustralia(),
+			accBankIDCode:     BankIDCodeAustralia,
+			accAccountNumber:  randomAccountNumberAustralia(),
+			accBaseCurrency:   randomAlpha(2, uppercase),
+		},
+		{
+			name:              "invalid base currency length 4",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustralia,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDAustralia(),
+			accBankIDCode:     BankIDCodeAustralia,
+			accAccountNumber:  randomAccountNumberAustralia(),
+			accBaseCurrency:   randomAlpha(4, uppercase),
+		},
+	}
+
+	austriaTestCases := []testOptions{
+		// BIC tests
+		{
+			name:              "bic blank",
+			shouldError:       false,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustria,
+			accBIC:            "",
+			accBankID:         randomBankIDAustria(),
+			accBankIDCode:     BankIDCodeAustria,
+			accAccountNumber:  randomAccountNumberAustria(),
+			accBaseCurrency:   CurrencyAustria,
+		},
+		{
+			name:              "invalid bic",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustria,
+			accBIC:            randomBICInvalid(),
+			accBankID:         randomBankIDAustria(),
+			accBankIDCode:     BankIDCodeAustria,
+			accAccountNumber:  randomAccountNumberAustria(),
+			accBaseCurrency:   CurrencyAustria,
+		},
+		{
+			name:              "invalid bic length minus 1",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustria,
+			accBIC:            randomBIC()[1:],
+			accBankID:         randomBankIDAustria(),
+			accBankIDCode:     BankIDCodeAustria,
+			accAccountNumber:  randomAccountNumberAustria(),
+			accBaseCurrency:   CurrencyAustria,
+		},
+		{
+			name:              "invalid bic length plus 1",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustria,
+			accBIC:            randomBIC() + "1",
+			accBankID:         randomBankIDAustria(),
+			accBankIDCode:     BankIDCodeAustria,
+			accAccountNumber:  randomAccountNumberAustria(),
+			accBaseCurrency:   CurrencyAustria,
+		},
+		// Bank ID tests
+		{
+			name:              "invalid bank id blank",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustria,
+			accBIC:            randomBIC(),
+			accBankID:         "",
+			accBankIDCode:     BankIDCodeAustria,
+			accAccountNumber:  randomAccountNumberAustria(),
+			accBaseCurrency:   CurrencyAustria,
+		},
+		{
+			name:              "invalid bank id not number",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustria,
+			accBIC:            randomBIC(),
+			accBankID: randomAlphanumeric(
+				BankIDLengthAustria,
+				alphanumericStylePure,
+			),
+			accBankIDCode:    BankIDCodeAustria,
+			accAccountNumber: randomAccountNumberAustria(),
+			accBaseCurrency:  CurrencyAustria,
+		},
+		{
+			name:              "invalid bank id length 4",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustria,
+			accBIC:            randomBIC(),
+			accBankID:         randomNumberString(4),
+			accBankIDCode:     BankIDCodeAustria,
+			accAccountNumber:  randomAccountNumberAustria(),
+			accBaseCurrency:   CurrencyAustria,
+		},
+		{
+			name:              "invalid bank id length 6",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustria,
+			accBIC:            randomBIC(),
+			accBankID:         randomNumberString(6),
+			accBankIDCode:     BankIDCodeAustria,
+			accAccountNumber:  randomAccountNumberAustria(),
+			accBaseCurrency:   CurrencyAustria,
+		},
+		// Bank ID Code tests
+		{
+			name:              "invalid bank id code blank",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustria,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDAustria(),
+			accBankIDCode:     "",
+			accAccountNumber:  randomAccountNumberAustria(),
+			accBaseCurrency:   CurrencyAustria,
+		},
+		{
+			name:              "invalid bank id code",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustria,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDAustria(),
+			accBankIDCode:     randomBankIDCodeInvalid(),
+			accAccountNumber:  randomAccountNumberAustria(),
+			accBaseCurrency:   CurrencyAustria,
+		},
+		{
+			name:              "invalid bank id code non english letters",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustria,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDAustria(),
+			accBankIDCode: randomAlphanumeric(
+				len(BankIDCodeAustria),
+				alphanumericStylePure,
+			),
+			accAccountNumber: randomAccountNumberAustria(),
+			accBaseCurrency:  CurrencyAustria,
+		},
+		// Account Number tests
+		{
+			name:              "account number blank",
+			shouldError:       false,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustria,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDAustria(),
+			accBankIDCode:     BankIDCodeAustria,
+			accAccountNumber:  "",
+			accBaseCurrency:   CurrencyAustria,
+		},
+		{
+			name:              "invalid account number not number",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustria,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDAustria(),
+			accBankIDCode:     BankIDCodeAustria,
+			accAccountNumber: randomAlphanumeric(
+				AccountNumberLengthAustria,
+				alphanumericStylePure,
+			),
+			accBaseCurrency: CurrencyAustria,
+		},
+		{
+			name:              "invalid account number length 10",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustria,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDAustria(),
+			accBankIDCode:     BankIDCodeAustria,
+			accAccountNumber:  randomNumberString(10),
+			accBaseCurrency:   CurrencyAustria,
+		},
+		{
+			name:              "invalid account number length 12",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustria,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDAustria(),
+			accBankIDCode:     BankIDCodeAustria,
+			accAccountNumber:  randomNumberString(12),
+			accBaseCurrency:   CurrencyAustria,
+		},
+		// Base Currency
+		{
+			name:              "base currency blank",
+			shouldError:       false,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustria,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDAustria(),
+			accBankIDCode:     BankIDCodeAustria,
+			accAccountNumber:  randomAccountNumberAustria(),
+			accBaseCurrency:   "",
+		},
+		{
+			name:              "invalid base currency",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustria,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDAustria(),
+			accBankIDCode:     BankIDCodeAustria,
+			accAccountNumber:  randomAccountNumberAustria(),
+			accBaseCurrency:   randomBaseCurrencyInvalid(),
+		},
+		{
+			name:              "invalid base currency non english letters",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustria,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDAustria(),
+			accBankIDCode:     BankIDCodeAustria,
+			accAccountNumber:  randomAccountNumberAustria(),
+			accBaseCurrency: randomAlphanumeric(
+				len(CurrencyAustria),
+				alphanumericStylePure,
+				uppercase,
+			),
+		},
+		{
+			name:              "invalid base currency length 2",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustria,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDAustria(),
+			accBankIDCode:     BankIDCodeAustria,
+			accAccountNumber:  randomAccountNumberAustria(),
+			accBaseCurrency:   randomAlpha(2, uppercase),
+		},
+		{
+			name:              "invalid base currency length 4",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryAustria,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDAustria(),
+			accBankIDCode:     BankIDCodeAustria,
+			accAccountNumber:  randomAccountNumberAustria(),
+			accBaseCurrency:   randomAlpha(4, uppercase),
+		},
 	}
 
-	unitedKingdomTestCases := []testOptions{
+	belgiumTestCases := []testOptions{
 		// BIC tests
 		{
-			name:              "invalid bic blank",
-			shouldError:       true,
+			name:              "bic blank",
+			shouldError:       false,
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryUnitedKingdom,
+			accCountry:        CountryBelgium,
 			accBIC:            "",
-			accBankID:         randomBankIDUnitedKingdom(),
-			accBankIDCode:     BankIDCodeUnitedKingdom,
-			accAccountNumber:  randomAccountNumberUnitedKingdom(),
-			accBaseCurrency:   CurrencyUnitedKingdom,
+			accBankID:         randomBankIDBelgium(),
+			accBankIDCode:     BankIDCodeBelgium,
+			accAccountNumber:  randomAccountNumberBelgium(),
+			accBaseCurrency:   CurrencyBelgium,
 		},
 		{
 			name:              "invalid bic",
@@ -339,12 +1587,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryUnitedKingdom,
+			accCountry:        CountryBelgium,
 			accBIC:            randomBICInvalid(),
-			accBankID:         randomBankIDUnitedKingdom(),
-			accBankIDCode:     BankIDCodeUnitedKingdom,
-			accAccountNumber:  randomAccountNumberUnitedKingdom(),
-			accBaseCurrency:   CurrencyUnitedKingdom,
+			accBankID:         randomBankIDBelgium(),
+			accBankIDCode:     BankIDCodeBelgium,
+			accAccountNumber:  randomAccountNumberBelgium(),
+			accBaseCurrency:   CurrencyBelgium,
 		},
 		{
 			name:              "invalid bic length minus 1",
@@ -352,12 +1600,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryUnitedKingdom,
+			accCountry:        CountryBelgium,
 			accBIC:            randomBIC()[1:],
-			accBankID:         randomBankIDUnitedKingdom(),
-			accBankIDCode:     BankIDCodeUnitedKingdom,
-			accAccountNumber:  randomAccountNumberUnitedKingdom(),
-			accBaseCurrency:   CurrencyUnitedKingdom,
+			accBankID:         randomBankIDBelgium(),
+			accBankIDCode:     BankIDCodeBelgium,
+			accAccountNumber:  randomAccountNumberBelgium(),
+			accBaseCurrency:   CurrencyBelgium,
 		},
 		{
 			name:              "invalid bic length plus 1",
@@ -365,12 +1613,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryUnitedKingdom,
+			accCountry:        CountryBelgium,
 			accBIC:            randomBIC() + "1",
-			accBankID:         randomBankIDUnitedKingdom(),
-			accBankIDCode:     BankIDCodeUnitedKingdom,
-			accAccountNumber:  randomAccountNumberUnitedKingdom(),
-			accBaseCurrency:   CurrencyUnitedKingdom,
+			accBankID:         randomBankIDBelgium(),
+			accBankIDCode:     BankIDCodeBelgium,
+			accAccountNumber:  randomAccountNumberBelgium(),
+			accBaseCurrency:   CurrencyBelgium,
 		},
 		// Bank ID tests
 		{
@@ -379,12 +1627,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryUnitedKingdom,
+			accCountry:        CountryBelgium,
 			accBIC:            randomBIC(),
 			accBankID:         "",
-			accBankIDCode:     BankIDCodeUnitedKingdom,
-			accAccountNumber:  randomAccountNumberUnitedKingdom(),
-			accBaseCurrency:   CurrencyUnitedKingdom,
+			accBankIDCode:     BankIDCodeBelgium,
+			accAccountNumber:  randomAccountNumberBelgium(),
+			accBaseCurrency:   CurrencyBelgium,
 		},
 		{
 			name:              "invalid bank id not number",
@@ -392,41 +1640,41 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryUnitedKingdom,
+			accCountry:        CountryBelgium,
 			accBIC:            randomBIC(),
 			accBankID: randomAlphanumeric(
-				BankIDLengthUnitedKingdom,
+				BankIDLengthBelgium,
 				alphanumericStylePure,
 			),
-			accBankIDCode:    BankIDCodeUnitedKingdom,
-			accAccountNumber: randomAccountNumberUnitedKingdom(),
-			accBaseCurrency:  CurrencyUnitedKingdom,
+			accBankIDCode:    BankIDCodeBelgium,
+			accAccountNumber: randomAccountNumberBelgium(),
+			accBaseCurrency:  CurrencyBelgium,
 		},
 		{
-			name:              "invalid bank id length 5",
+			name:              "invalid bank id length 2",
 			shouldError:       true,
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryUnitedKingdom,
+			accCountry:        CountryBelgium,
 			accBIC:            randomBIC(),
-			accBankID:         randomNumberString(5),
-			accBankIDCode:     BankIDCodeUnitedKingdom,
-			accAccountNumber:  randomAccountNumberUnitedKingdom(),
-			accBaseCurrency:   CurrencyUnitedKingdom,
+			accBankID:         randomNumberString(2),
+			accBankIDCode:     BankIDCodeBelgium,
+			accAccountNumber:  randomAccountNumberBelgium(),
+			accBaseCurrency:   CurrencyBelgium,
 		},
 		{
-			name:              "invalid bank id length 7",
+			name:              "invalid bank id length 4",
 			shouldError:       true,
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryUnitedKingdom,
+			accCountry:        CountryBelgium,
 			accBIC:            randomBIC(),
-			accBankID:         randomNumberString(7),
-			accBankIDCode:     BankIDCodeUnitedKingdom,
-			accAccountNumber:  randomAccountNumberUnitedKingdom(),
-			accBaseCurrency:   CurrencyUnitedKingdom,
+			accBankID:         randomNumberString(4),
+			accBankIDCode:     BankIDCodeBelgium,
+			accAccountNumber:  randomAccountNumberBelgium(),
+			accBaseCurrency:   CurrencyBelgium,
 		},
 		// Bank ID Code tests
 		{
@@ -435,12 +1683,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryUnitedKingdom,
+			accCountry:        CountryBelgium,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDUnitedKingdom(),
+			accBankID:         randomBankIDBelgium(),
 			accBankIDCode:     "",
-			accAccountNumber:  randomAccountNumberUnitedKingdom(),
-			accBaseCurrency:   CurrencyUnitedKingdom,
+			accAccountNumber:  randomAccountNumberBelgium(),
+			accBaseCurrency:   CurrencyBelgium,
 		},
 		{
 			name:              "invalid bank id code",
@@ -448,12 +1696,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryUnitedKingdom,
+			accCountry:        CountryBelgium,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDUnitedKingdom(),
+			accBankID:         randomBankIDBelgium(),
 			accBankIDCode:     randomBankIDCodeInvalid(),
-			accAccountNumber:  randomAccountNumberUnitedKingdom(),
-			accBaseCurrency:   CurrencyUnitedKingdom,
+			accAccountNumber:  randomAccountNumberBelgium(),
+			accBaseCurrency:   CurrencyBelgium,
 		},
 		{
 			name:              "invalid bank id code non english letters",
@@ -461,15 +1709,15 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryUnitedKingdom,
+			accCountry:        CountryBelgium,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDUnitedKingdom(),
+			accBankID:         randomBankIDBelgium(),
 			accBankIDCode: randomAlphanumeric(
-				len(BankIDCodeUnitedKingdom),
+				len(BankIDCodeBelgium),
 				alphanumericStylePure,
 			),
-			accAccountNumber: randomAccountNumberUnitedKingdom(),
-			accBaseCurrency:  CurrencyUnitedKingdom,
+			accAccountNumber: randomAccountNumberBelgium(),
+			accBaseCurrency:  CurrencyBelgium,
 		},
 		// Account Number tests
 		{
@@ -478,12 +1726,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryUnitedKingdom,
+			accCountry:        CountryBelgium,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDUnitedKingdom(),
-			accBankIDCode:     BankIDCodeUnitedKingdom,
+			accBankID:         randomBankIDBelgium(),
+			accBankIDCode:     BankIDCodeBelgium,
 			accAccountNumber:  "",
-			accBaseCurrency:   CurrencyUnitedKingdom,
+			accBaseCurrency:   CurrencyBelgium,
 		},
 		{
 			name:              "invalid account number not number",
@@ -491,41 +1739,41 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryUnitedKingdom,
+			accCountry:        CountryBelgium,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDUnitedKingdom(),
-			accBankIDCode:     BankIDCodeUnitedKingdom,
+			accBankID:         randomBankIDBelgium(),
+			accBankIDCode:     BankIDCodeBelgium,
 			accAccountNumber: randomAlphanumeric(
-				AccountNumberLengthUnitedKingdom,
+				AccountNumberLengthBelgium,
 				alphanumericStylePure,
 			),
-			accBaseCurrency: CurrencyUnitedKingdom,
+			accBaseCurrency: CurrencyBelgium,
 		},
 		{
-			name:              "invalid account number length 7",
+			name:              "invalid account number length 6",
 			shouldError:       true,
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryUnitedKingdom,
+			accCountry:        CountryBelgium,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDUnitedKingdom(),
-			accBankIDCode:     BankIDCodeUnitedKingdom,
-			accAccountNumber:  randomNumberString(7),
-			accBaseCurrency:   CurrencyUnitedKingdom,
+			accBankID:         randomBankIDBelgium(),
+			accBankIDCode:     BankIDCodeBelgium,
+			accAccountNumber:  randomNumberString(6),
+			accBaseCurrency:   CurrencyBelgium,
 		},
 		{
-			name:              "invalid account number length 9",
+			name:              "invalid account number length 8",
 			shouldError:       true,
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryUnitedKingdom,
+			accCountry:        CountryBelgium,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDUnitedKingdom(),
-			accBankIDCode:     BankIDCodeUnitedKingdom,
-			accAccountNumber:  randomNumberString(9),
-			accBaseCurrency:   CurrencyUnitedKingdom,
+			accBankID:         randomBankIDBelgium(),
+			accBankIDCode:     BankIDCodeBelgium,
+			accAccountNumber:  randomNumberString(8),
+			accBaseCurrency:   CurrencyBelgium,
 		},
 		// Base Currency
 		{
@@ -534,11 +1782,11 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryUnitedKingdom,
+			accCountry:        CountryBelgium,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDUnitedKingdom(),
-			accBankIDCode:     BankIDCodeUnitedKingdom,
-			accAccountNumber:  randomAccountNumberUnitedKingdom(),
+			accBankID:         randomBankIDBelgium(),
+			accBankIDCode:     BankIDCodeBelgium,
+			accAccountNumber:  randomAccountNumberBelgium(),
 			accBaseCurrency:   "",
 		},
 		{
@@ -547,11 +1795,11 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryUnitedKingdom,
+			accCountry:        CountryBelgium,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDUnitedKingdom(),
-			accBankIDCode:     BankIDCodeUnitedKingdom,
-			accAccountNumber:  randomAccountNumberUnitedKingdom(),
+			accBankID:         randomBankIDBelgium(),
+			accBankIDCode:     BankIDCodeBelgium,
+			accAccountNumber:  randomAccountNumberBelgium(),
 			accBaseCurrency:   randomBaseCurrencyInvalid(),
 		},
 		{
@@ -560,13 +1808,13 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryUnitedKingdom,
+			accCountry:        CountryBelgium,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDUnitedKingdom(),
-			accBankIDCode:     BankIDCodeUnitedKingdom,
-			accAccountNumber:  randomAccountNumberUnitedKingdom(),
+			accBankID:         randomBankIDBelgium(),
+			accBankIDCode:     BankIDCodeBelgium,
+			accAccountNumber:  randomAccountNumberBelgium(),
 			accBaseCurrency: randomAlphanumeric(
-				len(CurrencyUnitedKingdom),
+				len(CurrencyBelgium),
 				alphanumericStylePure,
 				uppercase,
 			),
@@ -577,11 +1825,11 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryUnitedKingdom,
+			accCountry:        CountryBelgium,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDUnitedKingdom(),
-			accBankIDCode:     BankIDCodeUnitedKingdom,
-			accAccountNumber:  randomAccountNumberUnitedKingdom(),
+			accBankID:         randomBankIDBelgium(),
+			accBankIDCode:     BankIDCodeBelgium,
+			accAccountNumber:  randomAccountNumberBelgium(),
 			accBaseCurrency:   randomAlpha(2, uppercase),
 		},
 		{
@@ -590,16 +1838,16 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryUnitedKingdom,
+			accCountry:        CountryBelgium,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDUnitedKingdom(),
-			accBankIDCode:     BankIDCodeUnitedKingdom,
-			accAccountNumber:  randomAccountNumberUnitedKingdom(),
+			accBankID:         randomBankIDBelgium(),
+			accBankIDCode:     BankIDCodeBelgium,
+			accAccountNumber:  randomAccountNumberBelgium(),
 			accBaseCurrency:   randomAlpha(4, uppercase),
 		},
 	}
 
-	australiaTestCases := []testOptions{
+	canadaTestCases := []testOptions{
 		// BIC tests
 		{
 			name:              "invalid bic blank",
@@ -607,12 +1855,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryAustralia,
+			accCountry:        CountryCanada,
 			accBIC:            "",
-			accBankID:         randomBankIDAustralia(),
-			accBankIDCode:     BankIDCodeAustralia,
-			accAccountNumber:  randomAccountNumberAustralia(),
-			accBaseCurrency:   CurrencyAustralia,
+			accBankID:         randomBankIDCanada(),
+			accBankIDCode:     BankIDCodeCanada,
+			accAccountNumber:  randomAccountNumberCanada(),
+			accBaseCurrency:   CurrencyCanada,
 		},
 		{
 			name:              "invalid bic",
@@ -620,12 +1868,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryAustralia,
+			accCountry:        CountryCanada,
 			accBIC:            randomBICInvalid(),
-			accBankID:         randomBankIDAustralia(),
-			accBankIDCode:     BankIDCodeAustralia,
-			accAccountNumber:  randomAccountNumberAustralia(),
-			accBaseCurrency:   CurrencyAustralia,
+			accBankID:         randomBankIDCanada(),
+			accBankIDCode:     BankIDCodeCanada,
+			accAccountNumber:  randomAccountNumberCanada(),
+			accBaseCurrency:   CurrencyCanada,
 		},
 		{
 			name:              "invalid bic length minus 1",
@@ -633,12 +1881,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryAustralia,
+			accCountry:        CountryCanada,
 			accBIC:            randomBIC()[1:],
-			accBankID:         randomBankIDAustralia(),
-			accBankIDCode:     BankIDCodeAustralia,
-			accAccountNumber:  randomAccountNumberAustralia(),
-			accBaseCurrency:   CurrencyAustralia,
+			accBankID:         randomBankIDCanada(),
+			accBankIDCode:     BankIDCodeCanada,
+			accAccountNumber:  randomAccountNumberCanada(),
+			accBaseCurrency:   CurrencyCanada,
 		},
 		{
 			name:              "invalid bic length plus 1",
@@ -646,12 +1894,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryAustralia,
+			accCountry:        CountryCanada,
 			accBIC:            randomBIC() + "1",
-			accBankID:         randomBankIDAustralia(),
-			accBankIDCode:     BankIDCodeAustralia,
-			accAccountNumber:  randomAccountNumberAustralia(),
-			accBaseCurrency:   CurrencyAustralia,
+			accBankID:         randomBankIDCanada(),
+			accBankIDCode:     BankIDCodeCanada,
+			accAccountNumber:  randomAccountNumberCanada(),
+			accBaseCurrency:   CurrencyCanada,
 		},
 		// Bank ID tests
 		{
@@ -660,12 +1908,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryAustralia,
+			accCountry:        CountryCanada,
 			accBIC:            randomBIC(),
 			accBankID:         "",
-			accBankIDCode:     BankIDCodeAustralia,
-			accAccountNumber:  randomAccountNumberAustralia(),
-			accBaseCurrency:   CurrencyAustralia,
+			accBankIDCode:     BankIDCodeCanada,
+			accAccountNumber:  randomAccountNumberCanada(),
+			accBaseCurrency:   CurrencyCanada,
 		},
 		{
 			name:              "invalid bank id not number",
@@ -673,55 +1921,55 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryAustralia,
+			accCountry:        CountryCanada,
 			accBIC:            randomBIC(),
 			accBankID: randomAlphanumeric(
-				BankIDLengthAustralia,
+				BankIDLengthCanada,
 				alphanumericStylePure,
 			),
-			accBankIDCode:    BankIDCodeAustralia,
-			accAccountNumber: randomAccountNumberAustralia(),
-			accBaseCurrency:  CurrencyAustralia,
+			accBankIDCode:    BankIDCodeCanada,
+			accAccountNumber: randomAccountNumberCanada(),
+			accBaseCurrency:  CurrencyCanada,
 		},
 		{
-			name:              "invalid bank id length 5",
+			name:              "invalid bank id length 8",
 			shouldError:       true,
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryAustralia,
+			accCountry:        CountryCanada,
 			accBIC:            randomBIC(),
-			accBankID:         randomNumberString(5),
-			accBankIDCode:     BankIDCodeAustralia,
-			accAccountNumber:  randomAccountNumberAustralia(),
-			accBaseCurrency:   CurrencyAustralia,
+			accBankID:         randomNumberString(8),
+			accBankIDCode:     BankIDCodeCanada,
+			accAccountNumber:  randomAccountNumberCanada(),
+			accBaseCurrency:   CurrencyCanada,
 		},
 		{
-			name:              "invalid bank id length 7",
+			name:              "invalid bank id length 10",
 			shouldError:       true,
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryAustralia,
+			accCountry:        CountryCanada,
 			accBIC:            randomBIC(),
-			accBankID:         randomNumberString(7),
-			accBankIDCode:     BankIDCodeAustralia,
-			accAccountNumber:  randomAccountNumberAustralia(),
-			accBaseCurrency:   CurrencyAustralia,
+			accBankID:         randomNumberString(10),
+			accBankIDCode:     BankIDCodeCanada,
+			accAccountNumber:  randomAccountNumberCanada(),
+			accBaseCurrency:   CurrencyCanada,
 		},
 		// Bank ID Code tests
 		{
-			name:              "invalid bank id code blank",
-			shouldError:       true,
+			name:              "bank id code blank",
+			shouldError:       false,
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryAustralia,
+			accCountry:        CountryCanada,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDAustralia(),
+			accBankID:         randomBankIDCanada(),
 			accBankIDCode:     "",
-			accAccountNumber:  randomAccountNumberAustralia(),
-			accBaseCurrency:   CurrencyAustralia,
+			accAccountNumber:  randomAccountNumberCanada(),
+			accBaseCurrency:   CurrencyCanada,
 		},
 		{
 			name:              "invalid bank id code",
@@ -729,12 +1977,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryAustralia,
+			accCountry:        CountryCanada,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDAustralia(),
+			accBankID:         randomBankIDCanada(),
 			accBankIDCode:     randomBankIDCodeInvalid(),
-			accAccountNumber:  randomAccountNumberAustralia(),
-			accBaseCurrency:   CurrencyAustralia,
+			accAccountNumber:  randomAccountNumberCanada(),
+			accBaseCurrency:   CurrencyCanada,
 		},
 		{
 			name:              "invalid bank id code non english letters",
@@ -742,15 +1990,15 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryAustralia,
+			accCountry:        CountryCanada,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDAustralia(),
+			accBankID:         randomBankIDCanada(),
 			accBankIDCode: randomAlphanumeric(
-				len(BankIDCodeAustralia),
+				len(BankIDCodeCanada),
 				alphanumericStylePure,
 			),
-			accAccountNumber: randomAccountNumberAustralia(),
-			accBaseCurrency:  CurrencyAustralia,
+			accAccountNumber: randomAccountNumberCanada(),
+			accBaseCurrency:  CurrencyCanada,
 		},
 		// Account Number tests
 		{
@@ -759,12 +2007,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryAustralia,
+			accCountry:        CountryCanada,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDAustralia(),
-			accBankIDCode:     BankIDCodeAustralia,
+			accBankID:         randomBankIDCanada(),
+			accBankIDCode:     BankIDCodeCanada,
 			accAccountNumber:  "",
-			accBaseCurrency:   CurrencyAustralia,
+			accBaseCurrency:   CurrencyCanada,
 		},
 		{
 			name:              "invalid account number not number",
@@ -772,44 +2020,44 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryAustralia,
+			accCountry:        CountryCanada,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDAustralia(),
-			accBankIDCode:     BankIDCodeAustralia,
+			accBankID:         randomBankIDCanada(),
+			accBankIDCode:     BankIDCodeCanada,
 			accAccountNumber: randomAlphanumeric(
 				randomLength(
-					AccountNumberLengthAustraliaStart,
-					AccountNumberLengthAustraliaStop,
+					AccountNumberLengthCanadaStart,
+					AccountNumberLengthCanadaStop,
 				),
 				alphanumericStylePure,
 			),
-			accBaseCurrency: CurrencyAustralia,
+			accBaseCurrency: CurrencyCanada,
 		},
 		{
-			name:              "invalid account number length 5",
+			name:              "invalid account number length 6",
 			shouldError:       true,
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryAustralia,
+			accCountry:        CountryCanada,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDAustralia(),
-			accBankIDCode:     BankIDCodeAustralia,
-			accAccountNumber:  randomNumberString(5),
-			accBaseCurrency:   CurrencyAustralia,
+			accBankID:         randomBankIDCanada(),
+			accBankIDCode:     BankIDCodeCanada,
+			accAccountNumber:  randomNumberString(6),
+			accBaseCurrency:   CurrencyCanada,
 		},
 		{
-			name:              "invalid account number length 11",
+			name:              "invalid account number length 13",
 			shouldError:       true,
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryAustralia,
+			accCountry:        CountryCanada,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDAustralia(),
-			accBankIDCode:     BankIDCodeAustralia,
-			accAccountNumber:  randomNumberString(11),
-			accBaseCurrency:   CurrencyAustralia,
+			accBankID:         randomBankIDCanada(),
+			accBankIDCode:     BankIDCodeCanada,
+			accAccountNumber:  randomNumberString(13),
+			accBaseCurrency:   CurrencyCanada,
 		},
 		// Base Currency
 		{
@@ -818,11 +2066,11 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryAustralia,
+			accCountry:        CountryCanada,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDAustralia(),
-			accBankIDCode:     BankIDCodeAustralia,
-			accAccountNumber:  randomAccountNumberAustralia(),
+			accBankID:         randomBankIDCanada(),
+			accBankIDCode:     BankIDCodeCanada,
+			accAccountNumber:  randomAccountNumberCanada(),
 			accBaseCurrency:   "",
 		},
 		{
@@ -831,11 +2079,11 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryAustralia,
+			accCountry:        CountryCanada,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDAustralia(),
-			accBankIDCode:     BankIDCodeAustralia,
-			accAccountNumber:  randomAccountNumberAustralia(),
+			accBankID:         randomBankIDCanada(),
+			accBankIDCode:     BankIDCodeCanada,
+			accAccountNumber:  randomAccountNumberCanada(),
 			accBaseCurrency:   randomBaseCurrencyInvalid(),
 		},
 		{
@@ -844,13 +2092,13 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryAustralia,
+			accCountry:        CountryCanada,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDAustralia(),
-			accBankIDCode:     BankIDCodeAustralia,
-			accAccountNumber:  randomAccountNumberAustralia(),
+			accBankID:         randomBankIDCanada(),
+			accBankIDCode:     BankIDCodeCanada,
+			accAccountNumber:  randomAccountNumberCanada(),
 			accBaseCurrency: randomAlphanumeric(
-				len(CurrencyAustralia),
+				len(CurrencyCanada),
 				alphanumericStylePure,
 				uppercase,
 			),
@@ -861,11 +2109,11 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryAustralia,
+			accCountry:        CountryCanada,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDAustralia(),
-			accBankIDCode:     BankIDCodeAustralia,
-			accAccountNumber:  randomAccountNumberAustralia(),
+			accBankID:         randomBankIDCanada(),
+			accBankIDCode:     BankIDCodeCanada,
+			accAccountNumber:  randomAccountNumberCanada(),
 			accBaseCurrency:   randomAlpha(2, uppercase),
 		},
 		{
@@ -874,16 +2122,16 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryAustralia,
+			accCountry:        CountryCanada,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDAustralia(),
-			accBankIDCode:     BankIDCodeAustralia,
-			accAccountNumber:  randomAccountNumberAustralia(),
+			accBankID:         randomBankIDCanada(),
+			accBankIDCode:     BankIDCodeCanada,
+			accAccountNumber:  randomAccountNumberCanada(),
 			accBaseCurrency:   randomAlpha(4, uppercase),
 		},
 	}
 
-	belgiumTestCases := []testOptions{
+	denmarkTestCases := []testOptions{
 		// BIC tests
 		{
 			name:              "bic blank",
@@ -891,12 +2139,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryBelgium,
+			accCountry:        CountryDenmark,
 			accBIC:            "",
-			accBankID:         randomBankIDBelgium(),
-			accBankIDCode:     BankIDCodeBelgium,
-			accAccountNumber:  randomAccountNumberBelgium(),
-			accBaseCurrency:   CurrencyBelgium,
+			accBankID:         randomBankIDDenmark(),
+			accBankIDCode:     BankIDCodeDenmark,
+			accAccountNumber:  randomAccountNumberDenmark(),
+			accBaseCurrency:   CurrencyDenmark,
 		},
 		{
 			name:              "invalid bic",
@@ -904,12 +2152,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryBelgium,
+			accCountry:        CountryDenmark,
 			accBIC:            randomBICInvalid(),
-			accBankID:         randomBankIDBelgium(),
-			accBankIDCode:     BankIDCodeBelgium,
-			accAccountNumber:  randomAccountNumberBelgium(),
-			accBaseCurrency:   CurrencyBelgium,
+			accBankID:         randomBankIDDenmark(),
+			accBankIDCode:     BankIDCodeDenmark,
+			accAccountNumber:  randomAccountNumberDenmark(),
+			accBaseCurrency:   CurrencyDenmark,
 		},
 		{
 			name:              "invalid bic length minus 1",
@@ -917,12 +2165,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryBelgium,
+			accCountry:        CountryDenmark,
 			accBIC:            randomBIC()[1:],
-			accBankID:         randomBankIDBelgium(),
-			accBankIDCode:     BankIDCodeBelgium,
-			accAccountNumber:  randomAccountNumberBelgium(),
-			accBaseCurrency:   CurrencyBelgium,
+			accBankID:         randomBankIDDenmark(),
+			accBankIDCode:     BankIDCodeDenmark,
+			accAccountNumber:  randomAccountNumberDenmark(),
+			accBaseCurrency:   CurrencyDenmark,
 		},
 		{
 			name:              "invalid bic length plus 1",
@@ -930,12 +2178,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryBelgium,
+			accCountry:        CountryDenmark,
 			accBIC:            randomBIC() + "1",
-			accBankID:         randomBankIDBelgium(),
-			accBankIDCode:     BankIDCodeBelgium,
-			accAccountNumber:  randomAccountNumberBelgium(),
-			accBaseCurrency:   CurrencyBelgium,
+			accBankID:         randomBankIDDenmark(),
+			accBankIDCode:     BankIDCodeDenmark,
+			accAccountNumber:  randomAccountNumberDenmark(),
+			accBaseCurrency:   CurrencyDenmark,
 		},
 		// Bank ID tests
 		{
@@ -944,12 +2192,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryBelgium,
+			accCountry:        CountryDenmark,
 			accBIC:            randomBIC(),
 			accBankID:         "",
-			accBankIDCode:     BankIDCodeBelgium,
-			accAccountNumber:  randomAccountNumberBelgium(),
-			accBaseCurrency:   CurrencyBelgium,
+			accBankIDCode:     BankIDCodeDenmark,
+			accAccountNumber:  randomAccountNumberDenmark(),
+			accBaseCurrency:   CurrencyDenmark,
 		},
 		{
 			name:              "invalid bank id not number",
@@ -957,68 +2205,68 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryBelgium,
+			accCountry:        CountryDenmark,
 			accBIC:            randomBIC(),
 			accBankID: randomAlphanumeric(
-				BankIDLengthBelgium,
+				BankIDLengthDenmark,
 				alphanumericStylePure,
 			),
-			accBankIDCode:    BankIDCodeBelgium,
-			accAccountNumber: randomAccountNumberBelgium(),
-			accBaseCurrency:  CurrencyBelgium,
+			accBankIDCode:    BankIDCodeDenmark,
+			accAccountNumber: randomAccountNumberDenmark(),
+			accBaseCurrency:  CurrencyDenmark,
 		},
 		{
-			name:              "invalid bank id length 2",
+			name:              "invalid bank id length 3",
 			shouldError:       true,
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryBelgium,
+			accCountry:        CountryDenmark,
 			accBIC:            randomBIC(),
-			accBankID:         randomNumberString(2),
-			accBankIDCode:     BankIDCodeBelgium,
-			accAccountNumber:  randomAccountNumberBelgium(),
-			accBaseCurrency:   CurrencyBelgium,
+			accBankID:         randomNumberString(3),
+			accBankIDCode:     BankIDCodeDenmark,
+			accAccountNumber:  randomAccountNumberDenmark(),
+			accBaseCurrency:   CurrencyDenmark,
 		},
 		{
-			name:              "invalid bank id length 4",
+			name:              "invalid bank id length 5",
 			shouldError:       true,
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryBelgium,
+			accCountry:        CountryDenmark,
 			accBIC:            randomBIC(),
-			accBankID:         randomNumberString(4),
-			accBankIDCode:     BankIDCodeBelgium,
-			accAccountNumber:  randomAccountNumberBelgium(),
-			accBaseCurrency:   CurrencyBelgium,
+			accBankID:         randomNumberString(5),
+			accBankIDCode:     BankIDCodeDenmark,
+			accAccountNumber:  randomAccountNumberDenmark(),
+			accBaseCurrency:   CurrencyDenmark,
 		},
 		// Bank ID Code tests
 		{
-			name:              "invalid bank id code blank",
-			shouldError:       true,
+			name:              "bank id code blank",
+			shouldError:       false,
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryBelgium,
+			accCountry:        CountryDenmark,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDBelgium(),
+			accBankID:         randomBankIDDenmark(),
 			accBankIDCode:     "",
-			accAccountNumber:  randomAccountNumberBelgium(),
-			accBaseCurrency:   CurrencyBelgium,
+			accAccountNumber:  randomAccountNumberDenmark(),
+			accBaseCurrency:   CurrencyDenmark,
 		},
 		{
-			name:              "invalid bank id code",
+			name:              "invalid bank id code not blank",
 			shouldError:       true,
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryBelgium,
+			accCountry:        CountryDenmark,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDBelgium(),
+			accBankID:         randomBankIDDenmark(),
 			accBankIDCode:     randomBankIDCodeInvalid(),
-			accAccountNumber:  randomAccountNumberBelgium(),
-			accBaseCurrency:   CurrencyBelgium,
+			accAccountNumber:  randomAccountNumberDenmark(),
+			accBaseCurrency:   CurrencyDenmark,
 		},
 		{
 			name:              "invalid bank id code non english letters",
@@ -1026,15 +2274,15 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryBelgium,
+			accCountry:        CountryDenmark,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDBelgium(),
+			accBankID:         randomBankIDDenmark(),
 			accBankIDCode: randomAlphanumeric(
-				len(BankIDCodeBelgium),
+				5,
 				alphanumericStylePure,
 			),
-			accAccountNumber: randomAccountNumberBelgium(),
-			accBaseCurrency:  CurrencyBelgium,
+			accAccountNumber: randomAccountNumberDenmark(),
+			accBaseCurrency:  CurrencyDenmark,
 		},
 		// Account Number tests
 		{
@@ -1043,12 +2291,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryBelgium,
+			accCountry:        CountryDenmark,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDBelgium(),
-			accBankIDCode:     BankIDCodeBelgium,
+			accBankID:         randomBankIDDenmark(),
+			accBankIDCode:     BankIDCodeDenmark,
 			accAccountNumber:  "",
-			accBaseCurrency:   CurrencyBelgium,
+			accBaseCurrency:   CurrencyDenmark,
 		},
 		{
 			name:              "invalid account number not number",
@@ -1056,41 +2304,41 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryBelgium,
+			accCountry:        CountryDenmark,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDBelgium(),
-			accBankIDCode:     BankIDCodeBelgium,
+			accBankID:         randomBankIDDenmark(),
+			accBankIDCode:     BankIDCodeDenmark,
 			accAccountNumber: randomAlphanumeric(
-				AccountNumberLengthBelgium,
+				AccountNumberLengthDenmark,
 				alphanumericStylePure,
 			),
-			accBaseCurrency: CurrencyBelgium,
+			accBaseCurrency: CurrencyDenmark,
 		},
 		{
-			name:              "invalid account number length 6",
+			name:              "invalid account number length 9",
 			shouldError:       true,
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryBelgium,
+			accCountry:        CountryDenmark,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDBelgium(),
-			accBankIDCode:     BankIDCodeBelgium,
-			accAccountNumber:  randomNumberString(6),
-			accBaseCurrency:   CurrencyBelgium,
+			accBankID:         randomBankIDDenmark(),
+			accBankIDCode:     BankIDCodeDenmark,
+			accAccountNumber:  randomNumberString(9),
+			accBaseCurrency:   CurrencyDenmark,
 		},
 		{
-			name:              "invalid account number length 8",
+			name:              "invalid account number length 11",
 			shouldError:       true,
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryBelgium,
+			accCountry:        CountryDenmark,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDBelgium(),
-			accBankIDCode:     BankIDCodeBelgium,
-			accAccountNumber:  randomNumberString(8),
-			accBaseCurrency:   CurrencyBelgium,
+			accBankID:         randomBankIDDenmark(),
+			accBankIDCode:     BankIDCodeDenmark,
+			accAccountNumber:  randomNumberString(11),
+			accBaseCurrency:   CurrencyDenmark,
 		},
 		// Base Currency
 		{
@@ -1099,11 +2347,11 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryBelgium,
+			accCountry:        CountryDenmark,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDBelgium(),
-			accBankIDCode:     BankIDCodeBelgium,
-			accAccountNumber:  randomAccountNumberBelgium(),
+			accBankID:         randomBankIDDenmark(),
+			accBankIDCode:     BankIDCodeDenmark,
+			accAccountNumber:  randomAccountNumberDenmark(),
 			accBaseCurrency:   "",
 		},
 		{
@@ -1112,11 +2360,11 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryBelgium,
+			accCountry:        CountryDenmark,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDBelgium(),
-			accBankIDCode:     BankIDCodeBelgium,
-			accAccountNumber:  randomAccountNumberBelgium(),
+			accBankID:         randomBankIDDenmark(),
+			accBankIDCode:     BankIDCodeDenmark,
+			accAccountNumber:  randomAccountNumberDenmark(),
 			accBaseCurrency:   randomBaseCurrencyInvalid(),
 		},
 		{
@@ -1125,13 +2373,13 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryBelgium,
+			accCountry:        CountryDenmark,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDBelgium(),
-			accBankIDCode:     BankIDCodeBelgium,
-			accAccountNumber:  randomAccountNumberBelgium(),
+			accBankID:         randomBankIDDenmark(),
+			accBankIDCode:     BankIDCodeDenmark,
+			accAccountNumber:  randomAccountNumberDenmark(),
 			accBaseCurrency: randomAlphanumeric(
-				len(CurrencyBelgium),
+				len(CurrencyDenmark),
 				alphanumericStylePure,
 				uppercase,
 			),
@@ -1142,11 +2390,11 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryBelgium,
+			accCountry:        CountryDenmark,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDBelgium(),
-			accBankIDCode:     BankIDCodeBelgium,
-			accAccountNumber:  randomAccountNumberBelgium(),
+			accBankID:         randomBankIDDenmark(),
+			accBankIDCode:     BankIDCodeDenmark,
+			accAccountNumber:  randomAccountNumberDenmark(),
 			accBaseCurrency:   randomAlpha(2, uppercase),
 		},
 		{
@@ -1155,16 +2403,16 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryBelgium,
+			accCountry:        CountryDenmark,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDBelgium(),
-			accBankIDCode:     BankIDCodeBelgium,
-			accAccountNumber:  randomAccountNumberBelgium(),
+			accBankID:         randomBankIDDenmark(),
+			accBankIDCode:     BankIDCodeDenmark,
+			accAccountNumber:  randomAccountNumberDenmark(),
 			accBaseCurrency:   randomAlpha(4, uppercase),
 		},
 	}
 
-	canadaTestCases := []testOptions{
+	finlandTestCases := []testOptions{
 		// BIC tests
 		{
 			name:              "invalid bic blank",
@@ -1172,12 +2420,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryCanada,
+			accCountry:        CountryFinland,
 			accBIC:            "",
-			accBankID:         randomBankIDCanada(),
-			accBankIDCode:     BankIDCodeCanada,
-			accAccountNumber:  randomAccountNumberCanada(),
-			accBaseCurrency:   CurrencyCanada,
+			accBankID:         randomBankIDFinland(),
+			accBankIDCode:     BankIDCodeFinland,
+			accAccountNumber:  randomAccountNumberFinland(),
+			accBaseCurrency:   CurrencyFinland,
 		},
 		{
 			name:              "invalid bic",
@@ -1185,12 +2433,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryCanada,
+			accCountry:        CountryFinland,
 			accBIC:            randomBICInvalid(),
-			accBankID:         randomBankIDCanada(),
-			accBankIDCode:     BankIDCodeCanada,
-			accAccountNumber:  randomAccountNumberCanada(),
-			accBaseCurrency:   CurrencyCanada,
+			accBankID:         randomBankIDFinland(),
+			accBankIDCode:     BankIDCodeFinland,
+			accAccountNumber:  randomAccountNumberFinland(),
+			accBaseCurrency:   CurrencyFinland,
 		},
 		{
 			name:              "invalid bic length minus 1",
@@ -1198,12 +2446,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryCanada,
+			accCountry:        CountryFinland,
 			accBIC:            randomBIC()[1:],
-			accBankID:         randomBankIDCanada(),
-			accBankIDCode:     BankIDCodeCanada,
-			accAccountNumber:  randomAccountNumberCanada(),
-			accBaseCurrency:   CurrencyCanada,
+			accBankID:         randomBankIDFinland(),
+			accBankIDCode:     BankIDCodeFinland,
+			accAccountNumber:  randomAccountNumberFinland(),
+			accBaseCurrency:   CurrencyFinland,
 		},
 		{
 			name:              "invalid bic length plus 1",
@@ -1211,12 +2459,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryCanada,
+			accCountry:        CountryFinland,
 			accBIC:            randomBIC() + "1",
-			accBankID:         randomBankIDCanada(),
-			accBankIDCode:     BankIDCodeCanada,
-			accAccountNumber:  randomAccountNumberCanada(),
-			accBaseCurrency:   CurrencyCanada,
+			accBankID:         randomBankIDFinland(),
+			accBankIDCode:     BankIDCodeFinland,
+			accAccountNumber:  randomAccountNumberFinland(),
+			accBaseCurrency:   CurrencyFinland,
 		},
 		// Bank ID tests
 		{
@@ -1225,54 +2473,25 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryCanada,
+			accCountry:        CountryFinland,
 			accBIC:            randomBIC(),
 			accBankID:         "",
-			accBankIDCode:     BankIDCodeCanada,
-			accAccountNumber:  randomAccountNumberCanada(),
-			accBaseCurrency:   CurrencyCanada,
-		},
-		{
-			name:              "invalid bank id not number",
-			shouldError:       true,
-			accType:           accountType,
-			accID:             uuid.New().String(),
-			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryCanada,
-			accBIC:            randomBIC(),
-			accBankID: randomAlphanumeric(
-				BankIDLengthCanada,
-				alphanumericStylePure,
-			),
-			accBankIDCode:    BankIDCodeCanada,
-			accAccountNumber: randomAccountNumberCanada(),
-			accBaseCurrency:  CurrencyCanada,
-		},
-		{
-			name:              "invalid bank id length 8",
-			shouldError:       true,
-			accType:           accountType,
-			accID:             uuid.New().String(),
-			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryCanada,
-			accBIC:            randomBIC(),
-			accBankID:         randomNumberString(8),
-			accBankIDCode:     BankIDCodeCanada,
-			accAccountNumber:  randomAccountNumberCanada(),
-			accBaseCurrency:   CurrencyCanada,
+			accBankIDCode:     BankIDCodeFinland,
+			accAccountNumber:  randomAccountNumberFinland(),
+			accBaseCurrency:   CurrencyFinland,
 		},
 		{
-			name:              "invalid bank id length 10",
+			name:              "invalid bank id not blank",
 			shouldError:       true,
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryCanada,
+			accCountry:        CountryFinland,
 			accBIC:            randomBIC(),
-			accBankID:         randomNumberString(10),
-			accBankIDCode:     BankIDCodeCanada,
-			accAccountNumber:  randomAccountNumberCanada(),
-			accBaseCurrency:   CurrencyCanada,
+			accBankID:         randomAlphanumeric(6, alphanumericStylePure),
+			accBankIDCode:     BankIDCodeFinland,
+			accAccountNumber:  randomAccountNumberFinland(),
+			accBaseCurrency:   CurrencyFinland,
 		},
 		// Bank ID Code tests
 		{
@@ -1281,41 +2500,25 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryCanada,
+			accCountry:        CountryFinland,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDCanada(),
+			accBankID:         randomBankIDFinland(),
 			accBankIDCode:     "",
-			accAccountNumber:  randomAccountNumberCanada(),
-			accBaseCurrency:   CurrencyCanada,
-		},
-		{
-			name:              "invalid bank id code",
-			shouldError:       true,
-			accType:           accountType,
-			accID:             uuid.New().String(),
-			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryCanada,
-			accBIC:            randomBIC(),
-			accBankID:         randomBankIDCanada(),
-			accBankIDCode:     randomBankIDCodeInvalid(),
-			accAccountNumber:  randomAccountNumberCanada(),
-			accBaseCurrency:   CurrencyCanada,
+			accAccountNumber:  randomAccountNumberFinland(),
+			accBaseCurrency:   CurrencyFinland,
 		},
 		{
-			name:              "invalid bank id code non english letters",
+			name:              "invalid bank id code not blank",
 			shouldError:       true,
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryCanada,
+			accCountry:        CountryFinland,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDCanada(),
-			accBankIDCode: randomAlphanumeric(
-				len(BankIDCodeCanada),
-				alphanumericStylePure,
-			),
-			accAccountNumber: randomAccountNumberCanada(),
-			accBaseCurrency:  CurrencyCanada,
+			accBankID:         randomBankIDFinland(),
+			accBankIDCode:     randomAlpha(5, uppercase),
+			accAccountNumber:  randomAccountNumberFinland(),
+			accBaseCurrency:   CurrencyFinland,
 		},
 		// Account Number tests
 		{
@@ -1324,12 +2527,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryCanada,
+			accCountry:        CountryFinland,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDCanada(),
-			accBankIDCode:     BankIDCodeCanada,
+			accBankID:         randomBankIDFinland(),
+			accBankIDCode:     BankIDCodeFinland,
 			accAccountNumber:  "",
-			accBaseCurrency:   CurrencyCanada,
+			accBaseCurrency:   CurrencyFinland,
 		},
 		{
 			name:              "invalid account number not number",
@@ -1337,44 +2540,41 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryCanada,
+			accCountry:        CountryFinland,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDCanada(),
-			accBankIDCode:     BankIDCodeCanada,
+			accBankID:         randomBankIDFinland(),
+			accBankIDCode:     BankIDCodeFinland,
 			accAccountNumber: randomAlphanumeric(
-				randomLength(
-					AccountNumberLengthCanadaStart,
-					AccountNumberLengthCanadaStop,
-				),
+				AccountNumberLengthFinland,
 				alphanumericStylePure,
 			),
-			accBaseCurrency: CurrencyCanada,
+			accBaseCurrency: CurrencyFinland,
 		},
 		{
-			name:              "invalid account number length 6",
+			name:              "invalid account number length 13",
 			shouldError:       true,
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryCanada,
+			accCountry:        CountryFinland,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDCanada(),
-			accBankIDCode:     BankIDCodeCanada,
-			accAccountNumber:  randomNumberString(6),
-			accBaseCurrency:   CurrencyCanada,
+			accBankID:         randomBankIDFinland(),
+			accBankIDCode:     BankIDCodeFinland,
+			accAccountNumber:  randomNumberString(13),
+			accBaseCurrency:   CurrencyFinland,
 		},
 		{
-			name:              "invalid account number length 13",
+			name:              "invalid account number length 15",
 			shouldError:       true,
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryCanada,
+			accCountry:        CountryFinland,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDCanada(),
-			accBankIDCode:     BankIDCodeCanada,
-			accAccountNumber:  randomNumberString(13),
-			accBaseCurrency:   CurrencyCanada,
+			accBankID:         randomBankIDFinland(),
+			accBankIDCode:     BankIDCodeFinland,
+			accAccountNumber:  randomNumberString(15),
+			accBaseCurrency:   CurrencyFinland,
 		},
 		// Base Currency
 		{
@@ -1383,11 +2583,11 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryCanada,
+			accCountry:        CountryFinland,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDCanada(),
-			accBankIDCode:     BankIDCodeCanada,
-			accAccountNumber:  randomAccountNumberCanada(),
+			accBankID:         randomBankIDFinland(),
+			accBankIDCode:     BankIDCodeFinland,
+			accAccountNumber:  randomAccountNumberFinland(),
 			accBaseCurrency:   "",
 		},
 		{
@@ -1396,11 +2596,11 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryCanada,
-			accBIC:            randomBIC(),
-			accBankID:         randomBankIDCanada(),
-			accBankIDCode:     BankIDCodeCanada,
-			accAccountNumber:  randomAccountNumberCanada(),
+			accCountry:        CountryFinland,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDFinland(),
+			accBankIDCode:     BankIDCodeFinland,
+			accAccountNumber:  randomAccountNumberFinland(),
 			accBaseCurrency:   randomBaseCurrencyInvalid(),
 		},
 		{
@@ -1409,13 +2609,13 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryCanada,
+			accCountry:        CountryFinland,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDCanada(),
-			accBankIDCode:     BankIDCodeCanada,
-			accAccountNumber:  randomAccountNumberCanada(),
+			accBankID:         randomBankIDFinland(),
+			accBankIDCode:     BankIDCodeFinland,
+			accAccountNumber:  randomAccountNumberFinland(),
 			accBaseCurrency: randomAlphanumeric(
-				len(CurrencyCanada),
+				len(CurrencyFinland),
 				alphanumericStylePure,
 				uppercase,
 			),
@@ -1426,11 +2626,11 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryCanada,
+			accCountry:        CountryFinland,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDCanada(),
-			accBankIDCode:     BankIDCodeCanada,
-			accAccountNumber:  randomAccountNumberCanada(),
+			accBankID:         randomBankIDFinland(),
+			accBankIDCode:     BankIDCodeFinland,
+			accAccountNumber:  randomAccountNumberFinland(),
 			accBaseCurrency:   randomAlpha(2, uppercase),
 		},
 		{
@@ -1439,13 +2639,26 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryCanada,
+			accCountry:        CountryFinland,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDCanada(),
-			accBankIDCode:     BankIDCodeCanada,
-			accAccountNumber:  randomAccountNumberCanada(),
+			accBankID:         randomBankIDFinland(),
+			accBankIDCode:     BankIDCodeFinland,
+			accAccountNumber:  randomAccountNumberFinland(),
 			accBaseCurrency:   randomAlpha(4, uppercase),
 		},
+		{
+			name:              "invalid account number check digit",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryFinland,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDFinland(),
+			accBankIDCode:     BankIDCodeFinland,
+			accAccountNumber:  invalidCheckDigit(randomAccountNumberFinland()),
+			accBaseCurrency:   CurrencyFinland,
+		},
 	}
 
 	franceTestCases := []testOptions{
@@ -2441,8 +3654,292 @@ func TestNewAccount(t *testing.T) {
 				len(BankIDCodeHongKong),
 				alphanumericStylePure,
 			),
-			accAccountNumber: randomAccountNumberHongKong(),
-			accBaseCurrency:  CurrencyHongKong,
+			accAccountNumber: randomAccountNumberHongKong(),
+			accBaseCurrency:  CurrencyHongKong,
+		},
+		// Account Number tests
+		{
+			name:              "account number blank",
+			shouldError:       false,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryHongKong,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDHongKong(),
+			accBankIDCode:     BankIDCodeHongKong,
+			accAccountNumber:  "",
+			accBaseCurrency:   CurrencyHongKong,
+		},
+		{
+			name:              "invalid account number not number",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryHongKong,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDHongKong(),
+			accBankIDCode:     BankIDCodeHongKong,
+			accAccountNumber: randomAlphanumeric(
+				randomLength(
+					AccountNumberLengthHongKongStart,
+					AccountNumberLengthHongKongStop,
+				),
+				alphanumericStylePure,
+			),
+			accBaseCurrency: CurrencyHongKong,
+		},
+		{
+			name:              "invalid account number length 8",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryHongKong,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDHongKong(),
+			accBankIDCode:     BankIDCodeHongKong,
+			accAccountNumber:  randomNumberString(8),
+			accBaseCurrency:   CurrencyHongKong,
+		},
+		{
+			name:              "invalid account number length 13",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryHongKong,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDHongKong(),
+			accBankIDCode:     BankIDCodeHongKong,
+			accAccountNumber:  randomNumberString(13),
+			accBaseCurrency:   CurrencyHongKong,
+		},
+		// Base Currency
+		{
+			name:              "base currency blank",
+			shouldError:       false,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryHongKong,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDHongKong(),
+			accBankIDCode:     BankIDCodeHongKong,
+			accAccountNumber:  randomAccountNumberHongKong(),
+			accBaseCurrency:   "",
+		},
+		{
+			name:              "invalid base currency",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryHongKong,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDHongKong(),
+			accBankIDCode:     BankIDCodeHongKong,
+			accAccountNumber:  randomAccountNumberHongKong(),
+			accBaseCurrency:   randomBaseCurrencyInvalid(),
+		},
+		{
+			name:              "invalid base currency non english letters",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryHongKong,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDHongKong(),
+			accBankIDCode:     BankIDCodeHongKong,
+			accAccountNumber:  randomAccountNumberHongKong(),
+			accBaseCurrency: randomAlphanumeric(
+				len(CurrencyHongKong),
+				alphanumericStylePure,
+				uppercase,
+			),
+		},
+		{
+			name:              "invalid base currency length 2",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryHongKong,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDHongKong(),
+			accBankIDCode:     BankIDCodeHongKong,
+			accAccountNumber:  randomAccountNumberHongKong(),
+			accBaseCurrency:   randomAlpha(2, uppercase),
+		},
+		{
+			name:              "invalid base currency length 4",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryHongKong,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDHongKong(),
+			accBankIDCode:     BankIDCodeHongKong,
+			accAccountNumber:  randomAccountNumberHongKong(),
+			accBaseCurrency:   randomAlpha(4, uppercase),
+		},
+	}
+
+	irelandTestCases := []testOptions{
+		// BIC tests
+		{
+			name:              "invalid bic blank",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryIreland,
+			accBIC:            "",
+			accBankID:         randomBankIDIreland(),
+			accBankIDCode:     BankIDCodeIreland,
+			accAccountNumber:  randomAccountNumberIreland(),
+			accBaseCurrency:   CurrencyIreland,
+		},
+		{
+			name:              "invalid bic",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryIreland,
+			accBIC:            randomBICInvalid(),
+			accBankID:         randomBankIDIreland(),
+			accBankIDCode:     BankIDCodeIreland,
+			accAccountNumber:  randomAccountNumberIreland(),
+			accBaseCurrency:   CurrencyIreland,
+		},
+		{
+			name:              "invalid bic length minus 1",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryIreland,
+			accBIC:            randomBIC()[1:],
+			accBankID:         randomBankIDIreland(),
+			accBankIDCode:     BankIDCodeIreland,
+			accAccountNumber:  randomAccountNumberIreland(),
+			accBaseCurrency:   CurrencyIreland,
+		},
+		{
+			name:              "invalid bic length plus 1",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryIreland,
+			accBIC:            randomBIC() + "1",
+			accBankID:         randomBankIDIreland(),
+			accBankIDCode:     BankIDCodeIreland,
+			accAccountNumber:  randomAccountNumberIreland(),
+			accBaseCurrency:   CurrencyIreland,
+		},
+		// Bank ID tests
+		{
+			name:              "invalid bank id blank",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryIreland,
+			accBIC:            randomBIC(),
+			accBankID:         "",
+			accBankIDCode:     BankIDCodeIreland,
+			accAccountNumber:  randomAccountNumberIreland(),
+			accBaseCurrency:   CurrencyIreland,
+		},
+		{
+			name:              "invalid bank id not number",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryIreland,
+			accBIC:            randomBIC(),
+			accBankID: randomAlphanumeric(
+				BankIDLengthIreland,
+				alphanumericStylePure,
+			),
+			accBankIDCode:    BankIDCodeIreland,
+			accAccountNumber: randomAccountNumberIreland(),
+			accBaseCurrency:  CurrencyIreland,
+		},
+		{
+			name:              "invalid bank id length 5",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryIreland,
+			accBIC:            randomBIC(),
+			accBankID:         randomNumberString(5),
+			accBankIDCode:     BankIDCodeIreland,
+			accAccountNumber:  randomAccountNumberIreland(),
+			accBaseCurrency:   CurrencyIreland,
+		},
+		{
+			name:              "invalid bank id length 7",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryIreland,
+			accBIC:            randomBIC(),
+			accBankID:         randomNumberString(7),
+			accBankIDCode:     BankIDCodeIreland,
+			accAccountNumber:  randomAccountNumberIreland(),
+			accBaseCurrency:   CurrencyIreland,
+		},
+		// Bank ID Code tests
+		{
+			name:              "invalid bank id code blank",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryIreland,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDIreland(),
+			accBankIDCode:     "",
+			accAccountNumber:  randomAccountNumberIreland(),
+			accBaseCurrency:   CurrencyIreland,
+		},
+		{
+			name:              "invalid bank id code",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryIreland,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDIreland(),
+			accBankIDCode:     randomBankIDCodeInvalid(),
+			accAccountNumber:  randomAccountNumberIreland(),
+			accBaseCurrency:   CurrencyIreland,
+		},
+		{
+			name:              "invalid bank id code non english letters",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryIreland,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDIreland(),
+			accBankIDCode: randomAlphanumeric(
+				len(BankIDCodeIreland),
+				alphanumericStylePure,
+			),
+			accAccountNumber: randomAccountNumberIreland(),
+			accBaseCurrency:  CurrencyIreland,
 		},
 		// Account Number tests
 		{
@@ -2451,12 +3948,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryHongKong,
+			accCountry:        CountryIreland,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDHongKong(),
-			accBankIDCode:     BankIDCodeHongKong,
+			accBankID:         randomBankIDIreland(),
+			accBankIDCode:     BankIDCodeIreland,
 			accAccountNumber:  "",
-			accBaseCurrency:   CurrencyHongKong,
+			accBaseCurrency:   CurrencyIreland,
 		},
 		{
 			name:              "invalid account number not number",
@@ -2464,44 +3961,41 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryHongKong,
+			accCountry:        CountryIreland,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDHongKong(),
-			accBankIDCode:     BankIDCodeHongKong,
+			accBankID:         randomBankIDIreland(),
+			accBankIDCode:     BankIDCodeIreland,
 			accAccountNumber: randomAlphanumeric(
-				randomLength(
-					AccountNumberLengthHongKongStart,
-					AccountNumberLengthHongKongStop,
-				),
+				AccountNumberLengthIreland,
 				alphanumericStylePure,
 			),
-			accBaseCurrency: CurrencyHongKong,
+			accBaseCurrency: CurrencyIreland,
 		},
 		{
-			name:              "invalid account number length 8",
+			name:              "invalid account number length 7",
 			shouldError:       true,
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryHongKong,
+			accCountry:        CountryIreland,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDHongKong(),
-			accBankIDCode:     BankIDCodeHongKong,
-			accAccountNumber:  randomNumberString(8),
-			accBaseCurrency:   CurrencyHongKong,
+			accBankID:         randomBankIDIreland(),
+			accBankIDCode:     BankIDCodeIreland,
+			accAccountNumber:  randomNumberString(7),
+			accBaseCurrency:   CurrencyIreland,
 		},
 		{
-			name:              "invalid account number length 13",
+			name:              "invalid account number length 9",
 			shouldError:       true,
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryHongKong,
+			accCountry:        CountryIreland,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDHongKong(),
-			accBankIDCode:     BankIDCodeHongKong,
-			accAccountNumber:  randomNumberString(13),
-			accBaseCurrency:   CurrencyHongKong,
+			accBankID:         randomBankIDIreland(),
+			accBankIDCode:     BankIDCodeIreland,
+			accAccountNumber:  randomNumberString(9),
+			accBaseCurrency:   CurrencyIreland,
 		},
 		// Base Currency
 		{
@@ -2510,11 +4004,11 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryHongKong,
+			accCountry:        CountryIreland,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDHongKong(),
-			accBankIDCode:     BankIDCodeHongKong,
-			accAccountNumber:  randomAccountNumberHongKong(),
+			accBankID:         randomBankIDIreland(),
+			accBankIDCode:     BankIDCodeIreland,
+			accAccountNumber:  randomAccountNumberIreland(),
 			accBaseCurrency:   "",
 		},
 		{
@@ -2523,11 +4017,11 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryHongKong,
+			accCountry:        CountryIreland,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDHongKong(),
-			accBankIDCode:     BankIDCodeHongKong,
-			accAccountNumber:  randomAccountNumberHongKong(),
+			accBankID:         randomBankIDIreland(),
+			accBankIDCode:     BankIDCodeIreland,
+			accAccountNumber:  randomAccountNumberIreland(),
 			accBaseCurrency:   randomBaseCurrencyInvalid(),
 		},
 		{
@@ -2536,13 +4030,13 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryHongKong,
+			accCountry:        CountryIreland,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDHongKong(),
-			accBankIDCode:     BankIDCodeHongKong,
-			accAccountNumber:  randomAccountNumberHongKong(),
+			accBankID:         randomBankIDIreland(),
+			accBankIDCode:     BankIDCodeIreland,
+			accAccountNumber:  randomAccountNumberIreland(),
 			accBaseCurrency: randomAlphanumeric(
-				len(CurrencyHongKong),
+				len(CurrencyIreland),
 				alphanumericStylePure,
 				uppercase,
 			),
@@ -2553,11 +4047,11 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryHongKong,
+			accCountry:        CountryIreland,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDHongKong(),
-			accBankIDCode:     BankIDCodeHongKong,
-			accAccountNumber:  randomAccountNumberHongKong(),
+			accBankID:         randomBankIDIreland(),
+			accBankIDCode:     BankIDCodeIreland,
+			accAccountNumber:  randomAccountNumberIreland(),
 			accBaseCurrency:   randomAlpha(2, uppercase),
 		},
 		{
@@ -2566,11 +4060,11 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryHongKong,
+			accCountry:        CountryIreland,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDHongKong(),
-			accBankIDCode:     BankIDCodeHongKong,
-			accAccountNumber:  randomAccountNumberHongKong(),
+			accBankID:         randomBankIDIreland(),
+			accBankIDCode:     BankIDCodeIreland,
+			accAccountNumber:  randomAccountNumberIreland(),
 			accBaseCurrency:   randomAlpha(4, uppercase),
 		},
 	}
@@ -3251,25 +4745,261 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryNetherlands,
+			accCountry:        CountryNetherlands,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDNetherlands(),
+			accBankIDCode:     "",
+			accAccountNumber:  randomAccountNumberNetherlands(),
+			accBaseCurrency:   CurrencyNetherlands,
+		},
+		{
+			name:              "invalid bank id code not blank",
+			shouldError:       false,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryNetherlands,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDNetherlands(),
+			accBankIDCode:     BankIDCodeNetherlands,
+			accAccountNumber:  randomAccountNumberNetherlands(),
+			accBaseCurrency:   CurrencyNetherlands,
+		},
+		// Account Number tests
+		{
+			name:              "account number blank",
+			shouldError:       false,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryNetherlands,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDNetherlands(),
+			accBankIDCode:     BankIDCodeNetherlands,
+			accAccountNumber:  "",
+			accBaseCurrency:   CurrencyNetherlands,
+		},
+		{
+			name:              "invalid account number not number",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryNetherlands,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDNetherlands(),
+			accBankIDCode:     BankIDCodeNetherlands,
+			accAccountNumber: randomAlphanumeric(
+				AccountNumberLengthNetherlands,
+				alphanumericStylePure,
+			),
+			accBaseCurrency: CurrencyNetherlands,
+		},
+		{
+			name:              "invalid account number length 9",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryNetherlands,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDNetherlands(),
+			accBankIDCode:     BankIDCodeNetherlands,
+			accAccountNumber:  randomNumberString(9),
+			accBaseCurrency:   CurrencyNetherlands,
+		},
+		{
+			name:              "invalid account number length 11",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryNetherlands,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDNetherlands(),
+			accBankIDCode:     BankIDCodeNetherlands,
+			accAccountNumber:  randomNumberString(11),
+			accBaseCurrency:   CurrencyNetherlands,
+		},
+		// Base Currency
+		{
+			name:              "base currency blank",
+			shouldError:       false,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryNetherlands,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDNetherlands(),
+			accBankIDCode:     BankIDCodeNetherlands,
+			accAccountNumber:  randomAccountNumberNetherlands(),
+			accBaseCurrency:   "",
+		},
+		{
+			name:              "invalid base currency",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryNetherlands,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDNetherlands(),
+			accBankIDCode:     BankIDCodeNetherlands,
+			accAccountNumber:  randomAccountNumberNetherlands(),
+			accBaseCurrency:   randomBaseCurrencyInvalid(),
+		},
+		{
+			name:              "invalid base currency non english letters",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryNetherlands,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDNetherlands(),
+			accBankIDCode:     BankIDCodeNetherlands,
+			accAccountNumber:  randomAccountNumberNetherlands(),
+			accBaseCurrency: randomAlphanumeric(
+				len(CurrencyNetherlands),
+				alphanumericStylePure,
+				uppercase,
+			),
+		},
+		{
+			name:              "invalid base currency length 2",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryNetherlands,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDNetherlands(),
+			accBankIDCode:     BankIDCodeNetherlands,
+			accAccountNumber:  randomAccountNumberNetherlands(),
+			accBaseCurrency:   randomAlpha(2, uppercase),
+		},
+		{
+			name:              "invalid base currency length 4",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryNetherlands,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDNetherlands(),
+			accBankIDCode:     BankIDCodeNetherlands,
+			accAccountNumber:  randomAccountNumberNetherlands(),
+			accBaseCurrency:   randomAlpha(4, uppercase),
+		},
+	}
+
+	norwayTestCases := []testOptions{
+		// BIC tests
+		{
+			name:              "invalid bic blank",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryNorway,
+			accBIC:            "",
+			accBankID:         randomBankIDNorway(),
+			accBankIDCode:     BankIDCodeNorway,
+			accAccountNumber:  randomAccountNumberNorway(),
+			accBaseCurrency:   CurrencyNorway,
+		},
+		{
+			name:              "invalid bic",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryNorway,
+			accBIC:            randomBICInvalid(),
+			accBankID:         randomBankIDNorway(),
+			accBankIDCode:     BankIDCodeNorway,
+			accAccountNumber:  randomAccountNumberNorway(),
+			accBaseCurrency:   CurrencyNorway,
+		},
+		{
+			name:              "invalid bic length minus 1",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryNorway,
+			accBIC:            randomBIC()[1:],
+			accBankID:         randomBankIDNorway(),
+			accBankIDCode:     BankIDCodeNorway,
+			accAccountNumber:  randomAccountNumberNorway(),
+			accBaseCurrency:   CurrencyNorway,
+		},
+		{
+			name:              "invalid bic length plus 1",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryNorway,
+			accBIC:            randomBIC() + "1",
+			accBankID:         randomBankIDNorway(),
+			accBankIDCode:     BankIDCodeNorway,
+			accAccountNumber:  randomAccountNumberNorway(),
+			accBaseCurrency:   CurrencyNorway,
+		},
+		// Bank ID tests
+		{
+			name:              "bank id blank",
+			shouldError:       false,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryNorway,
+			accBIC:            randomBIC(),
+			accBankID:         "",
+			accBankIDCode:     BankIDCodeNorway,
+			accAccountNumber:  randomAccountNumberNorway(),
+			accBaseCurrency:   CurrencyNorway,
+		},
+		{
+			name:              "invalid bank id not blank",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryNorway,
+			accBIC:            randomBIC(),
+			accBankID:         randomAlphanumeric(6, alphanumericStylePure),
+			accBankIDCode:     BankIDCodeNorway,
+			accAccountNumber:  randomAccountNumberNorway(),
+			accBaseCurrency:   CurrencyNorway,
+		},
+		// Bank ID Code tests
+		{
+			name:              "bank id code blank",
+			shouldError:       false,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryNorway,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDNetherlands(),
+			accBankID:         randomBankIDNorway(),
 			accBankIDCode:     "",
-			accAccountNumber:  randomAccountNumberNetherlands(),
-			accBaseCurrency:   CurrencyNetherlands,
+			accAccountNumber:  randomAccountNumberNorway(),
+			accBaseCurrency:   CurrencyNorway,
 		},
 		{
 			name:              "invalid bank id code not blank",
-			shouldError:       false,
+			shouldError:       true,
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryNetherlands,
+			accCountry:        CountryNorway,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDNetherlands(),
-			accBankIDCode:     BankIDCodeNetherlands,
-			accAccountNumber:  randomAccountNumberNetherlands(),
-			accBaseCurrency:   CurrencyNetherlands,
+			accBankID:         randomBankIDNorway(),
+			accBankIDCode:     randomAlpha(5, uppercase),
+			accAccountNumber:  randomAccountNumberNorway(),
+			accBaseCurrency:   CurrencyNorway,
 		},
 		// Account Number tests
 		{
@@ -3278,12 +5008,12 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryNetherlands,
+			accCountry:        CountryNorway,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDNetherlands(),
-			accBankIDCode:     BankIDCodeNetherlands,
+			accBankID:         randomBankIDNorway(),
+			accBankIDCode:     BankIDCodeNorway,
 			accAccountNumber:  "",
-			accBaseCurrency:   CurrencyNetherlands,
+			accBaseCurrency:   CurrencyNorway,
 		},
 		{
 			name:              "invalid account number not number",
@@ -3291,41 +5021,41 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryNetherlands,
+			accCountry:        CountryNorway,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDNetherlands(),
-			accBankIDCode:     BankIDCodeNetherlands,
+			accBankID:         randomBankIDNorway(),
+			accBankIDCode:     BankIDCodeNorway,
 			accAccountNumber: randomAlphanumeric(
-				AccountNumberLengthNetherlands,
+				AccountNumberLengthNorway,
 				alphanumericStylePure,
 			),
-			accBaseCurrency: CurrencyNetherlands,
+			accBaseCurrency: CurrencyNorway,
 		},
 		{
-			name:              "invalid account number length 9",
+			name:              "invalid account number length 10",
 			shouldError:       true,
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryNetherlands,
+			accCountry:        CountryNorway,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDNetherlands(),
-			accBankIDCode:     BankIDCodeNetherlands,
-			accAccountNumber:  randomNumberString(9),
-			accBaseCurrency:   CurrencyNetherlands,
+			accBankID:         randomBankIDNorway(),
+			accBankIDCode:     BankIDCodeNorway,
+			accAccountNumber:  randomNumberString(10),
+			accBaseCurrency:   CurrencyNorway,
 		},
 		{
-			name:              "invalid account number length 11",
+			name:              "invalid account number length 12",
 			shouldError:       true,
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryNetherlands,
+			accCountry:        CountryNorway,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDNetherlands(),
-			accBankIDCode:     BankIDCodeNetherlands,
-			accAccountNumber:  randomNumberString(11),
-			accBaseCurrency:   CurrencyNetherlands,
+			accBankID:         randomBankIDNorway(),
+			accBankIDCode:     BankIDCodeNorway,
+			accAccountNumber:  randomNumberString(12),
+			accBaseCurrency:   CurrencyNorway,
 		},
 		// Base Currency
 		{
@@ -3334,11 +5064,11 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryNetherlands,
+			accCountry:        CountryNorway,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDNetherlands(),
-			accBankIDCode:     BankIDCodeNetherlands,
-			accAccountNumber:  randomAccountNumberNetherlands(),
+			accBankID:         randomBankIDNorway(),
+			accBankIDCode:     BankIDCodeNorway,
+			accAccountNumber:  randomAccountNumberNorway(),
 			accBaseCurrency:   "",
 		},
 		{
@@ -3347,11 +5077,11 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryNetherlands,
+			accCountry:        CountryNorway,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDNetherlands(),
-			accBankIDCode:     BankIDCodeNetherlands,
-			accAccountNumber:  randomAccountNumberNetherlands(),
+			accBankID:         randomBankIDNorway(),
+			accBankIDCode:     BankIDCodeNorway,
+			accAccountNumber:  randomAccountNumberNorway(),
 			accBaseCurrency:   randomBaseCurrencyInvalid(),
 		},
 		{
@@ -3360,13 +5090,13 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryNetherlands,
+			accCountry:        CountryNorway,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDNetherlands(),
-			accBankIDCode:     BankIDCodeNetherlands,
-			accAccountNumber:  randomAccountNumberNetherlands(),
+			accBankID:         randomBankIDNorway(),
+			accBankIDCode:     BankIDCodeNorway,
+			accAccountNumber:  randomAccountNumberNorway(),
 			accBaseCurrency: randomAlphanumeric(
-				len(CurrencyNetherlands),
+				len(CurrencyNorway),
 				alphanumericStylePure,
 				uppercase,
 			),
@@ -3377,11 +5107,11 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryNetherlands,
+			accCountry:        CountryNorway,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDNetherlands(),
-			accBankIDCode:     BankIDCodeNetherlands,
-			accAccountNumber:  randomAccountNumberNetherlands(),
+			accBankID:         randomBankIDNorway(),
+			accBankIDCode:     BankIDCodeNorway,
+			accAccountNumber:  randomAccountNumberNorway(),
 			accBaseCurrency:   randomAlpha(2, uppercase),
 		},
 		{
@@ -3390,13 +5120,26 @@ func TestNewAccount(t *testing.T) {
 			accType:           accountType,
 			accID:             uuid.New().String(),
 			accOrganisationID: uuid.New().String(),
-			accCountry:        CountryNetherlands,
+			accCountry:        CountryNorway,
 			accBIC:            randomBIC(),
-			accBankID:         randomBankIDNetherlands(),
-			accBankIDCode:     BankIDCodeNetherlands,
-			accAccountNumber:  randomAccountNumberNetherlands(),
+			accBankID:         randomBankIDNorway(),
+			accBankIDCode:     BankIDCodeNorway,
+			accAccountNumber:  randomAccountNumberNorway(),
 			accBaseCurrency:   randomAlpha(4, uppercase),
 		},
+		{
+			name:              "invalid account number check digit",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountryNorway,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDNorway(),
+			accBankIDCode:     BankIDCodeNorway,
+			accAccountNumber:  invalidCheckDigit(randomAccountNumberNorway()),
+			accBaseCurrency:   CurrencyNorway,
+		},
 	}
 
 	polandTestCases := []testOptions{
@@ -4239,6 +5982,287 @@ func TestNewAccount(t *testing.T) {
 		},
 	}
 
+	swedenTestCases := []testOptions{
+		// BIC tests
+		{
+			name:              "bic blank",
+			shouldError:       false,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountrySweden,
+			accBIC:            "",
+			accBankID:         randomBankIDSweden(),
+			accBankIDCode:     BankIDCodeSweden,
+			accAccountNumber:  randomAccountNumberSweden(),
+			accBaseCurrency:   CurrencySweden,
+		},
+		{
+			name:              "invalid bic",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountrySweden,
+			accBIC:            randomBICInvalid(),
+			accBankID:         randomBankIDSweden(),
+			accBankIDCode:     BankIDCodeSweden,
+			accAccountNumber:  randomAccountNumberSweden(),
+			accBaseCurrency:   CurrencySweden,
+		},
+		{
+			name:              "invalid bic length minus 1",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountrySweden,
+			accBIC:            randomBIC()[1:],
+			accBankID:         randomBankIDSweden(),
+			accBankIDCode:     BankIDCodeSweden,
+			accAccountNumber:  randomAccountNumberSweden(),
+			accBaseCurrency:   CurrencySweden,
+		},
+		{
+			name:              "invalid bic length plus 1",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountrySweden,
+			accBIC:            randomBIC() + "1",
+			accBankID:         randomBankIDSweden(),
+			accBankIDCode:     BankIDCodeSweden,
+			accAccountNumber:  randomAccountNumberSweden(),
+			accBaseCurrency:   CurrencySweden,
+		},
+		// Bank ID tests
+		{
+			name:              "invalid bank id blank",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountrySweden,
+			accBIC:            randomBIC(),
+			accBankID:         "",
+			accBankIDCode:     BankIDCodeSweden,
+			accAccountNumber:  randomAccountNumberSweden(),
+			accBaseCurrency:   CurrencySweden,
+		},
+		{
+			name:              "invalid bank id not number",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountrySweden,
+			accBIC:            randomBIC(),
+			accBankID: randomAlphanumeric(
+				BankIDLengthSweden,
+				alphanumericStylePure,
+			),
+			accBankIDCode:    BankIDCodeSweden,
+			accAccountNumber: randomAccountNumberSweden(),
+			accBaseCurrency:  CurrencySweden,
+		},
+		{
+			name:              "invalid bank id length 3",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountrySweden,
+			accBIC:            randomBIC(),
+			accBankID:         randomNumberString(3),
+			accBankIDCode:     BankIDCodeSweden,
+			accAccountNumber:  randomAccountNumberSweden(),
+			accBaseCurrency:   CurrencySweden,
+		},
+		{
+			name:              "invalid bank id length 5",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountrySweden,
+			accBIC:            randomBIC(),
+			accBankID:         randomNumberString(5),
+			accBankIDCode:     BankIDCodeSweden,
+			accAccountNumber:  randomAccountNumberSweden(),
+			accBaseCurrency:   CurrencySweden,
+		},
+		// Bank ID Code tests
+		{
+			name:              "invalid bank id code blank",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountrySweden,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDSweden(),
+			accBankIDCode:     "",
+			accAccountNumber:  randomAccountNumberSweden(),
+			accBaseCurrency:   CurrencySweden,
+		},
+		{
+			name:              "invalid bank id code",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountrySweden,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDSweden(),
+			accBankIDCode:     randomBankIDCodeInvalid(),
+			accAccountNumber:  randomAccountNumberSweden(),
+			accBaseCurrency:   CurrencySweden,
+		},
+		{
+			name:              "invalid bank id code non english letters",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountrySweden,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDSweden(),
+			accBankIDCode: randomAlphanumeric(
+				len(BankIDCodeSweden),
+				alphanumericStylePure,
+			),
+			accAccountNumber: randomAccountNumberSweden(),
+			accBaseCurrency:  CurrencySweden,
+		},
+		// Account Number tests
+		{
+			name:              "account number blank",
+			shouldError:       false,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountrySweden,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDSweden(),
+			accBankIDCode:     BankIDCodeSweden,
+			accAccountNumber:  "",
+			accBaseCurrency:   CurrencySweden,
+		},
+		{
+			name:              "invalid account number not number",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountrySweden,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDSweden(),
+			accBankIDCode:     BankIDCodeSweden,
+			accAccountNumber: randomAlphanumeric(
+				AccountNumberLengthSwedenStop,
+				alphanumericStylePure,
+			),
+			accBaseCurrency: CurrencySweden,
+		},
+		{
+			name:              "invalid account number length 6",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountrySweden,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDSweden(),
+			accBankIDCode:     BankIDCodeSweden,
+			accAccountNumber:  randomNumberString(6),
+			accBaseCurrency:   CurrencySweden,
+		},
+		{
+			name:              "invalid account number length 11",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountrySweden,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDSweden(),
+			accBankIDCode:     BankIDCodeSweden,
+			accAccountNumber:  randomNumberString(11),
+			accBaseCurrency:   CurrencySweden,
+		},
+		// Base Currency
+		{
+			name:              "base currency blank",
+			shouldError:       false,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountrySweden,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDSweden(),
+			accBankIDCode:     BankIDCodeSweden,
+			accAccountNumber:  randomAccountNumberSweden(),
+			accBaseCurrency:   "",
+		},
+		{
+			name:              "invalid base currency",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountrySweden,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDSweden(),
+			accBankIDCode:     BankIDCodeSweden,
+			accAccountNumber:  randomAccountNumberSweden(),
+			accBaseCurrency:   randomBaseCurrencyInvalid(),
+		},
+		{
+			name:              "invalid base currency non english letters",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountrySweden,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDSweden(),
+			accBankIDCode:     BankIDCodeSweden,
+			accAccountNumber:  randomAccountNumberSweden(),
+			accBaseCurrency: randomAlphanumeric(
+				len(CurrencySweden),
+				alphanumericStylePure,
+				uppercase,
+			),
+		},
+		{
+			name:              "invalid base currency length 2",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountrySweden,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDSweden(),
+			accBankIDCode:     BankIDCodeSweden,
+			accAccountNumber:  randomAccountNumberSweden(),
+			accBaseCurrency:   randomAlpha(2, uppercase),
+		},
+		{
+			name:              "invalid base currency length 4",
+			shouldError:       true,
+			accType:           accountType,
+			accID:             uuid.New().String(),
+			accOrganisationID: uuid.New().String(),
+			accCountry:        CountrySweden,
+			accBIC:            randomBIC(),
+			accBankID:         randomBankIDSweden(),
+			accBankIDCode:     BankIDCodeSweden,
+			accAccountNumber:  randomAccountNumberSweden(),
+			accBaseCurrency:   randomAlpha(4, uppercase),
+		},
+	}
+
 	switzerlandTestCases := []testOptions{
 		// BIC tests
 		{
@@ -4811,18 +6835,24 @@ func TestNewAccount(t *testing.T) {
 		{country: "all countries", cases: allCountriesTestCases},
 		{country: "united kingdom", cases: unitedKingdomTestCases},
 		{country: "australia", cases: australiaTestCases},
+		{country: "austria", cases: austriaTestCases},
 		{country: "belgium", cases: belgiumTestCases},
 		{country: "canada", cases: canadaTestCases},
+		{country: "denmark", cases: denmarkTestCases},
+		{country: "finland", cases: finlandTestCases},
 		{country: "france", cases: franceTestCases},
 		{country: "germany", cases: germanyTestCases},
 		{country: "greece", cases: greeceTestCases},
 		{country: "hong kong", cases: hongKongTestCases},
+		{country: "ireland", cases: irelandTestCases},
 		{country: "italy", cases: italyTestCases},
 		{country: "luxembourg", cases: luxembourgTestCases},
 		{country: "netherlands", cases: netherlandsTestCases},
+		{country: "norway", cases: norwayTestCases},
 		{country: "poland", cases: polandTestCases},
 		{country: "portugal", cases: portugalTestCases},
 		{country: "spain", cases: spainTestCases},
+		{country: "sweden", cases: swedenTestCases},
 		{country: "switzerland", cases: switzerlandTestCases},
 		{country: "united states", cases: unitedStatesTestCases},
 	}
@@ -4851,6 +6881,14 @@ func TestNewAccount(t *testing.T) {
 							WithAttrAlternativeBankAccountNames(cc.accAlternativeBankAccountNames...),
 							WithAttrAccountMatchingOptOut(cc.accAccountMatchingOptOut),
 							WithAttrCustomerID(cc.accCustomerID),
+							WithAttrTitle(cc.accTitle),
+							WithAttrName(cc.accName...),
+							WithAttrBankAccountName(cc.accBankAccountName),
+							WithAttrAlternativeNames(cc.accAlternativeNames...),
+							WithAttrAccountClassification(cc.accAccountClassification),
+							WithAttrStatus(cc.accStatus),
+							WithAttrSwitched(cc.accSwitched),
+							WithAttrSecondaryIdentification(cc.accSecondaryIdentification),
 						},
 					}
 
@@ -4874,6 +6912,14 @@ func TestNewAccount(t *testing.T) {
 						assert.Equal(t, cc.accAlternativeBankAccountNames, account.Data.Attributes.AlternativeBankAccountNames)
 						assert.Equal(t, cc.accAccountMatchingOptOut, account.Data.Attributes.AccountMatchingOptOut)
 						assert.Equal(t, cc.accCustomerID, account.Data.Attributes.CustomerID)
+						assert.Equal(t, cc.accTitle, account.Data.Attributes.Title)
+						assert.Equal(t, cc.accName, account.Data.Attributes.Name)
+						assert.Equal(t, cc.accBankAccountName, account.Data.Attributes.BankAccountName)
+						assert.Equal(t, cc.accAlternativeNames, account.Data.Attributes.AlternativeNames)
+						assert.Equal(t, cc.accAccountClassification, account.Data.Attributes.AccountClassification)
+						assert.Equal(t, cc.accStatus, account.Data.Attributes.Status)
+						assert.Equal(t, cc.accSwitched, account.Data.Attributes.Switched)
+						assert.Equal(t, cc.accSecondaryIdentification, account.Data.Attributes.SecondaryIdentification)
 					}
 				})
 			}